@@ -0,0 +1,4 @@
+data "snowflake_session_policies" "current" {
+  database = "MYDB"
+  schema   = "MYSCHEMA"
+}
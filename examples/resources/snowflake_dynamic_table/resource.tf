@@ -0,0 +1,10 @@
+resource "snowflake_dynamic_table" "dt" {
+  database   = "MYDB"
+  schema     = "MYSCHEMA"
+  name       = "ORDERS_BY_DAY"
+  target_lag = "5 minutes"
+  warehouse  = "MYWAREHOUSE"
+  query      = "SELECT DATE_TRUNC('DAY', ORDERED_AT) AS DAY, COUNT(*) AS ORDERS FROM MYDB.MYSCHEMA.ORDERS GROUP BY 1"
+  cluster_by = ["DAY"]
+  comment    = "Daily order counts"
+}
@@ -0,0 +1,28 @@
+resource "snowflake_event_table" "events" {
+  database                    = "MYDB"
+  schema                      = "MYSCHEMA"
+  name                        = "EVENTS"
+  cluster_by                  = ["TIMESTAMP"]
+  data_retention_time_in_days = 7
+  change_tracking             = true
+  comment                     = "Logs and traces of the functions and procedures in MYDB"
+}
+
+// Collect the logs and traces emitted in MYDB in the event table
+resource "snowflake_object_parameter" "event_table" {
+  key         = "EVENT_TABLE"
+  value       = "${snowflake_event_table.events.database}.${snowflake_event_table.events.schema}.${snowflake_event_table.events.name}"
+  object_type = "DATABASE"
+  object_identifier {
+    name = "MYDB"
+  }
+}
+
+resource "snowflake_object_parameter" "log_level" {
+  key         = "LOG_LEVEL"
+  value       = "INFO"
+  object_type = "DATABASE"
+  object_identifier {
+    name = "MYDB"
+  }
+}
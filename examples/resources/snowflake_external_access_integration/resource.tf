@@ -0,0 +1,24 @@
+resource "snowflake_network_rule" "external_api" {
+  database   = "MYDB"
+  schema     = "MYSCHEMA"
+  name       = "EXTERNAL_API"
+  type       = "HOST_PORT"
+  mode       = "EGRESS"
+  value_list = ["api.example.com:443"]
+}
+
+resource "snowflake_secret" "api_key" {
+  database      = "MYDB"
+  schema        = "MYSCHEMA"
+  name          = "API_KEY"
+  type          = "GENERIC_STRING"
+  secret_string = var.api_key
+}
+
+resource "snowflake_external_access_integration" "external_api" {
+  name                           = "EXTERNAL_API_ACCESS"
+  allowed_network_rules          = [snowflake_network_rule.external_api.qualified_name]
+  allowed_authentication_secrets = ["MYDB.MYSCHEMA.${snowflake_secret.api_key.name}"]
+  enabled                        = true
+  comment                        = "Access to the external API"
+}
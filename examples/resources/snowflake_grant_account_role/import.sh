@@ -0,0 +1,2 @@
+# format is role_name|grantee_object_type|grantee_name, where grantee_object_type is one of ROLE or USER
+terraform import snowflake_grant_account_role.example '"analyst"|USER|"jdoe"'
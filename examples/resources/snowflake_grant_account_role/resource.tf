@@ -0,0 +1,11 @@
+# grant a role to another role
+resource "snowflake_grant_account_role" "role" {
+  role_name        = "analyst"
+  parent_role_name = "SYSADMIN"
+}
+
+# grant a role to a user
+resource "snowflake_grant_account_role" "user" {
+  role_name = "analyst"
+  user_name = "jdoe"
+}
@@ -0,0 +1,2 @@
+# format is database_role_name|parent_object_type|parent_name, where parent_object_type is one of ROLE, DATABASE ROLE or SHARE
+terraform import snowflake_grant_database_role.example '"analytics"."reader"|ROLE|"analyst"'
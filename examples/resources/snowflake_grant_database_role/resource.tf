@@ -0,0 +1,22 @@
+resource "snowflake_database_role" "database_role" {
+  database = "analytics"
+  name     = "reader"
+}
+
+# grant a database role to an account role
+resource "snowflake_grant_database_role" "account_role" {
+  database_role_name = "\"${snowflake_database_role.database_role.database}\".\"${snowflake_database_role.database_role.name}\""
+  parent_role_name   = "analyst"
+}
+
+# grant a database role to another database role in the same database
+resource "snowflake_grant_database_role" "database_role" {
+  database_role_name        = "\"${snowflake_database_role.database_role.database}\".\"${snowflake_database_role.database_role.name}\""
+  parent_database_role_name = "\"analytics\".\"writer\""
+}
+
+# grant a database role to a share
+resource "snowflake_grant_database_role" "share" {
+  database_role_name = "\"${snowflake_database_role.database_role.database}\".\"${snowflake_database_role.database_role.name}\""
+  share_name         = "analytics_share"
+}
@@ -0,0 +1,3 @@
+# format is role_name|with_grant_option|on_account|on_account_object|on_schema|on_schema_object|all|future|object_type|object_name
+# every privilege the role holds on the target is imported
+terraform import snowflake_grant_privileges_to_role.example "analyst|true|false|true|false|false|false|false|DATABASE|analytics"
@@ -0,0 +1,42 @@
+resource "snowflake_role" "role" {
+  name = "analyst"
+}
+
+# global privileges
+resource "snowflake_grant_privileges_to_role" "account" {
+  role_name  = snowflake_role.role.name
+  privileges = ["MONITOR USAGE", "CREATE DATABASE"]
+  on_account = true
+}
+
+# privileges on an account object, with grant option
+resource "snowflake_grant_privileges_to_role" "database" {
+  role_name         = snowflake_role.role.name
+  privileges        = ["USAGE", "MONITOR"]
+  with_grant_option = true
+  on_account_object {
+    object_type = "DATABASE"
+    object_name = "analytics"
+  }
+}
+
+# all privileges on a schema
+resource "snowflake_grant_privileges_to_role" "schema" {
+  role_name      = snowflake_role.role.name
+  all_privileges = true
+  on_schema {
+    schema_name = "analytics.reporting"
+  }
+}
+
+# privileges on all future tables in a schema
+resource "snowflake_grant_privileges_to_role" "future_tables" {
+  role_name  = snowflake_role.role.name
+  privileges = ["SELECT", "INSERT"]
+  on_schema_object {
+    future {
+      object_type_plural = "TABLES"
+      in_schema          = "analytics.reporting"
+    }
+  }
+}
@@ -5,3 +5,18 @@ resource "snowflake_network_policy" "policy" {
   allowed_ip_list = ["192.168.0.100/24"]
   blocked_ip_list = ["192.168.0.101"]
 }
+
+resource "snowflake_network_rule" "corporate" {
+  database   = "MYDB"
+  schema     = "MYSCHEMA"
+  name       = "CORPORATE_NETWORK"
+  type       = "IPV4"
+  mode       = "INGRESS"
+  value_list = ["192.168.0.0/24"]
+}
+
+resource "snowflake_network_policy" "rules" {
+  name = "rules_policy"
+
+  allowed_network_rule_list = [snowflake_network_rule.corporate.qualified_name]
+}
@@ -0,0 +1,2 @@
+# format is database name | schema name | network rule name
+terraform import snowflake_network_rule.example 'dbName|schemaName|networkRuleName'
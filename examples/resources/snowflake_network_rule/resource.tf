@@ -0,0 +1,18 @@
+resource "snowflake_network_rule" "corporate" {
+  database   = "MYDB"
+  schema     = "MYSCHEMA"
+  name       = "CORPORATE_NETWORK"
+  type       = "IPV4"
+  mode       = "INGRESS"
+  value_list = ["192.168.0.0/24", "10.0.0.1"]
+  comment    = "Corporate network"
+}
+
+resource "snowflake_network_rule" "external_api" {
+  database   = "MYDB"
+  schema     = "MYSCHEMA"
+  name       = "EXTERNAL_API"
+  type       = "HOST_PORT"
+  mode       = "EGRESS"
+  value_list = ["api.example.com:443"]
+}
@@ -0,0 +1,26 @@
+resource "snowflake_secret" "password" {
+  database = "MYDB"
+  schema   = "MYSCHEMA"
+  name     = "SERVICE_CREDENTIALS"
+  type     = "PASSWORD"
+  username = "service_user"
+  password = var.service_password
+  comment  = "Credentials of the external service"
+}
+
+resource "snowflake_secret" "api_key" {
+  database      = "MYDB"
+  schema        = "MYSCHEMA"
+  name          = "API_KEY"
+  type          = "GENERIC_STRING"
+  secret_string = var.api_key
+}
+
+resource "snowflake_secret" "oauth" {
+  database           = "MYDB"
+  schema             = "MYSCHEMA"
+  name               = "OAUTH_CLIENT"
+  type               = "OAUTH2"
+  api_authentication = "MY_API_AUTHENTICATION_INTEGRATION"
+  oauth_scopes       = ["read", "write"]
+}
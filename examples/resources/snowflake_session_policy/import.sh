@@ -0,0 +1,2 @@
+# format is database name | schema name | policy name
+terraform import snowflake_session_policy.example 'dbName|schemaName|policyName'
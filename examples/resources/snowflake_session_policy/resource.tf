@@ -0,0 +1,8 @@
+resource "snowflake_session_policy" "policy" {
+  database                     = "MYDB"
+  schema                       = "MYSCHEMA"
+  name                         = "IDLE_TIMEOUT"
+  session_idle_timeout_mins    = 30
+  session_ui_idle_timeout_mins = 60
+  comment                      = "Session idle timeouts for all users"
+}
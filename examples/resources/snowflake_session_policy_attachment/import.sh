@@ -0,0 +1,4 @@
+# format is database name | schema name | policy name | ACCOUNT
+terraform import snowflake_session_policy_attachment.account 'dbName|schemaName|policyName|ACCOUNT'
+# format is database name | schema name | policy name | USER | user name
+terraform import snowflake_session_policy_attachment.user 'dbName|schemaName|policyName|USER|userName'
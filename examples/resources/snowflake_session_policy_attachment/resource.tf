@@ -0,0 +1,9 @@
+resource "snowflake_session_policy_attachment" "account" {
+  session_policy_id = snowflake_session_policy.policy.id
+  set_for_account   = true
+}
+
+resource "snowflake_session_policy_attachment" "user" {
+  session_policy_id = snowflake_session_policy.policy.id
+  user              = "USER1"
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"log"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -81,7 +82,7 @@ func Alerts() *schema.Resource {
 
 // ReadAlerts Reads the database metadata information.
 func ReadAlerts(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	d.SetId("alerts_read")
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
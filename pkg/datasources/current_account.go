@@ -1,10 +1,10 @@
 package datasources
 
 import (
-	"database/sql"
 	"fmt"
 	"log"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -39,7 +39,7 @@ func CurrentAccount() *schema.Resource {
 
 // ReadCurrentAccount read the current snowflake account information.
 func ReadCurrentAccount(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	acc, err := snowflake.ReadCurrentAccount(db)
 	if err != nil {
 		log.Println("[DEBUG] current_account failed to decode")
@@ -1,10 +1,10 @@
 package datasources
 
 import (
-	"database/sql"
 	"fmt"
 	"log"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -25,7 +25,7 @@ func CurrentRole() *schema.Resource {
 }
 
 func ReadCurrentRole(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	role, err := snowflake.ReadCurrentRole(db)
 	if err != nil {
 		log.Printf("[DEBUG] current_role failed to decode")
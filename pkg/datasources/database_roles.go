@@ -5,6 +5,7 @@ import (
 	"errors"
 	"log"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -51,7 +52,7 @@ func DatabaseRoles() *schema.Resource {
 
 // ReadDatabaseRoles Reads the database metadata information.
 func ReadDatabaseRoles(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	d.SetId("database_roles_read")
 	databaseName := d.Get("database").(string)
 
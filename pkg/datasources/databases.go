@@ -2,8 +2,8 @@ package datasources
 
 import (
 	"context"
-	"database/sql"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -105,8 +105,7 @@ func Databases() *schema.Resource {
 
 // ReadDatabases read the current snowflake account information.
 func ReadDatabases(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 	opts := sdk.ShowDatabasesOptions{}
 	if terse, ok := d.GetOk("terse"); ok {
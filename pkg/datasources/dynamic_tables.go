@@ -0,0 +1,119 @@
+package datasources
+
+import (
+	"context"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var dynamicTablesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the dynamic tables from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the dynamic tables from.",
+	},
+	"dynamic_tables": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The dynamic tables in the schema",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"target_lag": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"warehouse": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"refresh_mode": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"scheduling_state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"data_timestamp": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func DynamicTables() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadDynamicTables,
+		Schema: dynamicTablesSchema,
+	}
+}
+
+func ReadDynamicTables(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+
+	dynamicTables, err := client.DynamicTables.Show(ctx, &sdk.ShowDynamicTableOptions{
+		In: &sdk.In{
+			Schema: sdk.NewSchemaIdentifier(databaseName, schemaName),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	dynamicTablesList := []map[string]interface{}{}
+	for _, dynamicTable := range dynamicTables {
+		dynamicTableMap := map[string]interface{}{}
+		dynamicTableMap["name"] = dynamicTable.Name
+		dynamicTableMap["database"] = dynamicTable.DatabaseName
+		dynamicTableMap["schema"] = dynamicTable.SchemaName
+		dynamicTableMap["target_lag"] = dynamicTable.TargetLag
+		dynamicTableMap["warehouse"] = dynamicTable.Warehouse
+		dynamicTableMap["refresh_mode"] = string(dynamicTable.RefreshMode)
+		dynamicTableMap["scheduling_state"] = string(dynamicTable.SchedulingState)
+		dynamicTableMap["data_timestamp"] = ""
+		if dynamicTable.DataTimestamp != nil {
+			dynamicTableMap["data_timestamp"] = dynamicTable.DataTimestamp.Format(time.RFC3339)
+		}
+		dynamicTableMap["comment"] = dynamicTable.Comment
+		dynamicTableMap["owner"] = dynamicTable.Owner
+		dynamicTablesList = append(dynamicTablesList, dynamicTableMap)
+	}
+	if err := d.Set("dynamic_tables", dynamicTablesList); err != nil {
+		return err
+	}
+	d.SetId(helpers.EncodeSnowflakeID(databaseName, schemaName))
+	return nil
+}
@@ -0,0 +1,80 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_DynamicTables(t *testing.T) {
+	databaseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	schemaName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	dynamicTableName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: dynamicTables(databaseName, schemaName, dynamicTableName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_dynamic_tables.t", "database", databaseName),
+					resource.TestCheckResourceAttr("data.snowflake_dynamic_tables.t", "schema", schemaName),
+					resource.TestCheckResourceAttr("data.snowflake_dynamic_tables.t", "dynamic_tables.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_dynamic_tables.t", "dynamic_tables.0.name", dynamicTableName),
+					resource.TestCheckResourceAttr("data.snowflake_dynamic_tables.t", "dynamic_tables.0.target_lag", "1 minute"),
+					resource.TestCheckResourceAttr("data.snowflake_dynamic_tables.t", "dynamic_tables.0.comment", "Terraform acceptance test"),
+				),
+			},
+		},
+	})
+}
+
+func dynamicTables(databaseName string, schemaName string, dynamicTableName string) string {
+	return fmt.Sprintf(`
+
+	resource snowflake_database "test" {
+		name = "%[1]v"
+	}
+
+	resource snowflake_schema "test"{
+		name 	 = "%[2]v"
+		database = snowflake_database.test.name
+	}
+
+	resource "snowflake_warehouse" "test" {
+		name           = "%[3]v"
+		warehouse_size = "XSMALL"
+	}
+
+	resource "snowflake_table" "test" {
+		database        = snowflake_database.test.name
+		schema          = snowflake_schema.test.name
+		name            = "SOURCE"
+		change_tracking = true
+
+		column {
+			name = "ID"
+			type = "NUMBER(38,0)"
+		}
+	}
+
+	resource "snowflake_dynamic_table" "test" {
+		name       = "%[3]v"
+		database   = snowflake_database.test.name
+		schema     = snowflake_schema.test.name
+		target_lag = "1 minute"
+		warehouse  = snowflake_warehouse.test.name
+		query      = "SELECT ID FROM \"${snowflake_database.test.name}\".\"${snowflake_schema.test.name}\".\"${snowflake_table.test.name}\""
+		comment    = "Terraform acceptance test"
+	}
+
+	data snowflake_dynamic_tables "t" {
+		database   = snowflake_dynamic_table.test.database
+		schema     = snowflake_dynamic_table.test.schema
+		depends_on = [snowflake_dynamic_table.test]
+	}
+	`, databaseName, schemaName, dynamicTableName)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -62,7 +63,7 @@ func ExternalFunctions() *schema.Resource {
 }
 
 func ReadExternalFunctions(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	databaseName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
 
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"log"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -35,7 +36,7 @@ func Role() *schema.Resource {
 
 // ReadRole Reads the database metadata information.
 func ReadRole(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	roleName := d.Get("name").(string)
 	role, err := snowflake.NewRoleBuilder(db, roleName).Show()
 
@@ -1,11 +1,11 @@
 package datasources
 
 import (
-	"database/sql"
-	"errors"
+	"context"
 	"log"
 
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -47,19 +47,20 @@ func Schemas() *schema.Resource {
 }
 
 func ReadSchemas(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
 	databaseName := d.Get("database").(string)
 
 	log.Printf("[DEBUG] database name %s", databaseName)
 
-	currentSchemas, err := snowflake.ListSchemas(databaseName, db)
-	if errors.Is(err, sql.ErrNoRows) {
-		// If not found, mark resource to be removed from state file during apply or refresh
-		log.Printf("[DEBUG] schemas in database (%s) not found", d.Id())
-		d.SetId("")
-		return nil
-	} else if err != nil {
-		log.Printf("[DEBUG] unable to parse schemas in database (%s)", d.Id())
+	currentSchemas, err := client.Schemas.Show(ctx, &sdk.ShowSchemaOptions{
+		In: &sdk.SchemaIn{
+			Database: sdk.Bool(true),
+			Name:     sdk.NewAccountObjectIdentifier(databaseName),
+		},
+	})
+	if err != nil {
+		log.Printf("[DEBUG] unable to show schemas in database (%s)", databaseName)
 		d.SetId("")
 		return nil
 	}
@@ -69,9 +70,9 @@ func ReadSchemas(d *schema.ResourceData, meta interface{}) error {
 	for _, schema := range currentSchemas {
 		schemaMap := map[string]interface{}{}
 
-		schemaMap["name"] = schema.Name.String
-		schemaMap["database"] = schema.DatabaseName.String
-		schemaMap["comment"] = schema.Comment.String
+		schemaMap["name"] = schema.Name
+		schemaMap["database"] = schema.DatabaseName
+		schemaMap["comment"] = schema.Comment
 
 		schemas = append(schemas, schemaMap)
 	}
@@ -0,0 +1,89 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var sessionPoliciesSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The database from which to return the session policies from.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The schema from which to return the session policies from.",
+	},
+	"session_policies": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The session policies in the schema",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"database": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"schema": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"comment": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"owner": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+}
+
+func SessionPolicies() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadSessionPolicies,
+		Schema: sessionPoliciesSchema,
+	}
+}
+
+func ReadSessionPolicies(d *schema.ResourceData, meta interface{}) error {
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+	sessionPolicies, err := client.SessionPolicies.Show(ctx, &sdk.SessionPolicyShowOptions{
+		In: &sdk.In{
+			Schema: sdk.NewSchemaIdentifier(databaseName, schemaName),
+		},
+	})
+	if err != nil {
+		return err
+	}
+	sessionPoliciesList := []map[string]interface{}{}
+	for _, sessionPolicy := range sessionPolicies {
+		sessionPolicyMap := map[string]interface{}{}
+		sessionPolicyMap["name"] = sessionPolicy.Name
+		sessionPolicyMap["database"] = sessionPolicy.DatabaseName
+		sessionPolicyMap["schema"] = sessionPolicy.SchemaName
+		sessionPolicyMap["comment"] = sessionPolicy.Comment
+		sessionPolicyMap["owner"] = sessionPolicy.Owner
+		sessionPoliciesList = append(sessionPoliciesList, sessionPolicyMap)
+	}
+	if err := d.Set("session_policies", sessionPoliciesList); err != nil {
+		return err
+	}
+	d.SetId(helpers.EncodeSnowflakeID(databaseName, schemaName))
+	return nil
+}
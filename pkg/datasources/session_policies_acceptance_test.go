@@ -0,0 +1,59 @@
+package datasources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_SessionPolicies(t *testing.T) {
+	databaseName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	schemaName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	sessionPolicyName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: sessionPolicies(databaseName, schemaName, sessionPolicyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.snowflake_session_policies.t", "database", databaseName),
+					resource.TestCheckResourceAttr("data.snowflake_session_policies.t", "schema", schemaName),
+					resource.TestCheckResourceAttr("data.snowflake_session_policies.t", "session_policies.#", "1"),
+					resource.TestCheckResourceAttr("data.snowflake_session_policies.t", "session_policies.0.name", sessionPolicyName),
+					resource.TestCheckResourceAttr("data.snowflake_session_policies.t", "session_policies.0.comment", "Terraform acceptance test"),
+				),
+			},
+		},
+	})
+}
+
+func sessionPolicies(databaseName string, schemaName string, sessionPolicyName string) string {
+	return fmt.Sprintf(`
+
+	resource snowflake_database "test" {
+		name = "%v"
+	}
+
+	resource snowflake_schema "test"{
+		name 	 = "%v"
+		database = snowflake_database.test.name
+	}
+
+	resource "snowflake_session_policy" "test" {
+		name     = "%v"
+		database = snowflake_database.test.name
+		schema   = snowflake_schema.test.name
+		comment  = "Terraform acceptance test"
+	}
+
+	data snowflake_session_policies "t" {
+		database   = snowflake_session_policy.test.database
+		schema     = snowflake_session_policy.test.schema
+		depends_on = [snowflake_session_policy.test]
+	}
+	`, databaseName, schemaName, sessionPolicyName)
+}
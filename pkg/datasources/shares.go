@@ -2,8 +2,8 @@ package datasources
 
 import (
 	"context"
-	"database/sql"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -61,10 +61,9 @@ func Shares() *schema.Resource {
 
 // ReadShares Reads the database metadata information.
 func ReadShares(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
 	d.SetId("shares_read")
 	pattern := d.Get("pattern").(string)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 	var opts sdk.ShowShareOptions
 	if pattern != "" {
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -48,16 +49,10 @@ func StorageIntegrations() *schema.Resource {
 }
 
 func ReadStorageIntegrations(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	providerContext := meta.(*provider.Context)
+	db := providerContext.DB
 
-	account, err := snowflake.ReadCurrentAccount(db)
-	if err != nil {
-		log.Print("[DEBUG] unable to retrieve current account")
-		d.SetId("")
-		return nil
-	}
-
-	d.SetId(fmt.Sprintf("%s.%s", account.Account, account.Region))
+	d.SetId(fmt.Sprintf("%s.%s", providerContext.AccountLocator, providerContext.Region))
 
 	currentStorageIntegrations, err := snowflake.ListStorageIntegrations(db)
 	if errors.Is(err, sql.ErrNoRows) {
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"log"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -32,7 +33,7 @@ func SystemGetAWSSNSIAMPolicy() *schema.Resource {
 
 // ReadSystemGetAWSSNSIAMPolicy implements schema.ReadFunc.
 func ReadSystemGetAWSSNSIAMPolicy(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	awsSNSTopicArn := d.Get("aws_sns_topic_arn").(string)
 
 	sel := snowflake.NewSystemGetAWSSNSIAMPolicyBuilder(awsSNSTopicArn).Select()
@@ -1,15 +1,13 @@
 package datasources
 
 import (
-	"database/sql"
-	"errors"
+	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -106,26 +104,21 @@ func Users() *schema.Resource {
 }
 
 func ReadUsers(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	userPattern := d.Get("pattern").(string)
+	providerContext := meta.(*provider.Context)
+	client := providerContext.Client
+	ctx := context.Background()
 
-	account, err := snowflake.ReadCurrentAccount(db)
-	if err != nil {
-		log.Print("[DEBUG] unable to retrieve current account")
-		d.SetId("")
-		return nil
-	}
+	userPattern := d.Get("pattern").(string)
 
-	d.SetId(fmt.Sprintf("%s.%s", account.Account, account.Region))
+	d.SetId(fmt.Sprintf("%s.%s", providerContext.AccountLocator, providerContext.Region))
 
-	currentUsers, err := snowflake.ListUsers(userPattern, db)
-	if errors.Is(err, sql.ErrNoRows) {
-		// If not found, mark resource to be removed from state file during apply or refresh
-		log.Printf("[DEBUG] no users found in account (%s)", d.Id())
-		d.SetId("")
-		return nil
-	} else if err != nil {
-		log.Printf("[DEBUG] unable to parse users in account (%s)", d.Id())
+	currentUsers, err := client.Users.Show(ctx, &sdk.ShowUserOptions{
+		Like: &sdk.Like{
+			Pattern: sdk.String(userPattern),
+		},
+	})
+	if err != nil {
+		log.Printf("[DEBUG] unable to show users in account (%s)", d.Id())
 		d.SetId("")
 		return nil
 	}
@@ -134,20 +127,19 @@ func ReadUsers(d *schema.ResourceData, meta interface{}) error {
 
 	for _, user := range currentUsers {
 		userMap := map[string]interface{}{}
-		userMap["name"] = user.Name.String
-		userMap["login_name"] = user.LoginName.String
-		userMap["comment"] = user.Comment.String
+		userMap["name"] = user.Name
+		userMap["login_name"] = user.LoginName
+		userMap["comment"] = user.Comment
 		userMap["disabled"] = user.Disabled
-		userMap["default_warehouse"] = user.DefaultWarehouse.String
-		userMap["default_namespace"] = user.DefaultNamespace.String
-		userMap["default_role"] = user.DefaultRole.String
-		userMap["default_secondary_roles"] = strings.Split(
-			helpers.ListContentToString(user.DefaultSecondaryRoles.String), ",")
-		userMap["has_rsa_public_key"] = user.HasRsaPublicKey
-		userMap["email"] = user.Email.String
-		userMap["display_name"] = user.DisplayName.String
-		userMap["first_name"] = user.FirstName.String
-		userMap["last_name"] = user.LastName.String
+		userMap["default_warehouse"] = user.DefaultWarehouse
+		userMap["default_namespace"] = user.DefaultNamespace
+		userMap["default_role"] = user.DefaultRole
+		userMap["default_secondary_roles"] = helpers.StringListToList(helpers.ListContentToString(user.DefaultSecondaryRoles))
+		userMap["has_rsa_public_key"] = user.HasRSAPublicKey
+		userMap["email"] = user.Email
+		userMap["display_name"] = user.DisplayName
+		userMap["first_name"] = user.FirstName
+		userMap["last_name"] = user.LastName
 
 		users = append(users, userMap)
 	}
@@ -2,11 +2,9 @@ package datasources
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -58,16 +56,11 @@ func Warehouses() *schema.Resource {
 }
 
 func ReadWarehouses(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	providerContext := meta.(*provider.Context)
+	client := providerContext.Client
 	ctx := context.Background()
 
-	account, err := snowflake.ReadCurrentAccount(db)
-	if err != nil {
-		d.SetId("")
-		return nil
-	}
-	d.SetId(fmt.Sprintf("%s.%s", account.Account, account.Region))
+	d.SetId(fmt.Sprintf("%s.%s", providerContext.AccountLocator, providerContext.Region))
 
 	result, err := client.Warehouses.Show(ctx, nil)
 	if err != nil {
@@ -3,14 +3,19 @@ package db
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
+	"sync"
 
 	"github.com/luna-duclos/instrumentedsql"
 	"github.com/snowflakedb/gosnowflake"
 )
 
+var instrumentedDriver instrumentedsql.WrappedDriver
+
 func init() {
 	re := regexp.MustCompile(`\r?\n`)
 
@@ -19,9 +24,116 @@ func init() {
 		log.Println(re.ReplaceAllString(s, " "))
 	})
 
-	sql.Register("snowflake-instrumented", instrumentedsql.WrapDriver(&gosnowflake.SnowflakeDriver{}, instrumentedsql.WithLogger(logger)))
+	instrumentedDriver = instrumentedsql.WrapDriver(&gosnowflake.SnowflakeDriver{}, instrumentedsql.WithLogger(logger))
+	sql.Register("snowflake-instrumented", instrumentedDriver)
 }
 
+var (
+	poolsMu sync.Mutex
+	pools   = map[string]*sql.DB{}
+)
+
+// Open returns a database for the DSN. Identical DSNs share a database, so that provider aliases connecting
+// to the same account with the same settings share one connection pool.
 func Open(dsn string) (*sql.DB, error) {
-	return sql.Open("snowflake-instrumented", dsn)
+	poolsMu.Lock()
+	defer poolsMu.Unlock()
+
+	if db, ok := pools[dsn]; ok {
+		return db, nil
+	}
+	db, err := sql.Open("snowflake-instrumented", dsn)
+	if err != nil {
+		return nil, err
+	}
+	pools[dsn] = db
+	return db, nil
+}
+
+// TokenSource provides the OAuth access tokens connections log in with.
+type TokenSource interface {
+	// Token returns an access token which is valid for logging in.
+	Token() (string, error)
+	// Invalidate discards the current access token, so that the next call to Token returns a new one.
+	Invalidate()
+}
+
+// OpenWithTokenSource returns a database whose connections log in with an OAuth access token of the token source,
+// instead of the token of the config. A login rejected because of the token is retried once with a new token.
+// Like in Open, configs with an identical DSN share a database, regardless of the token they carry.
+func OpenWithTokenSource(config *gosnowflake.Config, source TokenSource) (*sql.DB, error) {
+	keyConfig := *config
+	keyConfig.Authenticator = gosnowflake.AuthTypeOAuth
+	keyConfig.Token = ""
+	dsn, err := gosnowflake.DSN(&keyConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	poolsMu.Lock()
+	defer poolsMu.Unlock()
+
+	if db, ok := pools[dsn]; ok {
+		return db, nil
+	}
+	db := sql.OpenDB(&tokenConnector{config: *config, source: source})
+	pools[dsn] = db
+	return db, nil
+}
+
+type tokenConnector struct {
+	config gosnowflake.Config
+	source TokenSource
+}
+
+func (c *tokenConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.connect(ctx)
+	if IsTokenRejected(err) {
+		log.Printf("[DEBUG] OAuth access token was rejected, retrying with a new one: %v\n", err)
+		c.source.Invalidate()
+		conn, err = c.connect(ctx)
+	}
+	return conn, err
+}
+
+func (c *tokenConnector) connect(ctx context.Context) (driver.Conn, error) {
+	token, err := c.source.Token()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve OAuth access token err = %w", err)
+	}
+	config := c.config
+	config.Authenticator = gosnowflake.AuthTypeOAuth
+	config.Token = token
+	dsn, err := gosnowflake.DSN(&config)
+	if err != nil {
+		return nil, err
+	}
+	connector, err := instrumentedDriver.OpenConnector(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return connector.Connect(ctx)
+}
+
+func (c *tokenConnector) Driver() driver.Driver {
+	return instrumentedDriver
+}
+
+const (
+	errCodeInvalidOauthAccessToken = 390303
+	errCodeOauthAccessTokenExpired = 390318
+)
+
+// IsTokenRejected returns true if Snowflake refused a login because of its OAuth access token, e.g. when
+// the token expired.
+func IsTokenRejected(err error) bool {
+	var sfErr *gosnowflake.SnowflakeError
+	if !errors.As(err, &sfErr) {
+		return false
+	}
+	switch sfErr.Number {
+	case errCodeInvalidOauthAccessToken, errCodeOauthAccessTokenExpired:
+		return true
+	}
+	return false
 }
@@ -0,0 +1,51 @@
+package db_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/db"
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsTokenRejected(t *testing.T) {
+	require.True(t, db.IsTokenRejected(&gosnowflake.SnowflakeError{Number: 390318, Message: "OAuth access token expired."}))
+	require.True(t, db.IsTokenRejected(fmt.Errorf("login failed: %w", &gosnowflake.SnowflakeError{Number: 390303})))
+	require.False(t, db.IsTokenRejected(&gosnowflake.SnowflakeError{Number: gosnowflake.ErrCodeFailedToConnect}))
+	require.False(t, db.IsTokenRejected(&gosnowflake.SnowflakeError{Number: 2003}))
+	require.False(t, db.IsTokenRejected(errors.New("account is empty")))
+	require.False(t, db.IsTokenRejected(nil))
+}
+
+func TestOpen(t *testing.T) {
+	first, err := db.Open("user:pass@acct.snowflakecomputing.com:443?role=first")
+	require.NoError(t, err)
+	same, err := db.Open("user:pass@acct.snowflakecomputing.com:443?role=first")
+	require.NoError(t, err)
+	other, err := db.Open("user:pass@acct.snowflakecomputing.com:443?role=other")
+	require.NoError(t, err)
+
+	require.Same(t, first, same)
+	require.NotSame(t, first, other)
+}
+
+type staticTokenSource string
+
+func (s staticTokenSource) Token() (string, error) { return string(s), nil }
+
+func (s staticTokenSource) Invalidate() {}
+
+func TestOpenWithTokenSource(t *testing.T) {
+	config := &gosnowflake.Config{Account: "acct", User: "user", Role: "first", Token: "first-token"}
+	first, err := db.OpenWithTokenSource(config, staticTokenSource("first-token"))
+	require.NoError(t, err)
+	same, err := db.OpenWithTokenSource(&gosnowflake.Config{Account: "acct", User: "user", Role: "first", Token: "second-token"}, staticTokenSource("second-token"))
+	require.NoError(t, err)
+	other, err := db.OpenWithTokenSource(&gosnowflake.Config{Account: "acct", User: "user", Role: "other"}, staticTokenSource("first-token"))
+	require.NoError(t, err)
+
+	require.Same(t, first, same)
+	require.NotSame(t, first, other)
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+// Context is the provider meta passed to every resource and data source.
+type Context struct {
+	// Client is the SDK client sharing the connection pool of DB.
+	Client *sdk.Client
+	// DB is the underlying connection pool, for code that has not moved to the SDK yet.
+	DB *sql.DB
+
+	// AccountLocator, Region and Role describe the session opened when the provider was configured.
+	AccountLocator string
+	Region         string
+	Role           string
+	// Edition is the edition of the account, or empty if the session is not allowed to read it.
+	Edition sdk.AccountEdition
+}
+
+// NewContext wraps a connection pool without any session details, e.g. for tests.
+func NewContext(db *sql.DB) *Context {
+	return &Context{
+		Client: sdk.NewClientFromDB(db),
+		DB:     db,
+	}
+}
+
+// NewSessionContext wraps a connection pool and describes its session, so that resources can make
+// account-aware decisions without querying it again.
+func NewSessionContext(ctx context.Context, db *sql.DB) (*Context, error) {
+	c := NewContext(db)
+	details, err := c.Client.ContextFunctions.CurrentSessionDetails(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve session details err = %w", err)
+	}
+	log.Printf("[INFO] Snowflake DB connection opened, session ID : %s\n", details.Session)
+	c.AccountLocator = details.Account
+	c.Region = details.Region
+	c.Role = details.Role
+
+	edition, err := c.Client.ContextFunctions.CurrentAccountEdition(ctx)
+	if err != nil {
+		log.Printf("[DEBUG] could not retrieve account edition err = %v\n", err)
+	}
+	c.Edition = edition
+	return c, nil
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+// withMockDb mirrors testhelpers.WithMockDb, which cannot be used here since it depends on this package.
+func withMockDb(t *testing.T, f func(*sql.DB, sqlmock.Sqlmock)) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	f(db, mock)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func expectSessionDetails(mock sqlmock.Sqlmock, role string) {
+	rows := sqlmock.NewRows([]string{"CURRENT_ACCOUNT", "CURRENT_ROLE", "CURRENT_REGION", "CURRENT_SESSION", "CURRENT_USER"}).
+		AddRow("AB12345", role, "AWS_US_WEST_2", "1234", "ADMIN")
+	mock.ExpectQuery(`^SELECT CURRENT_ACCOUNT\(\) as CURRENT_ACCOUNT, CURRENT_ROLE\(\) as CURRENT_ROLE`).WillReturnRows(rows)
+}
+
+const accountEditionSQL = `^SELECT PARSE_JSON\(SYSTEM\$BOOTSTRAP_DATA_REQUEST\('ACCOUNT'\)\):accountInfo.serviceLevelName::VARCHAR AS EDITION$`
+
+func TestNewSessionContext(t *testing.T) {
+	t.Run("session details", func(t *testing.T) {
+		withMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+			expectSessionDetails(mock, "SYSADMIN")
+			mock.ExpectQuery(accountEditionSQL).WillReturnRows(sqlmock.NewRows([]string{"EDITION"}).AddRow("ENTERPRISE"))
+
+			c, err := NewSessionContext(context.Background(), db)
+			require.NoError(t, err)
+			require.Same(t, db, c.DB)
+			require.NotNil(t, c.Client)
+			require.Equal(t, "AB12345", c.AccountLocator)
+			require.Equal(t, "AWS_US_WEST_2", c.Region)
+			require.Equal(t, "SYSADMIN", c.Role)
+			require.Equal(t, sdk.EditionEnterprise, c.Edition)
+		})
+	})
+
+	t.Run("edition is best effort", func(t *testing.T) {
+		withMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+			expectSessionDetails(mock, "SYSADMIN")
+			mock.ExpectQuery(accountEditionSQL).WillReturnError(errors.New("insufficient privileges"))
+
+			c, err := NewSessionContext(context.Background(), db)
+			require.NoError(t, err)
+			require.Equal(t, "SYSADMIN", c.Role)
+			require.Empty(t, c.Edition)
+		})
+	})
+
+	t.Run("session error", func(t *testing.T) {
+		withMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+			mock.ExpectQuery(`^SELECT CURRENT_ACCOUNT\(\)`).WillReturnError(errors.New("connection refused"))
+
+			_, err := NewSessionContext(context.Background(), db)
+			require.ErrorContains(t, err, "could not retrieve session details")
+		})
+	})
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// oauthTokenExpiryMargin is how long before its expiry an access token is refreshed, so that it does not expire
+// while a connection is logging in.
+const oauthTokenExpiryMargin = time.Minute
+
+// OauthTokenSource exchanges an OAuth refresh token for access tokens. An access token is reused until shortly before
+// it expires. With a cache path, access tokens are shared with the other provider processes of a Terraform run.
+type OauthTokenSource struct {
+	endpoint     string
+	clientID     string
+	clientSecret string
+	refreshToken string
+	redirectURL  string
+	cachePath    string
+
+	mu    sync.Mutex
+	token *oauthToken
+}
+
+type oauthToken struct {
+	AccessToken string    `json:"access_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+func (t *oauthToken) valid() bool {
+	// tokens without expires_in are used until Snowflake rejects them
+	return t != nil && t.AccessToken != "" && (t.ExpiresAt.IsZero() || time.Now().Add(oauthTokenExpiryMargin).Before(t.ExpiresAt))
+}
+
+func NewOauthTokenSource(endpoint, clientID, clientSecret, refreshToken, redirectURL, cachePath string) *OauthTokenSource {
+	return &OauthTokenSource{
+		endpoint:     endpoint,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		refreshToken: refreshToken,
+		redirectURL:  redirectURL,
+		cachePath:    cachePath,
+	}
+}
+
+// Token returns a valid access token, requesting a new one from the token endpoint if needed.
+func (s *OauthTokenSource) Token() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.valid() {
+		return s.token.AccessToken, nil
+	}
+	if token := s.readCache(); token.valid() {
+		log.Printf("[DEBUG] using cached OAuth access token expiring at %v\n", token.ExpiresAt)
+		s.token = token
+		return token.AccessToken, nil
+	}
+
+	result, err := GetOauthToken(s.endpoint, s.clientID, s.clientSecret, GetOauthData(s.refreshToken, s.redirectURL))
+	if err != nil {
+		return "", err
+	}
+	token := &oauthToken{AccessToken: result.AccessToken}
+	if result.ExpiresIn > 0 {
+		token.ExpiresAt = time.Now().Add(time.Duration(result.ExpiresIn) * time.Second)
+	}
+	log.Printf("[DEBUG] retrieved OAuth access token expiring at %v\n", token.ExpiresAt)
+	s.token = token
+	s.writeCache(token)
+	return token.AccessToken, nil
+}
+
+// Invalidate discards the current access token, e.g. after Snowflake rejected it.
+func (s *OauthTokenSource) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = nil
+	s.writeCache(nil)
+}
+
+// cacheKey identifies the tokens of a refresh token in the cache, without storing the refresh token itself.
+func (s *OauthTokenSource) cacheKey() string {
+	sum := sha256.Sum256([]byte(s.endpoint + "\n" + s.clientID + "\n" + s.refreshToken))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *OauthTokenSource) loadCache() (string, map[string]*oauthToken, error) {
+	path, err := homedir.Expand(s.cachePath)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid path to token cache err = %w", err)
+	}
+	tokens := map[string]*oauthToken{}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return path, tokens, nil
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	if err := json.Unmarshal(data, &tokens); err != nil {
+		// a corrupt cache is overwritten
+		log.Printf("[DEBUG] ignoring OAuth token cache %s err = %v\n", path, err)
+		return path, map[string]*oauthToken{}, nil
+	}
+	return path, tokens, nil
+}
+
+func (s *OauthTokenSource) readCache() *oauthToken {
+	if s.cachePath == "" {
+		return nil
+	}
+	_, tokens, err := s.loadCache()
+	if err != nil {
+		log.Printf("[DEBUG] could not read OAuth token cache err = %v\n", err)
+		return nil
+	}
+	return tokens[s.cacheKey()]
+}
+
+// writeCache stores the token in the cache, or removes the cached token if token is nil. Failing to update
+// the cache only costs another token request, so errors are logged.
+func (s *OauthTokenSource) writeCache(token *oauthToken) {
+	if s.cachePath == "" {
+		return
+	}
+	path, tokens, err := s.loadCache()
+	if err != nil {
+		log.Printf("[DEBUG] could not read OAuth token cache err = %v\n", err)
+		return
+	}
+	if token == nil {
+		delete(tokens, s.cacheKey())
+	} else {
+		tokens[s.cacheKey()] = token
+	}
+	data, err := json.Marshal(tokens)
+	if err != nil {
+		log.Printf("[DEBUG] could not encode OAuth token cache err = %v\n", err)
+		return
+	}
+	// write to a temporary file first, so that other processes never read a partially written cache
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		log.Printf("[DEBUG] could not create OAuth token cache directory err = %v\n", err)
+		return
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		log.Printf("[DEBUG] could not write OAuth token cache err = %v\n", err)
+		return
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		log.Printf("[DEBUG] could not write OAuth token cache err = %v\n", err)
+	}
+}
@@ -0,0 +1,96 @@
+package provider_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/stretchr/testify/require"
+)
+
+func oauthServer(t *testing.T, expiresIn int) (*httptest.Server, *int32) {
+	t.Helper()
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&requests, 1)
+		require.NoError(t, r.ParseForm())
+		if r.Form.Get("refresh_token") == "expired" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error": "invalid_grant", "error_description": "The refresh token is expired."}`)
+			return
+		}
+		fmt.Fprintf(w, `{"access_token": "token-%d", "token_type": "Bearer", "expires_in": %d}`, n, expiresIn)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestOauthTokenSource(t *testing.T) {
+	t.Run("token is reused until it expires", func(t *testing.T) {
+		server, requests := oauthServer(t, 600)
+		source := provider.NewOauthTokenSource(server.URL, "client", "secret", "refresh", "https://localhost.com", "")
+		for i := 0; i < 2; i++ {
+			token, err := source.Token()
+			require.NoError(t, err)
+			require.Equal(t, "token-1", token)
+		}
+		require.Equal(t, int32(1), atomic.LoadInt32(requests))
+	})
+
+	t.Run("token is refreshed before it expires", func(t *testing.T) {
+		server, requests := oauthServer(t, 30)
+		source := provider.NewOauthTokenSource(server.URL, "client", "secret", "refresh", "https://localhost.com", "")
+		_, err := source.Token()
+		require.NoError(t, err)
+		token, err := source.Token()
+		require.NoError(t, err)
+		require.Equal(t, "token-2", token)
+		require.Equal(t, int32(2), atomic.LoadInt32(requests))
+	})
+
+	t.Run("invalidated token is refreshed", func(t *testing.T) {
+		server, _ := oauthServer(t, 600)
+		source := provider.NewOauthTokenSource(server.URL, "client", "secret", "refresh", "https://localhost.com", "")
+		_, err := source.Token()
+		require.NoError(t, err)
+		source.Invalidate()
+		token, err := source.Token()
+		require.NoError(t, err)
+		require.Equal(t, "token-2", token)
+	})
+
+	t.Run("token is shared through the cache", func(t *testing.T) {
+		server, requests := oauthServer(t, 600)
+		cachePath := filepath.Join(t.TempDir(), "oauth", "tokens.json")
+		token, err := provider.NewOauthTokenSource(server.URL, "client", "secret", "refresh", "https://localhost.com", cachePath).Token()
+		require.NoError(t, err)
+		require.Equal(t, "token-1", token)
+
+		token, err = provider.NewOauthTokenSource(server.URL, "client", "secret", "refresh", "https://localhost.com", cachePath).Token()
+		require.NoError(t, err)
+		require.Equal(t, "token-1", token)
+		require.Equal(t, int32(1), atomic.LoadInt32(requests))
+
+		// tokens of another refresh token are cached separately
+		token, err = provider.NewOauthTokenSource(server.URL, "client", "secret", "other", "https://localhost.com", cachePath).Token()
+		require.NoError(t, err)
+		require.Equal(t, "token-2", token)
+
+		// an invalidated token is removed from the cache
+		source := provider.NewOauthTokenSource(server.URL, "client", "secret", "refresh", "https://localhost.com", cachePath)
+		source.Invalidate()
+		token, err = provider.NewOauthTokenSource(server.URL, "client", "secret", "refresh", "https://localhost.com", cachePath).Token()
+		require.NoError(t, err)
+		require.Equal(t, "token-3", token)
+	})
+
+	t.Run("error payload is returned", func(t *testing.T) {
+		server, _ := oauthServer(t, 600)
+		_, err := provider.NewOauthTokenSource(server.URL, "client", "secret", "expired", "https://localhost.com", "").Token()
+		require.ErrorContains(t, err, "400: Bad Request: invalid_grant: The refresh token is expired.")
+	})
+}
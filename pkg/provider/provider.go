@@ -2,10 +2,8 @@ package provider
 
 import (
 	"context"
-	"crypto/rsa"
 	"database/sql"
 	"encoding/json"
-	"encoding/pem"
 	"errors"
 	"fmt"
 	"io"
@@ -17,13 +15,12 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	homedir "github.com/mitchellh/go-homedir"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/snowflakedb/gosnowflake"
-	"github.com/youmark/pkcs8"
-	"golang.org/x/crypto/ssh"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/datasources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/db"
+	internalprovider "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 )
@@ -36,19 +33,26 @@ func Provider() *schema.Provider {
 				Type:        schema.TypeString,
 				Description: "The name of the Snowflake account. Can also come from the `SNOWFLAKE_ACCOUNT` environment variable. Required unless using profile.",
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_ACCOUNT", nil),
+			},
+			"organization_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the organization of the account, see [account identifiers](https://docs.snowflake.com/en/user-guide/admin-account-identifier). Used together with `account_name` to connect to `<organization_name>-<account_name>.snowflakecomputing.com`, instead of `account` and `region`. Can be sourced from the `SNOWFLAKE_ORGANIZATION_NAME` environment variable.",
+				Optional:    true,
+			},
+			"account_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the account in its organization, see [account identifiers](https://docs.snowflake.com/en/user-guide/admin-account-identifier). Used together with `organization_name`, takes precedence over `account`. Can be sourced from the `SNOWFLAKE_ACCOUNT_NAME` environment variable.",
+				Optional:    true,
 			},
 			"username": {
 				Type:        schema.TypeString,
 				Description: "Username for username+password authentication. Can come from the `SNOWFLAKE_USER` environment variable. Required unless using profile.",
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_USER", nil),
 			},
 			"password": {
 				Type:          schema.TypeString,
 				Description:   "Password for username+password auth. Cannot be used with `browser_auth` or `private_key_path`. Can be sourced from `SNOWFLAKE_PASSWORD` environment variable.",
 				Optional:      true,
-				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_PASSWORD", nil),
 				Sensitive:     true,
 				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "oauth_access_token", "oauth_refresh_token"},
 			},
@@ -56,7 +60,6 @@ func Provider() *schema.Provider {
 				Type:          schema.TypeString,
 				Description:   "Token for use with OAuth. Generating the token is left to other tools. Cannot be used with `browser_auth`, `private_key_path`, `oauth_refresh_token` or `password`. Can be sourced from `SNOWFLAKE_OAUTH_ACCESS_TOKEN` environment variable.",
 				Optional:      true,
-				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_ACCESS_TOKEN", nil),
 				Sensitive:     true,
 				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_refresh_token"},
 			},
@@ -64,7 +67,6 @@ func Provider() *schema.Provider {
 				Type:          schema.TypeString,
 				Description:   "Token for use with OAuth. Setup and generation of the token is left to other tools. Should be used in conjunction with `oauth_client_id`, `oauth_client_secret`, `oauth_endpoint`, `oauth_redirect_url`. Cannot be used with `browser_auth`, `private_key_path`, `oauth_access_token` or `password`. Can be sourced from `SNOWFLAKE_OAUTH_REFRESH_TOKEN` environment variable.",
 				Optional:      true,
-				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_REFRESH_TOKEN", nil),
 				Sensitive:     true,
 				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token"},
 				RequiredWith:  []string{"oauth_client_id", "oauth_client_secret", "oauth_endpoint", "oauth_redirect_url"},
@@ -73,7 +75,6 @@ func Provider() *schema.Provider {
 				Type:          schema.TypeString,
 				Description:   "Required when `oauth_refresh_token` is used. Can be sourced from `SNOWFLAKE_OAUTH_CLIENT_ID` environment variable.",
 				Optional:      true,
-				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_CLIENT_ID", nil),
 				Sensitive:     true,
 				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token"},
 				RequiredWith:  []string{"oauth_refresh_token", "oauth_client_secret", "oauth_endpoint", "oauth_redirect_url"},
@@ -82,7 +83,6 @@ func Provider() *schema.Provider {
 				Type:          schema.TypeString,
 				Description:   "Required when `oauth_refresh_token` is used. Can be sourced from `SNOWFLAKE_OAUTH_CLIENT_SECRET` environment variable.",
 				Optional:      true,
-				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_CLIENT_SECRET", nil),
 				Sensitive:     true,
 				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token"},
 				RequiredWith:  []string{"oauth_client_id", "oauth_refresh_token", "oauth_endpoint", "oauth_redirect_url"},
@@ -91,7 +91,6 @@ func Provider() *schema.Provider {
 				Type:          schema.TypeString,
 				Description:   "Required when `oauth_refresh_token` is used. Can be sourced from `SNOWFLAKE_OAUTH_ENDPOINT` environment variable.",
 				Optional:      true,
-				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_ENDPOINT", nil),
 				Sensitive:     true,
 				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token"},
 				RequiredWith:  []string{"oauth_client_id", "oauth_client_secret", "oauth_refresh_token", "oauth_redirect_url"},
@@ -100,16 +99,19 @@ func Provider() *schema.Provider {
 				Type:          schema.TypeString,
 				Description:   "Required when `oauth_refresh_token` is used. Can be sourced from `SNOWFLAKE_OAUTH_REDIRECT_URL` environment variable.",
 				Optional:      true,
-				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_OAUTH_REDIRECT_URL", nil),
 				Sensitive:     true,
 				ConflictsWith: []string{"browser_auth", "private_key_path", "private_key", "private_key_passphrase", "password", "oauth_access_token"},
 				RequiredWith:  []string{"oauth_client_id", "oauth_client_secret", "oauth_endpoint", "oauth_refresh_token"},
 			},
+			"oauth_token_cache_path": {
+				Type:        schema.TypeString,
+				Description: "Path to a file in which the access tokens retrieved with `oauth_refresh_token` are cached until they expire, so that they are shared by the provider processes of a Terraform run. The file contains secrets and is created with owner-only permissions. Can be sourced from `SNOWFLAKE_OAUTH_TOKEN_CACHE_PATH` environment variable.",
+				Optional:    true,
+			},
 			"browser_auth": {
 				Type:          schema.TypeBool,
 				Description:   "Required when `oauth_refresh_token` is used. Can be sourced from `SNOWFLAKE_USE_BROWSER_AUTH` environment variable.",
 				Optional:      true,
-				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_USE_BROWSER_AUTH", nil),
 				Sensitive:     false,
 				ConflictsWith: []string{"password", "private_key_path", "private_key", "private_key_passphrase", "oauth_access_token", "oauth_refresh_token"},
 			},
@@ -117,7 +119,6 @@ func Provider() *schema.Provider {
 				Type:          schema.TypeString,
 				Description:   "Path to a private key for using keypair authentication. Cannot be used with `browser_auth`, `oauth_access_token` or `password`. Can be sourced from `SNOWFLAKE_PRIVATE_KEY_PATH` environment variable.",
 				Optional:      true,
-				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_PRIVATE_KEY_PATH", nil),
 				Sensitive:     true,
 				ConflictsWith: []string{"browser_auth", "password", "oauth_access_token", "private_key"},
 			},
@@ -125,7 +126,6 @@ func Provider() *schema.Provider {
 				Type:          schema.TypeString,
 				Description:   "Private Key for username+private-key auth. Cannot be used with `browser_auth` or `password`. Can be sourced from `SNOWFLAKE_PRIVATE_KEY` environment variable.",
 				Optional:      true,
-				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_PRIVATE_KEY", nil),
 				Sensitive:     true,
 				ConflictsWith: []string{"browser_auth", "password", "oauth_access_token", "private_key_path", "oauth_refresh_token"},
 			},
@@ -133,51 +133,127 @@ func Provider() *schema.Provider {
 				Type:          schema.TypeString,
 				Description:   "Supports the encryption ciphers aes-128-cbc, aes-128-gcm, aes-192-cbc, aes-192-gcm, aes-256-cbc, aes-256-gcm, and des-ede3-cbc",
 				Optional:      true,
-				DefaultFunc:   schema.EnvDefaultFunc("SNOWFLAKE_PRIVATE_KEY_PASSPHRASE", nil),
 				Sensitive:     true,
 				ConflictsWith: []string{"browser_auth", "password", "oauth_access_token", "oauth_refresh_token"},
 			},
+			"authenticator": {
+				Type:         schema.TypeString,
+				Description:  "Specifies the [authentication type](https://pkg.go.dev/github.com/snowflakedb/gosnowflake#AuthType) to use. One of `SNOWFLAKE`, `OAUTH`, `EXTERNALBROWSER`, `OKTA`, `SNOWFLAKE_JWT` or `USERNAME_PASSWORD_MFA`. If left unset, it is derived from the other credentials that are set. The OAuth client credentials flow is not supported, as the gosnowflake driver version in use (v1.6.19) has no authenticator for it; an access token obtained with it can be passed with `oauth_access_token` or `token_file_path`. Can be sourced from the `SNOWFLAKE_AUTHENTICATOR` environment variable.",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(sdk.AuthenticatorValues, true),
+			},
+			"okta_url": {
+				Type:        schema.TypeString,
+				Description: "The URL of the Okta server, e.g. `https://example.okta.com`. Required when `authenticator` is `OKTA`. Can be sourced from the `SNOWFLAKE_OKTA_URL` environment variable.",
+				Optional:    true,
+			},
+			"passcode": {
+				Type:          schema.TypeString,
+				Description:   "The MFA passcode to use with the `USERNAME_PASSWORD_MFA` authenticator. Can be sourced from the `SNOWFLAKE_PASSCODE` environment variable.",
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"passcode_in_password"},
+			},
+			"passcode_in_password": {
+				Type:          schema.TypeBool,
+				Description:   "True if the MFA passcode is appended to the `password`. Used with the `USERNAME_PASSWORD_MFA` authenticator. Can be sourced from the `SNOWFLAKE_PASSCODE_IN_PASSWORD` environment variable.",
+				Optional:      true,
+				ConflictsWith: []string{"passcode"},
+			},
+			"jwt_expire_timeout": {
+				Type:        schema.TypeInt,
+				Description: "The number of seconds after which the JWT used for keypair authentication expires. The driver default of 60 seconds is used if left unset. Can be sourced from the `SNOWFLAKE_JWT_EXPIRE_TIMEOUT` environment variable.",
+				Optional:    true,
+			},
+			"token_file_path": {
+				Type:          schema.TypeString,
+				Description:   "Path to a file containing an OAuth access token, e.g. one that is rotated by the environment the provider runs in. Implies the `OAUTH` authenticator unless `authenticator` is set. Cannot be used with `oauth_access_token`. Can be sourced from the `SNOWFLAKE_TOKEN_FILE_PATH` environment variable.",
+				Optional:      true,
+				ConflictsWith: []string{"oauth_access_token", "oauth_refresh_token"},
+			},
+			"login_timeout": {
+				Type:        schema.TypeInt,
+				Description: "Login retry timeout in seconds, excluding the network roundtrip and reading the HTTP response. The driver default is used if left unset. Can be sourced from the `SNOWFLAKE_LOGIN_TIMEOUT` environment variable.",
+				Optional:    true,
+			},
+			"request_timeout": {
+				Type:        schema.TypeInt,
+				Description: "Request retry timeout in seconds, excluding the network roundtrip and reading the HTTP response. The driver version in use has no limit on the number of retries, so the timeouts are the only bound on them. The driver default is used if left unset. Can be sourced from the `SNOWFLAKE_REQUEST_TIMEOUT` environment variable.",
+				Optional:    true,
+			},
+			"max_statement_attempts": {
+				Type:         schema.TypeInt,
+				Description:  "The number of times a statement failing on a transient error, e.g. a lock wait, a concurrent modification or a throttled request, is run at most. Read-only statements such as `SHOW` are repeated after every such error, other statements only when Snowflake did not execute them. The waits between the attempts grow exponentially. Set to 1 to disable retries. Can be sourced from the `SNOWFLAKE_MAX_STATEMENT_ATTEMPTS` environment variable.",
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SNOWFLAKE_MAX_STATEMENT_ATTEMPTS", sdk.DefaultRetryPolicy().MaxAttempts),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"client_timeout": {
+				Type:        schema.TypeInt,
+				Description: "Timeout in seconds for the network roundtrip and reading the HTTP response. The driver default is used if left unset. Can be sourced from the `SNOWFLAKE_CLIENT_TIMEOUT` environment variable.",
+				Optional:    true,
+			},
+			"client_session_keep_alive": {
+				Type:        schema.TypeBool,
+				Description: "Enables a heartbeat that keeps the session alive while the provider is running, e.g. during long applies. Can be sourced from the `SNOWFLAKE_CLIENT_SESSION_KEEP_ALIVE` environment variable.",
+				Optional:    true,
+			},
+			"ocsp_fail_open": {
+				Type:        schema.TypeBool,
+				Description: "If false, connections fail when the OCSP responder cannot be reached to check the certificate revocation status. Can be sourced from the `SNOWFLAKE_OCSP_FAIL_OPEN` environment variable.",
+				Optional:    true,
+			},
+			"validate_default_parameters": {
+				Type:        schema.TypeBool,
+				Description: "If true, the existence of the warehouse and role set in the provider is validated when a connection is established. Can be sourced from the `SNOWFLAKE_VALIDATE_DEFAULT_PARAMETERS` environment variable.",
+				Optional:    true,
+			},
+			"tracing": {
+				Type:         schema.TypeString,
+				Description:  "The log level of the driver. One of `trace`, `debug`, `info`, `print`, `warning`, `error`, `fatal` or `panic`. Can be sourced from the `SNOWFLAKE_TRACING` environment variable.",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"trace", "debug", "info", "print", "warning", "error", "fatal", "panic"}, true),
+			},
+			"params": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Session parameters set on every connection of the provider, e.g. `QUERY_TAG`. Can also be sourced from the `SNOWFLAKE_PARAMS` environment variable as a comma separated list of `key=value` pairs, the values set in the provider take precedence.",
+				Optional:    true,
+			},
 			"role": {
 				Type:        schema.TypeString,
 				Description: "Snowflake role to use for operations. If left unset, default role for user will be used. Can be sourced from the `SNOWFLAKE_ROLE` environment variable.",
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_ROLE", nil),
 			},
 			"region": {
 				Type:        schema.TypeString,
 				Description: "[Snowflake region](https://docs.snowflake.com/en/user-guide/intro-regions.html) to use.  Required if using the [legacy format for the `account` identifier](https://docs.snowflake.com/en/user-guide/admin-account-identifier.html#format-2-legacy-account-locator-in-a-region) in the form of `<cloud_region_id>.<cloud>`. Can be sourced from the `SNOWFLAKE_REGION` environment variable.",
-				Required:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_REGION", "us-west-2"),
+				Optional:    true,
 			},
 			"host": {
 				Type:        schema.TypeString,
 				Description: "Supports passing in a custom host value to the snowflake go driver for use with privatelink.",
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_HOST", nil),
 			},
 			"port": {
 				Type:        schema.TypeInt,
 				Description: "Support custom port values to snowflake go driver for use with privatelink. Can be sourced from `SNOWFLAKE_PORT` environment variable.",
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_PORT", 443),
 			},
 			"protocol": {
 				Type:        schema.TypeString,
 				Description: "Support custom protocols to snowflake go driver. Can be sourced from `SNOWFLAKE_PROTOCOL` environment variable.",
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_PROTOCOL", "https"),
 			},
 			"insecure_mode": {
 				Type:        schema.TypeBool,
 				Description: "If true, bypass the Online Certificate Status Protocol (OCSP) certificate revocation check. IMPORTANT: Change the default value for testing or emergency situations only.",
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_INSECURE_MODE", false),
 			},
 			"warehouse": {
 				Type:        schema.TypeString,
 				Description: "Sets the default warehouse. Optional. Can be sourced from SNOWFLAKE_WAREHOUSE environment variable.",
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SNOWFLAKE_WAREHOUSE", nil),
 			},
 			"profile": {
 				Type:        schema.TypeString,
@@ -230,18 +306,25 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_api_integration":                         resources.APIIntegration(),
 		"snowflake_database":                                resources.Database(),
 		"snowflake_database_role":                           resources.DatabaseRole(),
+		"snowflake_dynamic_table":                           resources.DynamicTable(),
 		"snowflake_email_notification_integration":          resources.EmailNotificationIntegration(),
+		"snowflake_event_table":                             resources.EventTable(),
+		"snowflake_external_access_integration":             resources.ExternalAccessIntegration(),
 		"snowflake_external_function":                       resources.ExternalFunction(),
 		"snowflake_external_oauth_integration":              resources.ExternalOauthIntegration(),
 		"snowflake_external_table":                          resources.ExternalTable(),
 		"snowflake_failover_group":                          resources.FailoverGroup(),
 		"snowflake_file_format":                             resources.FileFormat(),
 		"snowflake_function":                                resources.Function(),
+		"snowflake_grant_account_role":                      resources.GrantAccountRole(),
+		"snowflake_grant_database_role":                     resources.GrantDatabaseRole(),
+		"snowflake_grant_privileges_to_role":                resources.GrantPrivilegesToRole(),
 		"snowflake_managed_account":                         resources.ManagedAccount(),
 		"snowflake_masking_policy":                          resources.MaskingPolicy(),
 		"snowflake_materialized_view":                       resources.MaterializedView(),
 		"snowflake_network_policy":                          resources.NetworkPolicy(),
 		"snowflake_network_policy_attachment":               resources.NetworkPolicyAttachment(),
+		"snowflake_network_rule":                            resources.NetworkRule(),
 		"snowflake_notification_integration":                resources.NotificationIntegration(),
 		"snowflake_oauth_integration":                       resources.OAuthIntegration(),
 		"snowflake_object_parameter":                        resources.ObjectParameter(),
@@ -256,8 +339,11 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_saml_integration":                        resources.SAMLIntegration(),
 		"snowflake_schema":                                  resources.Schema(),
 		"snowflake_scim_integration":                        resources.SCIMIntegration(),
+		"snowflake_secret":                                  resources.Secret(),
 		"snowflake_sequence":                                resources.Sequence(),
 		"snowflake_session_parameter":                       resources.SessionParameter(),
+		"snowflake_session_policy":                          resources.SessionPolicy(),
+		"snowflake_session_policy_attachment":               resources.SessionPolicyAttachment(),
 		"snowflake_share":                                   resources.Share(),
 		"snowflake_stage":                                   resources.Stage(),
 		"snowflake_storage_integration":                     resources.StorageIntegration(),
@@ -291,6 +377,7 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_database":                           datasources.Database(),
 		"snowflake_database_roles":                     datasources.DatabaseRoles(),
 		"snowflake_databases":                          datasources.Databases(),
+		"snowflake_dynamic_tables":                     datasources.DynamicTables(),
 		"snowflake_external_functions":                 datasources.ExternalFunctions(),
 		"snowflake_external_tables":                    datasources.ExternalTables(),
 		"snowflake_failover_groups":                    datasources.FailoverGroups(),
@@ -308,6 +395,7 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_row_access_policies":                datasources.RowAccessPolicies(),
 		"snowflake_schemas":                            datasources.Schemas(),
 		"snowflake_sequences":                          datasources.Sequences(),
+		"snowflake_session_policies":                   datasources.SessionPolicies(),
 		"snowflake_shares":                             datasources.Shares(),
 		"snowflake_stages":                             datasources.Stages(),
 		"snowflake_storage_integrations":               datasources.StorageIntegrations(),
@@ -327,73 +415,181 @@ func getDataSources() map[string]*schema.Resource {
 }
 
 func ConfigureProvider(s *schema.ResourceData) (interface{}, error) {
-	account := s.Get("account").(string)
-	user := s.Get("username").(string)
-	password := s.Get("password").(string)
-	browserAuth := s.Get("browser_auth").(bool)
-	privateKeyPath := s.Get("private_key_path").(string)
-	privateKey := s.Get("private_key").(string)
-	privateKeyPassphrase := s.Get("private_key_passphrase").(string)
-	oauthAccessToken := s.Get("oauth_access_token").(string)
-	region := s.Get("region").(string)
-	role := s.Get("role").(string)
-	oauthRefreshToken := s.Get("oauth_refresh_token").(string)
-	oauthClientID := s.Get("oauth_client_id").(string)
-	oauthClientSecret := s.Get("oauth_client_secret").(string)
-	oauthEndpoint := s.Get("oauth_endpoint").(string)
-	oauthRedirectURL := s.Get("oauth_redirect_url").(string)
-	host := s.Get("host").(string)
-	protocol := s.Get("protocol").(string)
-	port := s.Get("port").(int)
-	warehouse := s.Get("warehouse").(string)
-	insecureMode := s.Get("insecure_mode").(bool)
-	profile := s.Get("profile").(string)
-
-	if oauthRefreshToken != "" {
-		accessToken, err := GetOauthAccessToken(oauthEndpoint, oauthClientID, oauthClientSecret, GetOauthData(oauthRefreshToken, oauthRedirectURL))
+	config, tokenSource, err := configFromResourceData(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var snowflakeDB *sql.DB
+	if tokenSource != nil {
+		// connections opened later in the run log in with a refreshed token
+		snowflakeDB, err = db.OpenWithTokenSource(config, tokenSource)
 		if err != nil {
-			return nil, fmt.Errorf("could not retrieve access token from refresh token")
+			return nil, fmt.Errorf("could not open snowflake database err = %w", err)
+		}
+	} else {
+		dsn, err := gosnowflake.DSN(config)
+		if err != nil {
+			return nil, fmt.Errorf("could not build dsn for snowflake connection err = %w", err)
+		}
+		snowflakeDB, err = db.Open(dsn)
+		if err != nil {
+			return nil, fmt.Errorf("could not open snowflake database err = %w", err)
 		}
-		oauthAccessToken = accessToken
 	}
-
-	dsn, err := DSN(
-		account,
-		user,
-		password,
-		browserAuth,
-		privateKeyPath,
-		privateKey,
-		privateKeyPassphrase,
-		oauthAccessToken,
-		region,
-		role,
-		host,
-		protocol,
-		port,
-		warehouse,
-		insecureMode,
-		profile,
-	)
+	providerContext, err := internalprovider.NewSessionContext(context.Background(), snowflakeDB)
 	if err != nil {
-		return nil, fmt.Errorf("could not build dsn for snowflake connection err = %w", err)
+		return nil, err
 	}
+	providerContext.Client.SetRetryPolicy(RetryPolicyFromResourceData(s))
+	return providerContext, nil
+}
+
+// RetryPolicyFromResourceData builds the policy for repeating statements failing on transient errors from the
+// provider settings.
+func RetryPolicyFromResourceData(s *schema.ResourceData) *sdk.RetryPolicy {
+	policy := sdk.DefaultRetryPolicy()
+	policy.MaxAttempts = s.Get("max_statement_attempts").(int)
+	return policy
+}
 
-	db, err := db.Open(dsn)
+// ConfigFromResourceData builds the driver configuration from the provider settings. The provider block takes
+// precedence over the environment variables, which take precedence over the profile of the config file.
+// Attributes set in neither the provider block nor the environment are taken from the profile.
+func ConfigFromResourceData(s *schema.ResourceData) (*gosnowflake.Config, error) {
+	config, _, err := configFromResourceData(s)
+	return config, err
+}
+
+func configFromResourceData(s *schema.ResourceData) (*gosnowflake.Config, *OauthTokenSource, error) {
+	params := map[string]string{}
+	for k, v := range s.Get("params").(map[string]interface{}) {
+		params[k] = v.(string)
+	}
+	envSettings, err := sdk.EnvConfigProfile()
 	if err != nil {
-		return nil, fmt.Errorf("could not open snowflake database err = %w", err)
+		return nil, nil, err
 	}
-	client := sdk.NewClientFromDB(db)
-	sessionID, err := client.ContextFunctions.CurrentSession(context.Background())
+
+	settings := &sdk.ConfigProfile{
+		Account:                   configured[string](s, "account"),
+		OrganizationName:          configured[string](s, "organization_name"),
+		AccountName:               configured[string](s, "account_name"),
+		User:                      configured[string](s, "username"),
+		Password:                  configured[string](s, "password"),
+		Role:                      configured[string](s, "role"),
+		Region:                    configured[string](s, "region"),
+		Host:                      configured[string](s, "host"),
+		Port:                      configured[int](s, "port"),
+		Protocol:                  configured[string](s, "protocol"),
+		Warehouse:                 configured[string](s, "warehouse"),
+		InsecureMode:              configured[bool](s, "insecure_mode"),
+		BrowserAuth:               configured[bool](s, "browser_auth"),
+		Authenticator:             configured[string](s, "authenticator"),
+		PrivateKeyPath:            configured[string](s, "private_key_path"),
+		PrivateKey:                configured[string](s, "private_key"),
+		PrivateKeyPassphrase:      configured[string](s, "private_key_passphrase"),
+		OauthAccessToken:          configured[string](s, "oauth_access_token"),
+		OauthRefreshToken:         configured[string](s, "oauth_refresh_token"),
+		OauthClientID:             configured[string](s, "oauth_client_id"),
+		OauthClientSecret:         configured[string](s, "oauth_client_secret"),
+		OauthEndpoint:             configured[string](s, "oauth_endpoint"),
+		OauthRedirectURL:          configured[string](s, "oauth_redirect_url"),
+		OauthTokenCachePath:       configured[string](s, "oauth_token_cache_path"),
+		OktaURL:                   configured[string](s, "okta_url"),
+		Passcode:                  configured[string](s, "passcode"),
+		PasscodeInPassword:        configured[bool](s, "passcode_in_password"),
+		JWTExpireTimeout:          configured[int](s, "jwt_expire_timeout"),
+		TokenFilePath:             configured[string](s, "token_file_path"),
+		LoginTimeout:              configured[int](s, "login_timeout"),
+		RequestTimeout:            configured[int](s, "request_timeout"),
+		ClientTimeout:             configured[int](s, "client_timeout"),
+		ClientSessionKeepAlive:    configured[bool](s, "client_session_keep_alive"),
+		OCSPFailOpen:              configured[bool](s, "ocsp_fail_open"),
+		ValidateDefaultParameters: configured[bool](s, "validate_default_parameters"),
+		Tracing:                   configured[string](s, "tracing"),
+		Params:                    params,
+	}
+	settings = settings.Merge(envSettings)
+	settings, err = mergeProfile(settings, s.Get("profile").(string))
 	if err != nil {
-		return nil, fmt.Errorf("could not retrieve session id err = %w", err)
+		return nil, nil, err
 	}
-	log.Printf("[INFO] Snowflake DB connection opened, session ID : %s\n", sessionID)
+
+	var tokenSource *OauthTokenSource
+	if settings.OauthRefreshToken != nil {
+		if settings.OauthEndpoint == nil || settings.OauthClientID == nil || settings.OauthClientSecret == nil || settings.OauthRedirectURL == nil {
+			return nil, nil, errors.New("oauth_client_id, oauth_client_secret, oauth_endpoint and oauth_redirect_url must be set when using oauth_refresh_token")
+		}
+		var cachePath string
+		if settings.OauthTokenCachePath != nil {
+			cachePath = *settings.OauthTokenCachePath
+		}
+		tokenSource = NewOauthTokenSource(*settings.OauthEndpoint, *settings.OauthClientID, *settings.OauthClientSecret, *settings.OauthRefreshToken, *settings.OauthRedirectURL, cachePath)
+		accessToken, err := tokenSource.Token()
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not retrieve access token from refresh token err = %w", err)
+		}
+		settings.OauthAccessToken = &accessToken
+	}
+
+	config, err := settings.DriverConfig()
 	if err != nil {
-		return nil, fmt.Errorf("could not open snowflake database err = %w", err)
+		return nil, nil, fmt.Errorf("could not build dsn for snowflake connection err = %w", err)
 	}
+	config.Application = "terraform-provider-snowflake"
+	return config, tokenSource, nil
+}
 
-	return db, nil
+// configured returns the value of an attribute set in the provider block, or nil so that it can be taken from the
+// environment or the profile. GetOk is not enough, as it reports a value explicitly set to false or 0 as unset.
+func configured[T any](s *schema.ResourceData, key string) *T {
+	//nolint:staticcheck
+	if v, ok := s.GetOkExists(key); ok {
+		value := v.(T)
+		return &value
+	}
+	return nil
+}
+
+// optional returns nil for a value left at its default, so that it can be taken from the profile. It is used where
+// only the values of the settings are known, not whether they were set.
+func optional[T comparable](v T, defaultValue T) *T {
+	if v == defaultValue {
+		return nil
+	}
+	return &v
+}
+
+// mergeProfile completes the settings with the profile of the config file. The profile is read when it is chosen
+// explicitly, or when the account or the user are not set in the provider block or the environment.
+func mergeProfile(settings *sdk.ConfigProfile, profile string) (*sdk.ConfigProfile, error) {
+	if profile == "" {
+		profile = "default"
+	}
+	if profile == "default" && settings.HasAccount() && settings.User != nil {
+		return settings, nil
+	}
+
+	log.Printf("[DEBUG] Reading settings which are not set in the provider from profile %s\n", profile)
+	profileSettings, err := sdk.LoadConfigProfile(profile)
+	if profile == "default" {
+		if err != nil {
+			log.Printf("[DEBUG] Could not read the default profile: %v\n", err)
+		}
+	} else {
+		if err != nil {
+			return nil, errors.New("could not retrieve profile config: " + err.Error())
+		}
+		if profileSettings == nil {
+			return nil, errors.New("profile with name: " + profile + " not found in config file")
+		}
+	}
+	settings = settings.Merge(profileSettings)
+	if !settings.HasAccount() {
+		return nil, errors.New("Account must be set in provider config, ~/.snowflake/config, or as an environment variable.")
+	}
+	return settings, nil
 }
 
 func DSN(
@@ -414,128 +610,60 @@ func DSN(
 	insecureMode bool,
 	profile string,
 ) (string, error) {
-	// us-west-2 is Snowflake's default region, but if you actually specify that it won't trigger the default code
-	//  https://github.com/snowflakedb/gosnowflake/blob/52137ce8c32eaf93b0bd22fc5c7297beff339812/dsn.go#L61
-	if region == "us-west-2" {
-		region = ""
-	}
-
-	config := &gosnowflake.Config{
-		Account:      account,
-		User:         user,
-		Region:       region,
-		Role:         role,
-		Port:         port,
-		Protocol:     protocol,
-		InsecureMode: insecureMode,
-	}
-
-	// If host is set trust it and do not use the region value
-	if host != "" {
-		config.Region = ""
-		config.Host = host
-	}
-
-	// If warehouse is set
-	if warehouse != "" {
-		config.Warehouse = warehouse
-	}
-
-	if privateKeyPath != "" { //nolint:gocritic // todo: please fix this to pass gocritic
-		privateKeyBytes, err := ReadPrivateKeyFile(privateKeyPath)
-		if err != nil {
-			return "", fmt.Errorf("private Key file could not be read err = %w", err)
-		}
-		rsaPrivateKey, err := ParsePrivateKey(privateKeyBytes, []byte(privateKeyPassphrase))
-		if err != nil {
-			return "", fmt.Errorf("private Key could not be parsed err = %w", err)
-		}
-		config.PrivateKey = rsaPrivateKey
-		config.Authenticator = gosnowflake.AuthTypeJwt
-	} else if privateKey != "" {
-		rsaPrivateKey, err := ParsePrivateKey([]byte(privateKey), []byte(privateKeyPassphrase))
-		if err != nil {
-			return "", fmt.Errorf("private Key could not be parsed err = %w", err)
-		}
-		config.PrivateKey = rsaPrivateKey
-		config.Authenticator = gosnowflake.AuthTypeJwt
-	} else if browserAuth {
-		config.Authenticator = gosnowflake.AuthTypeExternalBrowser
-	} else if oauthAccessToken != "" {
-		config.Authenticator = gosnowflake.AuthTypeOAuth
-		config.Token = oauthAccessToken
-	} else if password != "" {
-		config.Password = password
-	} else if account == "" || user == "" {
-		// If account and user are empty then we need to fall back on using profile config
-		log.Printf("[DEBUG] No account or user provided, falling back to profile %s\n", profile)
-		if profile == "default" {
-			defaultConfig := sdk.DefaultConfig()
-			if defaultConfig.Account == "" || defaultConfig.User == "" {
-				return "", errors.New("Account and User must be set in provider config, ~/.snowflake/config, or as an environment variable.")
-			}
-			config = sdk.MergeConfig(config, defaultConfig)
-		} else {
-			profileConfig, err := sdk.ProfileConfig(profile)
-			if err != nil {
-				return "", errors.New("could not retrieve profile config: " + err.Error())
-			}
-			if profileConfig == nil {
-				return "", errors.New("profile with name: " + profile + " not found in config file")
-			}
-			// merge any credentials found in profile with config
-			config = sdk.MergeConfig(config, profileConfig)
-		}
+	config, err := Config(account, user, password, browserAuth, privateKeyPath, privateKey, privateKeyPassphrase, oauthAccessToken, region, role, host, protocol, port, warehouse, insecureMode, profile)
+	if err != nil {
+		return "", err
 	}
-	config.Application = "terraform-provider-snowflake"
 	return gosnowflake.DSN(config)
 }
 
-func ReadPrivateKeyFile(privateKeyPath string) ([]byte, error) {
-	expandedPrivateKeyPath, err := homedir.Expand(privateKeyPath)
-	if err != nil {
-		return nil, fmt.Errorf("invalid Path to private key err = %w", err)
+// Config builds the driver configuration from the given connection settings, completed with the profile of the config
+// file like in ConfigFromResourceData. The authenticator is derived from the credentials that are set.
+func Config(
+	account string,
+	user string,
+	password string,
+	browserAuth bool,
+	privateKeyPath string,
+	privateKey string,
+	privateKeyPassphrase string,
+	oauthAccessToken string,
+	region string,
+	role string,
+	host string,
+	protocol string,
+	port int,
+	warehouse string,
+	insecureMode bool,
+	profile string,
+) (*gosnowflake.Config, error) {
+	settings := &sdk.ConfigProfile{
+		Account:              optional(account, ""),
+		User:                 optional(user, ""),
+		Password:             optional(password, ""),
+		BrowserAuth:          optional(browserAuth, false),
+		PrivateKeyPath:       optional(privateKeyPath, ""),
+		PrivateKey:           optional(privateKey, ""),
+		PrivateKeyPassphrase: optional(privateKeyPassphrase, ""),
+		OauthAccessToken:     optional(oauthAccessToken, ""),
+		Region:               optional(region, "us-west-2"),
+		Role:                 optional(role, ""),
+		Host:                 optional(host, ""),
+		Protocol:             optional(protocol, "https"),
+		Port:                 optional(port, 443),
+		Warehouse:            optional(warehouse, ""),
+		InsecureMode:         optional(insecureMode, false),
 	}
-
-	privateKeyBytes, err := os.ReadFile(expandedPrivateKeyPath)
+	settings, err := mergeProfile(settings, profile)
 	if err != nil {
-		return nil, fmt.Errorf("could not read private key err = %w", err)
-	}
-
-	if len(privateKeyBytes) == 0 {
-		return nil, errors.New("private key is empty")
-	}
-
-	return privateKeyBytes, nil
-}
-
-func ParsePrivateKey(privateKeyBytes []byte, passhrase []byte) (*rsa.PrivateKey, error) {
-	privateKeyBlock, _ := pem.Decode(privateKeyBytes)
-	if privateKeyBlock == nil {
-		return nil, fmt.Errorf("could not parse private key, key is not in PEM format")
-	}
-
-	if privateKeyBlock.Type == "ENCRYPTED PRIVATE KEY" {
-		if len(passhrase) == 0 {
-			return nil, fmt.Errorf("private key requires a passphrase, but private_key_passphrase was not supplied")
-		}
-		privateKey, err := pkcs8.ParsePKCS8PrivateKeyRSA(privateKeyBlock.Bytes, passhrase)
-		if err != nil {
-			return nil, fmt.Errorf("could not parse encrypted private key with passphrase, only ciphers aes-128-cbc, aes-128-gcm, aes-192-cbc, aes-192-gcm, aes-256-cbc, aes-256-gcm, and des-ede3-cbc are supported err = %w", err)
-		}
-		return privateKey, nil
+		return nil, err
 	}
-
-	privateKey, err := ssh.ParseRawPrivateKey(privateKeyBytes)
+	config, err := settings.DriverConfig()
 	if err != nil {
-		return nil, fmt.Errorf("could not parse private key err = %w", err)
-	}
-
-	rsaPrivateKey, ok := privateKey.(*rsa.PrivateKey)
-	if !ok {
-		return nil, errors.New("privateKey not of type RSA")
+		return nil, err
 	}
-	return rsaPrivateKey, nil
+	config.Application = "terraform-provider-snowflake"
+	return config, nil
 }
 
 type Result struct {
@@ -568,31 +696,61 @@ func GetOauthAccessToken(
 	clientSecret string,
 	data url.Values,
 ) (string, error) {
+	result, err := GetOauthToken(endPoint, clientID, clientSecret, data)
+	if err != nil {
+		return "", err
+	}
+	return result.AccessToken, nil
+}
+
+// GetOauthToken requests an access token from the OAuth token endpoint. The error payload of the endpoint is
+// part of the returned error, e.g. when the refresh token is expired.
+func GetOauthToken(
+	endPoint,
+	clientID,
+	clientSecret string,
+	data url.Values,
+) (*Result, error) {
 	client := &http.Client{}
 	request, err := GetOauthRequest(strings.NewReader(data.Encode()), endPoint, clientID, clientSecret)
 	if err != nil {
-		return "", fmt.Errorf("oauth request returned an error")
+		return nil, fmt.Errorf("oauth request returned an error err = %w", err)
 	}
 
-	var result Result
-
 	response, err := client.Do(request)
 	if err != nil {
-		return "", fmt.Errorf("response status returned an err = %w", err)
-	}
-	if response.StatusCode != 200 {
-		return "", fmt.Errorf("response status code: %s: %s err = %w", strconv.Itoa(response.StatusCode), http.StatusText(response.StatusCode), err)
+		return nil, fmt.Errorf("response status returned an err = %w", err)
 	}
 	defer response.Body.Close()
 	body, err := io.ReadAll(response.Body)
 	if err != nil {
-		return "", fmt.Errorf("response body was not able to be parsed err = %w", err)
+		return nil, fmt.Errorf("response body was not able to be parsed err = %w", err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, oauthResponseError(response.StatusCode, body)
 	}
+
+	var result Result
 	err = json.Unmarshal(body, &result)
 	if err != nil {
-		return "", fmt.Errorf("error parsing JSON from Snowflake err = %w", err)
+		return nil, fmt.Errorf("error parsing JSON from Snowflake err = %w", err)
 	}
-	return result.AccessToken, nil
+	if result.AccessToken == "" {
+		return nil, errors.New("response of the oauth endpoint does not contain an access token")
+	}
+	return &result, nil
+}
+
+// oauthResponseError describes an error response of the token endpoint, see https://www.rfc-editor.org/rfc/rfc6749#section-5.2.
+func oauthResponseError(statusCode int, body []byte) error {
+	var payload struct {
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := json.Unmarshal(body, &payload); err == nil && payload.Error != "" {
+		return fmt.Errorf("response status code: %s: %s: %s: %s", strconv.Itoa(statusCode), http.StatusText(statusCode), payload.Error, payload.ErrorDescription)
+	}
+	return fmt.Errorf("response status code: %s: %s: %s", strconv.Itoa(statusCode), http.StatusText(statusCode), strings.TrimSpace(string(body)))
 }
 
 func GetDatabaseHandleFromEnv() (db *sql.DB, err error) {
@@ -2,7 +2,12 @@ package provider_test
 
 import (
 	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -12,8 +17,12 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/snowflakedb/gosnowflake"
 	"github.com/stretchr/testify/require"
 )
 
@@ -316,3 +325,199 @@ func TestGetOauthAccessToken(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigFromResourceData(t *testing.T) {
+	privateKeyPath := filepath.Join(t.TempDir(), "rsa_key.p8")
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(rsaKey)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(privateKeyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+
+	c := fmt.Sprintf(`
+	[default]
+	account='DEFAULT_ACCOUNT'
+	user='DEFAULT_USER'
+	password='abcd1234'
+
+	[dev]
+	account='DEV_ACCOUNT'
+	user='DEV_USER'
+	role='DEV_ROLE'
+	warehouse='DEV_WAREHOUSE'
+	private_key_path='%s'
+	authenticator='SNOWFLAKE_JWT'
+	port=8443
+	protocol='http'
+	insecure_mode=true
+	ocsp_fail_open=false
+	login_timeout=30
+	params={ QUERY_TAG='dev', TIMEZONE='UTC' }
+	`, privateKeyPath)
+	configPath := filepath.Join(t.TempDir(), "config")
+	require.NoError(t, os.WriteFile(configPath, []byte(c), 0o600))
+	t.Setenv("SNOWFLAKE_CONFIG_PATH", configPath)
+	for _, env := range []string{"SNOWFLAKE_ACCOUNT", "SNOWFLAKE_USER", "SNOWFLAKE_PASSWORD", "SNOWFLAKE_ROLE", "SNOWFLAKE_WAREHOUSE", "SNOWFLAKE_PROFILE", "SNOWFLAKE_PARAMS", "SNOWFLAKE_PRIVATE_KEY_PATH", "SNOWFLAKE_AUTHENTICATOR"} {
+		t.Setenv(env, "")
+	}
+
+	t.Run("profile only", func(t *testing.T) {
+		t.Setenv("SNOWFLAKE_PROFILE", "dev")
+		config, err := provider.ConfigFromResourceData(schema.TestResourceDataRaw(t, provider.Provider().Schema, map[string]interface{}{}))
+		require.NoError(t, err)
+		require.Equal(t, "DEV_ACCOUNT", config.Account)
+		require.Equal(t, "DEV_USER", config.User)
+		require.Equal(t, "DEV_ROLE", config.Role)
+		require.Equal(t, "DEV_WAREHOUSE", config.Warehouse)
+		require.Equal(t, gosnowflake.AuthTypeJwt, config.Authenticator)
+		require.True(t, rsaKey.Equal(config.PrivateKey))
+		require.Equal(t, 8443, config.Port)
+		require.Equal(t, "http", config.Protocol)
+		require.True(t, config.InsecureMode)
+		require.Equal(t, gosnowflake.OCSPFailOpenFalse, config.OCSPFailOpen)
+		require.Equal(t, 30*time.Second, config.LoginTimeout)
+		require.Equal(t, "dev", *config.Params["query_tag"])
+		require.Equal(t, "UTC", *config.Params["timezone"])
+	})
+
+	t.Run("environment over profile", func(t *testing.T) {
+		t.Setenv("SNOWFLAKE_PROFILE", "dev")
+		t.Setenv("SNOWFLAKE_ROLE", "ENV_ROLE")
+		t.Setenv("SNOWFLAKE_PARAMS", "QUERY_TAG=env")
+		config, err := provider.ConfigFromResourceData(schema.TestResourceDataRaw(t, provider.Provider().Schema, map[string]interface{}{}))
+		require.NoError(t, err)
+		require.Equal(t, "DEV_ACCOUNT", config.Account)
+		require.Equal(t, "ENV_ROLE", config.Role)
+		require.Equal(t, "env", *config.Params["query_tag"])
+		require.Equal(t, "UTC", *config.Params["timezone"])
+	})
+
+	t.Run("provider block over environment and profile", func(t *testing.T) {
+		t.Setenv("SNOWFLAKE_ROLE", "ENV_ROLE")
+		config, err := provider.ConfigFromResourceData(schema.TestResourceDataRaw(t, provider.Provider().Schema, map[string]interface{}{
+			"profile":   "dev",
+			"role":      "BLOCK_ROLE",
+			"warehouse": "BLOCK_WAREHOUSE",
+			"port":      9443,
+		}))
+		require.NoError(t, err)
+		require.Equal(t, "DEV_ACCOUNT", config.Account)
+		require.Equal(t, "BLOCK_ROLE", config.Role)
+		require.Equal(t, "BLOCK_WAREHOUSE", config.Warehouse)
+		require.Equal(t, 9443, config.Port)
+	})
+
+	t.Run("provider block values equal to the defaults override profile", func(t *testing.T) {
+		config, err := provider.ConfigFromResourceData(schema.TestResourceDataRaw(t, provider.Provider().Schema, map[string]interface{}{
+			"profile":        "dev",
+			"port":           443,
+			"protocol":       "https",
+			"insecure_mode":  false,
+			"ocsp_fail_open": true,
+		}))
+		require.NoError(t, err)
+		require.Equal(t, 443, config.Port)
+		require.Equal(t, "https", config.Protocol)
+		require.False(t, config.InsecureMode)
+		require.Equal(t, gosnowflake.OCSPFailOpenTrue, config.OCSPFailOpen)
+	})
+
+	t.Run("provider block credentials replace profile credentials", func(t *testing.T) {
+		config, err := provider.ConfigFromResourceData(schema.TestResourceDataRaw(t, provider.Provider().Schema, map[string]interface{}{
+			"profile":  "dev",
+			"password": "secret",
+		}))
+		require.NoError(t, err)
+		require.Equal(t, "DEV_USER", config.User)
+		require.Equal(t, "secret", config.Password)
+		require.Nil(t, config.PrivateKey)
+		require.Equal(t, gosnowflake.AuthTypeSnowflake, config.Authenticator)
+	})
+
+	t.Run("environment credentials replace profile credentials", func(t *testing.T) {
+		t.Setenv("SNOWFLAKE_PROFILE", "dev")
+		t.Setenv("SNOWFLAKE_PASSWORD", "env_secret")
+		config, err := provider.ConfigFromResourceData(schema.TestResourceDataRaw(t, provider.Provider().Schema, map[string]interface{}{}))
+		require.NoError(t, err)
+		require.Equal(t, "env_secret", config.Password)
+		require.Nil(t, config.PrivateKey)
+	})
+
+	t.Run("provider block credentials replace environment credentials", func(t *testing.T) {
+		t.Setenv("SNOWFLAKE_PRIVATE_KEY_PATH", privateKeyPath)
+		config, err := provider.ConfigFromResourceData(schema.TestResourceDataRaw(t, provider.Provider().Schema, map[string]interface{}{
+			"account":  "BLOCK_ACCOUNT",
+			"username": "BLOCK_USER",
+			"password": "secret",
+		}))
+		require.NoError(t, err)
+		require.Equal(t, "secret", config.Password)
+		require.Nil(t, config.PrivateKey)
+	})
+
+	t.Run("default profile is not read when account and user are set", func(t *testing.T) {
+		config, err := provider.ConfigFromResourceData(schema.TestResourceDataRaw(t, provider.Provider().Schema, map[string]interface{}{
+			"account":  "BLOCK_ACCOUNT",
+			"username": "BLOCK_USER",
+			"password": "secret",
+		}))
+		require.NoError(t, err)
+		require.Equal(t, "BLOCK_ACCOUNT", config.Account)
+		require.Equal(t, "secret", config.Password)
+	})
+
+	t.Run("default profile completes the settings", func(t *testing.T) {
+		t.Setenv("SNOWFLAKE_ROLE", "ENV_ROLE")
+		config, err := provider.ConfigFromResourceData(schema.TestResourceDataRaw(t, provider.Provider().Schema, map[string]interface{}{}))
+		require.NoError(t, err)
+		require.Equal(t, "DEFAULT_ACCOUNT", config.Account)
+		require.Equal(t, "DEFAULT_USER", config.User)
+		require.Equal(t, "abcd1234", config.Password)
+		require.Equal(t, "ENV_ROLE", config.Role)
+	})
+
+	t.Run("organization and account names", func(t *testing.T) {
+		t.Setenv("SNOWFLAKE_ACCOUNT", "ENV_ACCOUNT")
+		config, err := provider.ConfigFromResourceData(schema.TestResourceDataRaw(t, provider.Provider().Schema, map[string]interface{}{
+			"organization_name": "MYORG",
+			"account_name":      "MYACCOUNT",
+			"username":          "BLOCK_USER",
+			"password":          "secret",
+			"region":            "eu-central-1",
+		}))
+		require.NoError(t, err)
+		require.Equal(t, "MYORG-MYACCOUNT", config.Account)
+		require.Empty(t, config.Region)
+	})
+
+	t.Run("profile not found", func(t *testing.T) {
+		_, err := provider.ConfigFromResourceData(schema.TestResourceDataRaw(t, provider.Provider().Schema, map[string]interface{}{
+			"profile": "prod",
+		}))
+		require.ErrorContains(t, err, "profile with name: prod not found in config file")
+	})
+}
+
+func TestRetryPolicyFromResourceData(t *testing.T) {
+	t.Setenv("SNOWFLAKE_MAX_STATEMENT_ATTEMPTS", "")
+
+	t.Run("default", func(t *testing.T) {
+		policy := provider.RetryPolicyFromResourceData(schema.TestResourceDataRaw(t, provider.Provider().Schema, map[string]interface{}{}))
+		require.Equal(t, sdk.DefaultRetryPolicy(), policy)
+	})
+
+	t.Run("environment", func(t *testing.T) {
+		t.Setenv("SNOWFLAKE_MAX_STATEMENT_ATTEMPTS", "3")
+		policy := provider.RetryPolicyFromResourceData(schema.TestResourceDataRaw(t, provider.Provider().Schema, map[string]interface{}{}))
+		require.Equal(t, 3, policy.MaxAttempts)
+	})
+
+	t.Run("provider block over environment", func(t *testing.T) {
+		t.Setenv("SNOWFLAKE_MAX_STATEMENT_ATTEMPTS", "3")
+		policy := provider.RetryPolicyFromResourceData(schema.TestResourceDataRaw(t, provider.Provider().Schema, map[string]interface{}{
+			"max_statement_attempts": 1,
+		}))
+		require.Equal(t, 1, policy.MaxAttempts)
+		require.Equal(t, sdk.DefaultRetryPolicy().InitialBackoff, policy.InitialBackoff)
+	})
+}
@@ -2,11 +2,11 @@ package resources
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -216,8 +216,8 @@ func Account() *schema.Resource {
 
 // CreateAccount implements schema.CreateFunc.
 func CreateAccount(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	providerContext := meta.(*provider.Context)
+	client := providerContext.Client
 	ctx := context.Background()
 
 	name := d.Get("name").(string)
@@ -249,11 +249,7 @@ func CreateAccount(d *schema.ResourceData, meta interface{}) error {
 		createOptions.RegionGroup = sdk.String(v.(string))
 	} else {
 		// For organizations that have accounts in multiple region groups, returns <region_group>.<region> so we need to split on "."
-		currentRegion, err := client.ContextFunctions.CurrentRegion(ctx)
-		if err != nil {
-			return err
-		}
-		regionParts := strings.Split(currentRegion, ".")
+		regionParts := strings.Split(providerContext.Region, ".")
 		if len(regionParts) == 2 {
 			createOptions.RegionGroup = sdk.String(regionParts[0])
 		}
@@ -262,15 +258,11 @@ func CreateAccount(d *schema.ResourceData, meta interface{}) error {
 		createOptions.Region = sdk.String(v.(string))
 	} else {
 		// For organizations that have accounts in multiple region groups, returns <region_group>.<region> so we need to split on "."
-		currentRegion, err := client.ContextFunctions.CurrentRegion(ctx)
-		if err != nil {
-			return err
-		}
-		regionParts := strings.Split(currentRegion, ".")
+		regionParts := strings.Split(providerContext.Region, ".")
 		if len(regionParts) == 2 {
 			createOptions.Region = sdk.String(regionParts[1])
 		} else {
-			createOptions.Region = sdk.String(currentRegion)
+			createOptions.Region = sdk.String(providerContext.Region)
 		}
 	}
 	if v, ok := d.GetOk("comment"); ok {
@@ -293,8 +285,7 @@ func CreateAccount(d *schema.ResourceData, meta interface{}) error {
 
 // ReadAccount implements schema.ReadFunc.
 func ReadAccount(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
@@ -333,8 +324,7 @@ func ReadAccount(d *schema.ResourceData, meta interface{}) error {
 
 // UpdateAccount implements schema.UpdateFunc.
 func UpdateAccount(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 
 	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.AccountObjectIdentifier)
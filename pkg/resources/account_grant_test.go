@@ -36,7 +36,7 @@ func TestAccountGrantCreate(t *testing.T) { // lintignore:AT003
 		mock.ExpectExec(`^GRANT CREATE DATABASE ON ACCOUNT TO ROLE "test-role-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT CREATE DATABASE ON ACCOUNT TO ROLE "test-role-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadAccountGrant(mock)
-		err := resources.CreateAccountGrant(d, db)
+		err := resources.CreateAccountGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -55,7 +55,7 @@ func TestAccountGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadAccountGrant(mock)
-		err := resources.ReadAccountGrant(d, db)
+		err := resources.ReadAccountGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -73,7 +73,7 @@ func TestMonitorExecution(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadAccountGrant(mock)
-		err := resources.ReadAccountGrant(d, db)
+		err := resources.ReadAccountGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -91,7 +91,7 @@ func TestExecuteTask(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadAccountGrant(mock)
-		err := resources.ReadAccountGrant(d, db)
+		err := resources.ReadAccountGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -120,7 +120,40 @@ func TestApplyMaskingPolicy(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadAccountGrant(mock)
-		err := resources.ReadAccountGrant(d, db)
+		err := resources.ReadAccountGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
+
+func TestAccountGrantReadAllPrivileges(t *testing.T) {
+	r := require.New(t)
+
+	d := accountGrant(t, "ALL PRIVILEGES|false|test-role-1,test-role-2", map[string]interface{}{
+		"privilege":         "ALL PRIVILEGES",
+		"roles":             []interface{}{"test-role-1", "test-role-2"},
+		"with_grant_option": false,
+	})
+
+	// GRANT ALL PRIVILEGES ON ACCOUNT by ACCOUNTADMIN, which leaves out e.g. CREATE ACCOUNT and the support cases
+	granted := []string{
+		"APPLY MASKING POLICY", "APPLY PASSWORD POLICY", "APPLY ROW ACCESS POLICY", "APPLY SESSION POLICY", "APPLY TAG",
+		"ATTACH POLICY", "CREATE DATABASE", "CREATE FAILOVER GROUP", "CREATE INTEGRATION", "CREATE NETWORK POLICY",
+		"CREATE ROLE", "CREATE SHARE", "CREATE USER", "CREATE WAREHOUSE", "EXECUTE MANAGED TASK", "EXECUTE TASK",
+		"IMPORT SHARE", "MANAGE GRANTS", "MONITOR", "MONITOR EXECUTION", "MONITOR USAGE",
+	}
+	rows := sqlmock.NewRows([]string{
+		"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+	})
+	for _, privilege := range granted {
+		rows.AddRow(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), privilege, "ACCOUNT", "", "ROLE", "test-role-1", false, "ACCOUNTADMIN")
+	}
+	// a role missing a privilege of ALL has drifted
+	rows.AddRow(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "MONITOR USAGE", "ACCOUNT", "", "ROLE", "test-role-2", false, "ACCOUNTADMIN")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`^SHOW GRANTS ON ACCOUNT$`).WillReturnRows(rows)
+		err := resources.ReadAccountGrant(d, ProviderContext(db))
+		r.NoError(err)
+	})
+	r.Equal([]interface{}{"test-role-1"}, d.Get("roles").(*schema.Set).List())
+}
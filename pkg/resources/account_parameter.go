@@ -1,10 +1,10 @@
 package resources
 
 import (
-	"database/sql"
 	"fmt"
 	"reflect"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -42,7 +42,7 @@ func AccountParameter() *schema.Resource {
 
 // CreateAccountParameter implements schema.CreateFunc.
 func CreateAccountParameter(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	key := d.Get("key").(string)
 	value := d.Get("value").(string)
 
@@ -79,7 +79,7 @@ func CreateAccountParameter(d *schema.ResourceData, meta interface{}) error {
 
 // ReadAccountParameter implements schema.ReadFunc.
 func ReadAccountParameter(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	key := d.Id()
 	p, err := snowflake.ShowAccountParameter(db, key)
 	if err != nil {
@@ -99,7 +99,7 @@ func UpdateAccountParameter(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteAccountParameter implements schema.DeleteFunc.
 func DeleteAccountParameter(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	key := d.Get("key").(string)
 
 	parameterDefault := snowflake.GetParameterDefaults(snowflake.ParameterTypeAccount)[key]
@@ -112,7 +112,13 @@ func DeleteAccountParameter(d *schema.ResourceData, meta interface{}) error {
 		value = fmt.Sprintf("'%s'", value)
 	}
 	builder := snowflake.NewAccountParameter(key, value, db)
-	err := builder.SetParameter()
+	var err error
+	// parameters without a default value (e.g. EVENT_TABLE) can only be unset
+	if defaultValue == nil {
+		err = builder.UnsetParameter()
+	} else {
+		err = builder.SetParameter()
+	}
 	if err != nil {
 		return fmt.Errorf("error creating account parameter err = %w", err)
 	}
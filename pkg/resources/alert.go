@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -169,7 +170,7 @@ func Alert() *schema.Resource {
 
 // ReadAlert implements schema.ReadFunc.
 func ReadAlert(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	alertID, err := alertIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -265,7 +266,7 @@ func ReadAlert(d *schema.ResourceData, meta interface{}) error {
 // CreateAlert implements schema.CreateFunc.
 func CreateAlert(d *schema.ResourceData, meta interface{}) error {
 	var err error
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 
 	database := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
@@ -342,7 +343,7 @@ func UpdateAlert(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	database := alertID.DatabaseName
 	schemaName := alertID.SchemaName
 	name := alertID.AlertName
@@ -434,7 +435,7 @@ func UpdateAlert(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteAlert implements schema.DeleteFunc.
 func DeleteAlert(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	alterID, err := alertIDFromString(d.Id())
 	if err != nil {
 		return err
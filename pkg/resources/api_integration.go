@@ -1,11 +1,11 @@
 package resources
 
 import (
-	"database/sql"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -129,7 +129,7 @@ func APIIntegration() *schema.Resource {
 
 // CreateAPIIntegration implements schema.CreateFunc.
 func CreateAPIIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	name := d.Get("name").(string)
 
 	stmt := snowflake.NewAPIIntegrationBuilder(name).Create()
@@ -168,7 +168,7 @@ func CreateAPIIntegration(d *schema.ResourceData, meta interface{}) error {
 
 // ReadAPIIntegration implements schema.ReadFunc.
 func ReadAPIIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	id := d.Id()
 
 	stmt := snowflake.NewAPIIntegrationBuilder(id).Show()
@@ -272,7 +272,7 @@ func ReadAPIIntegration(d *schema.ResourceData, meta interface{}) error {
 
 // UpdateAPIIntegration implements schema.UpdateFunc.
 func UpdateAPIIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	id := d.Id()
 
 	stmt := snowflake.NewAPIIntegrationBuilder(id).Alter()
@@ -49,7 +49,7 @@ func TestAPIIntegrationCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadAPIIntegration(mock)
 
-		err := resources.CreateAPIIntegration(d, db)
+		err := resources.CreateAPIIntegration(d, ProviderContext(db))
 		r.NoError(err)
 	})
 
@@ -59,7 +59,7 @@ func TestAPIIntegrationCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadGovAPIIntegration(mock)
 
-		err := resources.CreateAPIIntegration(d2, db)
+		err := resources.CreateAPIIntegration(d2, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -72,7 +72,7 @@ func TestAPIIntegrationRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadAPIIntegration(mock)
 
-		err := resources.ReadAPIIntegration(d, db)
+		err := resources.ReadAPIIntegration(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -84,7 +84,7 @@ func TestAPIIntegrationDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP API INTEGRATION "drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteAPIIntegration(d, db)
+		err := resources.DeleteAPIIntegration(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -2,12 +2,12 @@ package resources
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"golang.org/x/exp/slices"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 )
 
@@ -96,8 +96,7 @@ func Database() *schema.Resource {
 
 // CreateDatabase implements schema.CreateFunc.
 func CreateDatabase(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 	name := d.Get("name").(string)
 	id := sdk.NewAccountObjectIdentifier(name)
@@ -182,8 +181,7 @@ func CreateDatabase(d *schema.ResourceData, meta interface{}) error {
 }
 
 func ReadDatabase(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 	name := d.Id()
 	id := sdk.NewAccountObjectIdentifier(name)
@@ -213,8 +211,7 @@ func ReadDatabase(d *schema.ResourceData, meta interface{}) error {
 func UpdateDatabase(d *schema.ResourceData, meta interface{}) error {
 	name := d.Id()
 	id := sdk.NewAccountObjectIdentifier(name)
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 
 	if d.HasChange("name") {
@@ -319,8 +316,7 @@ func UpdateDatabase(d *schema.ResourceData, meta interface{}) error {
 }
 
 func DeleteDatabase(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 	name := d.Id()
 	id := sdk.NewAccountObjectIdentifier(name)
@@ -40,7 +40,7 @@ func TestDatabaseGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT USAGE ON DATABASE "test-database" TO SHARE "test-share-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT USAGE ON DATABASE "test-database" TO SHARE "test-share-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadDatabaseGrant(mock)
-		err := resources.CreateDatabaseGrant(d, db)
+		err := resources.CreateDatabaseGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -60,7 +60,7 @@ func TestDatabaseGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadDatabaseGrant(mock)
-		err := resources.ReadDatabaseGrant(d, db)
+		err := resources.ReadDatabaseGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 	roles := d.Get("roles").(*schema.Set)
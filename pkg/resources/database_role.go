@@ -2,12 +2,12 @@ package resources
 
 import (
 	"bytes"
-	"database/sql"
 	"encoding/csv"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -95,7 +95,7 @@ func DatabaseRole() *schema.Resource {
 
 // ReadDatabaseRole implements schema.ReadFunc.
 func ReadDatabaseRole(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	dbRoleID, err := databaseRoleIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -142,7 +142,7 @@ func ReadDatabaseRole(d *schema.ResourceData, meta interface{}) error {
 // CreateDatabaseRole implements schema.CreateFunc.
 func CreateDatabaseRole(d *schema.ResourceData, meta interface{}) error {
 	var err error
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	databaseName := d.Get("database").(string)
 	roleName := d.Get("name").(string)
 
@@ -176,7 +176,7 @@ func UpdateDatabaseRole(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	databaseName := dbRoleID.DatabaseName
 	roleName := dbRoleID.RoleName
 	builder := snowflake.NewDatabaseRoleBuilder(roleName, databaseName)
@@ -195,7 +195,7 @@ func UpdateDatabaseRole(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteDatabaseRole implements schema.DeleteFunc.
 func DeleteDatabaseRole(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	dbRoleID, err := databaseRoleIDFromString(d.Id())
 	if err != nil {
 		return err
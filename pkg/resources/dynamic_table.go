@@ -0,0 +1,351 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var dynamicTableSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the dynamic table.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the dynamic table.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the dynamic table; must be unique for the schema in which the dynamic table is created.",
+	},
+	"target_lag": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "Specifies how far the content of the dynamic table may lag behind its base tables, e.g. `5 minutes`, or `DOWNSTREAM` to refresh it only when the dynamic tables depending on it are refreshed.",
+		DiffSuppressFunc: DiffSuppressStatement,
+	},
+	"warehouse": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The warehouse used to refresh the dynamic table.",
+	},
+	"query": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Specifies the query whose results the dynamic table holds.",
+		DiffSuppressFunc: DiffSuppressStatement,
+	},
+	"refresh_mode": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Default:          string(sdk.DynamicTableRefreshModeAuto),
+		Description:      "Specifies the refresh mode of the dynamic table, one of `AUTO`, `FULL` or `INCREMENTAL`. With `AUTO`, Snowflake chooses the mode when the dynamic table is created and the state holds the chosen mode, see `refresh_mode_reason`.",
+		ValidateFunc:     validation.StringInSlice([]string{string(sdk.DynamicTableRefreshModeAuto), string(sdk.DynamicTableRefreshModeFull), string(sdk.DynamicTableRefreshModeIncremental)}, true),
+		DiffSuppressFunc: suppressDynamicTableRefreshModeAuto,
+	},
+	"cluster_by": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "A list of one or more columns or expressions to be used as clustering key of the dynamic table.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the dynamic table.",
+	},
+	"suspended": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether the scheduled refreshes of the dynamic table are suspended.",
+	},
+	"refresh_trigger": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Any value; changing it refreshes the dynamic table manually, without waiting for its target lag.",
+	},
+	"scheduling_state": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The scheduling state of the dynamic table, either `RUNNING` or `SUSPENDED`.",
+	},
+	"data_timestamp": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The time the content of the dynamic table is up to date with, empty before its first refresh.",
+	},
+	"last_suspended_on": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The time the dynamic table was last suspended.",
+	},
+	"refresh_mode_reason": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Explains why Snowflake chose the refresh mode when `refresh_mode` is `AUTO`.",
+	},
+	"owner": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Role that owns the dynamic table.",
+	},
+	"rows": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "Number of rows in the dynamic table.",
+	},
+	"bytes": {
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "Number of bytes that will be scanned if the entire dynamic table is scanned in a query.",
+	},
+}
+
+// suppressDynamicTableRefreshModeAuto suppresses the diff between AUTO and the refresh mode Snowflake chose for it,
+// which is the one reported for the dynamic table.
+func suppressDynamicTableRefreshModeAuto(_, old, new string, _ *schema.ResourceData) bool {
+	if old != "" && strings.EqualFold(new, string(sdk.DynamicTableRefreshModeAuto)) {
+		return true
+	}
+	return strings.EqualFold(old, new)
+}
+
+// DynamicTable returns a pointer to the resource representing a dynamic table.
+func DynamicTable() *schema.Resource {
+	return &schema.Resource{
+		Description: "A dynamic table materializes the results of a query and keeps them up to date within a target lag.",
+		Create:      CreateDynamicTable,
+		Read:        ReadDynamicTable,
+		Update:      UpdateDynamicTable,
+		Delete:      DeleteDynamicTable,
+
+		Schema: dynamicTableSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func expandTargetLag(v string) sdk.TargetLag {
+	if strings.EqualFold(strings.TrimSpace(v), "DOWNSTREAM") {
+		return sdk.TargetLag{Downstream: sdk.Bool(true)}
+	}
+	return sdk.TargetLag{Lagtime: sdk.String(v)}
+}
+
+// CreateDynamicTable implements schema.CreateFunc.
+func CreateDynamicTable(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	database := d.Get("database").(string)
+	schema := d.Get("schema").(string)
+	name := d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(database, schema, name)
+	warehouse := sdk.NewAccountObjectIdentifier(d.Get("warehouse").(string))
+	targetLag := expandTargetLag(d.Get("target_lag").(string))
+	query := d.Get("query").(string)
+
+	refreshMode := sdk.DynamicTableRefreshMode(strings.ToUpper(d.Get("refresh_mode").(string)))
+	createOptions := &sdk.CreateDynamicTableOptions{
+		RefreshMode: &refreshMode,
+	}
+	if v, ok := d.GetOk("cluster_by"); ok {
+		createOptions.ClusterBy = expandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		createOptions.Comment = sdk.String(v.(string))
+	}
+
+	// creating the dynamic table runs its initial refresh
+	if err := client.DynamicTables.Create(withResourceQueryTag(ctx, "snowflake_dynamic_table"), id, warehouse, targetLag, query, createOptions); err != nil {
+		return err
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	if d.Get("suspended").(bool) {
+		if err := client.DynamicTables.Alter(ctx, id, &sdk.AlterDynamicTableOptions{Suspend: sdk.Bool(true)}); err != nil {
+			return err
+		}
+	}
+	return ReadDynamicTable(d, meta)
+}
+
+// ReadDynamicTable implements schema.ReadFunc.
+func ReadDynamicTable(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	dynamicTable, err := client.DynamicTables.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		log.Printf("[DEBUG] dynamic table (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("database", dynamicTable.DatabaseName); err != nil {
+		return err
+	}
+	if err := d.Set("schema", dynamicTable.SchemaName); err != nil {
+		return err
+	}
+	if err := d.Set("name", dynamicTable.Name); err != nil {
+		return err
+	}
+	if err := d.Set("target_lag", dynamicTable.TargetLag); err != nil {
+		return err
+	}
+	if err := d.Set("warehouse", dynamicTable.Warehouse); err != nil {
+		return err
+	}
+	query, err := snowflake.NewViewSelectStatementExtractor(dynamicTable.Text).ExtractDynamicTable()
+	if err != nil {
+		return err
+	}
+	if err := d.Set("query", query); err != nil {
+		return err
+	}
+	if err := d.Set("refresh_mode", string(dynamicTable.RefreshMode)); err != nil {
+		return err
+	}
+	if err := d.Set("cluster_by", snowflake.ClusterStatementToList(dynamicTable.ClusterBy)); err != nil {
+		return err
+	}
+	if err := d.Set("comment", dynamicTable.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("suspended", dynamicTable.SchedulingState == sdk.DynamicTableSchedulingStateSuspended); err != nil {
+		return err
+	}
+	if err := d.Set("scheduling_state", string(dynamicTable.SchedulingState)); err != nil {
+		return err
+	}
+	dataTimestamp := ""
+	if dynamicTable.DataTimestamp != nil {
+		dataTimestamp = dynamicTable.DataTimestamp.Format(time.RFC3339)
+	}
+	if err := d.Set("data_timestamp", dataTimestamp); err != nil {
+		return err
+	}
+	lastSuspendedOn := ""
+	if dynamicTable.LastSuspendedOn != nil {
+		lastSuspendedOn = dynamicTable.LastSuspendedOn.Format(time.RFC3339)
+	}
+	if err := d.Set("last_suspended_on", lastSuspendedOn); err != nil {
+		return err
+	}
+	if err := d.Set("refresh_mode_reason", dynamicTable.RefreshModeReason); err != nil {
+		return err
+	}
+	if err := d.Set("owner", dynamicTable.Owner); err != nil {
+		return err
+	}
+	if err := d.Set("rows", dynamicTable.Rows); err != nil {
+		return err
+	}
+	if err := d.Set("bytes", dynamicTable.Bytes); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateDynamicTable implements schema.UpdateFunc.
+func UpdateDynamicTable(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	runSet := false
+	set := &sdk.DynamicTableSet{}
+	if d.HasChange("target_lag") {
+		runSet = true
+		targetLag := expandTargetLag(d.Get("target_lag").(string))
+		set.TargetLag = &targetLag
+	}
+	if d.HasChange("warehouse") {
+		runSet = true
+		set.Warehouse = sdk.NewAccountObjectIdentifier(d.Get("warehouse").(string))
+	}
+	if runSet {
+		if err := client.DynamicTables.Alter(ctx, id, &sdk.AlterDynamicTableOptions{Set: set}); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("cluster_by") {
+		alterOptions := &sdk.AlterDynamicTableOptions{DropClusteringKey: sdk.Bool(true)}
+		if clusterBy := expandStringList(d.Get("cluster_by").([]interface{})); len(clusterBy) > 0 {
+			alterOptions = &sdk.AlterDynamicTableOptions{ClusterBy: clusterBy}
+		}
+		if err := client.DynamicTables.Alter(ctx, id, alterOptions); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("comment") {
+		err := client.Comments.Set(ctx, &sdk.SetCommentOptions{
+			ObjectType: sdk.ObjectTypeDynamicTable,
+			ObjectName: id,
+			Value:      sdk.String(d.Get("comment").(string)),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("suspended") {
+		alterOptions := &sdk.AlterDynamicTableOptions{Resume: sdk.Bool(true)}
+		if d.Get("suspended").(bool) {
+			alterOptions = &sdk.AlterDynamicTableOptions{Suspend: sdk.Bool(true)}
+		}
+		if err := client.DynamicTables.Alter(ctx, id, alterOptions); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("refresh_trigger") {
+		if err := client.DynamicTables.Alter(withResourceQueryTag(ctx, "snowflake_dynamic_table"), id, &sdk.AlterDynamicTableOptions{Refresh: sdk.Bool(true)}); err != nil {
+			return err
+		}
+	}
+
+	return ReadDynamicTable(d, meta)
+}
+
+// DeleteDynamicTable implements schema.DeleteFunc.
+func DeleteDynamicTable(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	if err := client.DynamicTables.Drop(ctx, id, nil); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,92 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_DynamicTable(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: dynamicTableConfig(accName, "2 minutes", false, "", "this is a test resource"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_dynamic_table.dt", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_dynamic_table.dt", "target_lag", "2 minutes"),
+					resource.TestCheckResourceAttr("snowflake_dynamic_table.dt", "warehouse", accName),
+					resource.TestCheckResourceAttr("snowflake_dynamic_table.dt", "refresh_mode", "AUTO"),
+					resource.TestCheckResourceAttr("snowflake_dynamic_table.dt", "comment", "this is a test resource"),
+					resource.TestCheckResourceAttr("snowflake_dynamic_table.dt", "scheduling_state", "RUNNING"),
+				),
+			},
+			{
+				Config: dynamicTableConfig(accName, "DOWNSTREAM", true, "1", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_dynamic_table.dt", "target_lag", "DOWNSTREAM"),
+					resource.TestCheckResourceAttr("snowflake_dynamic_table.dt", "suspended", "true"),
+					resource.TestCheckResourceAttr("snowflake_dynamic_table.dt", "scheduling_state", "SUSPENDED"),
+					resource.TestCheckResourceAttrSet("snowflake_dynamic_table.dt", "data_timestamp"),
+					resource.TestCheckResourceAttr("snowflake_dynamic_table.dt", "comment", ""),
+				),
+			},
+			{
+				ResourceName:            "snowflake_dynamic_table.dt",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"refresh_trigger"},
+			},
+		},
+	})
+}
+
+func dynamicTableConfig(s string, targetLag string, suspended bool, refreshTrigger string, comment string) string {
+	return fmt.Sprintf(`
+	resource "snowflake_database" "test" {
+		name    = "%[1]v"
+		comment = "Terraform acceptance test"
+	}
+
+	resource "snowflake_schema" "test" {
+		name     = "%[1]v"
+		database = snowflake_database.test.name
+		comment  = "Terraform acceptance test"
+	}
+
+	resource "snowflake_warehouse" "test" {
+		name           = "%[1]v"
+		warehouse_size = "XSMALL"
+	}
+
+	resource "snowflake_table" "test" {
+		database        = snowflake_database.test.name
+		schema          = snowflake_schema.test.name
+		name            = "SOURCE"
+		change_tracking = true
+
+		column {
+			name = "ID"
+			type = "NUMBER(38,0)"
+		}
+	}
+
+	resource "snowflake_dynamic_table" "dt" {
+		database        = snowflake_database.test.name
+		schema          = snowflake_schema.test.name
+		name            = "%[1]v"
+		target_lag      = "%[2]v"
+		warehouse       = snowflake_warehouse.test.name
+		query           = "SELECT ID FROM \"${snowflake_database.test.name}\".\"${snowflake_schema.test.name}\".\"${snowflake_table.test.name}\""
+		suspended       = %[3]t
+		refresh_trigger = "%[4]v"
+		comment         = "%[5]v"
+	}
+	`, s, targetLag, suspended, refreshTrigger, comment)
+}
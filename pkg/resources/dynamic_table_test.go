@@ -0,0 +1,130 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+var dynamicTableShowColumns = []string{
+	"created_on", "name", "reserved", "database_name", "schema_name", "cluster_by", "rows", "bytes", "owner", "target_lag",
+	"refresh_mode", "refresh_mode_reason", "warehouse", "comment", "text", "automatic_clustering", "scheduling_state",
+	"last_suspended_on", "is_clone", "is_replica", "data_timestamp", "owner_role_type",
+}
+
+func TestDynamicTable(t *testing.T) {
+	r := require.New(t)
+	err := resources.DynamicTable().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestDynamicTableCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"database":   "test_db",
+		"schema":     "test_schema",
+		"name":       "test_dt",
+		"target_lag": "1 minute",
+		"warehouse":  "test_wh",
+		"query":      "SELECT ID FROM T",
+		"cluster_by": []interface{}{"ID"},
+		"comment":    "great comment",
+		"suspended":  true,
+	}
+	d := schema.TestResourceDataRaw(t, resources.DynamicTable().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectDynamicTableQueryTag(mock)
+		mock.ExpectExec(`^CREATE DYNAMIC TABLE "test_db"."test_schema"."test_dt" TARGET_LAG = '1 minute' WAREHOUSE = "test_wh" REFRESH_MODE = AUTO CLUSTER BY \(ID\) COMMENT = 'great comment' AS SELECT ID FROM T$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER SESSION UNSET QUERY_TAG$`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`^ALTER DYNAMIC TABLE "test_db"."test_schema"."test_dt" SUSPEND$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadDynamicTable(mock)
+		err := resources.CreateDynamicTable(d, ProviderContext(db))
+		r.NoError(err)
+		r.Equal("test_db|test_schema|test_dt", d.Id())
+		r.Equal("SELECT ID FROM T", d.Get("query"))
+		// Snowflake reports the refresh mode it chose for AUTO
+		r.Equal("INCREMENTAL", d.Get("refresh_mode"))
+		r.Equal([]interface{}{"ID"}, d.Get("cluster_by"))
+		r.Equal(true, d.Get("suspended"))
+		r.Equal("SUSPENDED", d.Get("scheduling_state"))
+		r.Equal("2023-09-01T10:00:00Z", d.Get("data_timestamp"))
+	})
+}
+
+func TestDynamicTableUpdate(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.DynamicTable().Schema, map[string]interface{}{
+		"database":        "test_db",
+		"schema":          "test_schema",
+		"name":            "test_dt",
+		"target_lag":      "DOWNSTREAM",
+		"warehouse":       "test_wh",
+		"query":           "SELECT ID FROM T",
+		"refresh_trigger": "1",
+	})
+	d.SetId("test_db|test_schema|test_dt")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER DYNAMIC TABLE "test_db"."test_schema"."test_dt" SET TARGET_LAG = DOWNSTREAM WAREHOUSE = "test_wh"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectDynamicTableQueryTag(mock)
+		mock.ExpectExec(`^ALTER DYNAMIC TABLE "test_db"."test_schema"."test_dt" REFRESH$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER SESSION UNSET QUERY_TAG$`).WillReturnResult(sqlmock.NewResult(0, 0))
+		expectReadDynamicTable(mock)
+		err := resources.UpdateDynamicTable(d, ProviderContext(db))
+		r.NoError(err)
+	})
+}
+
+func TestDynamicTableRefreshModeDiffSuppress(t *testing.T) {
+	r := require.New(t)
+
+	suppress := resources.DynamicTable().Schema["refresh_mode"].DiffSuppressFunc
+	// AUTO accepts whichever refresh mode Snowflake chose, e.g. after an import
+	r.True(suppress("refresh_mode", "INCREMENTAL", "AUTO", nil))
+	r.True(suppress("refresh_mode", "FULL", "auto", nil))
+	r.True(suppress("refresh_mode", "FULL", "full", nil))
+	r.False(suppress("refresh_mode", "INCREMENTAL", "FULL", nil))
+	r.False(suppress("refresh_mode", "", "AUTO", nil))
+}
+
+func TestDynamicTableReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.DynamicTable().Schema, map[string]interface{}{})
+	d.SetId("test_db|test_schema|test_dt")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows(dynamicTableShowColumns)
+		mock.ExpectQuery(`^SHOW DYNAMIC TABLES LIKE 'test_dt' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+		err := resources.ReadDynamicTable(d, ProviderContext(db))
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+// expectDynamicTableQueryTag expects the statements refreshing the dynamic table to be tagged with the resource type.
+func expectDynamicTableQueryTag(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(`^SHOW PARAMETERS IN SESSION$`).WillReturnRows(sqlmock.NewRows([]string{"key", "value", "default", "level", "description"}))
+	mock.ExpectExec(`^ALTER SESSION SET QUERY_TAG = 'terraform:snowflake_dynamic_table'$`).WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+func expectReadDynamicTable(mock sqlmock.Sqlmock) {
+	text := `CREATE DYNAMIC TABLE "test_db"."test_schema"."test_dt" TARGET_LAG = '1 minute' WAREHOUSE = "test_wh" REFRESH_MODE = AUTO CLUSTER BY (ID) COMMENT = 'great comment' AS SELECT ID FROM T`
+	dataTimestamp := time.Date(2023, 9, 1, 10, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows(dynamicTableShowColumns).
+		AddRow(time.Now(), "test_dt", "", "test_db", "test_schema", "LINEAR(ID)", 10, 1024, "ACCOUNTADMIN", "1 minute",
+			"INCREMENTAL", "", "test_wh", "great comment", text, "ON", "SUSPENDED",
+			time.Now(), false, false, dataTimestamp, "ROLE")
+	mock.ExpectQuery(`^SHOW DYNAMIC TABLES LIKE 'test_dt' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+}
@@ -1,11 +1,11 @@
 package resources
 
 import (
-	"database/sql"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -51,7 +51,7 @@ func EmailNotificationIntegration() *schema.Resource {
 
 // CreateEmailNotificationIntegration implements schema.CreateFunc.
 func CreateEmailNotificationIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	name := d.Get("name").(string)
 
 	stmt := snowflake.NewNotificationIntegrationBuilder(name).Create()
@@ -76,7 +76,7 @@ func CreateEmailNotificationIntegration(d *schema.ResourceData, meta interface{}
 
 // ReadEmailNotificationIntegration implements schema.ReadFunc.
 func ReadEmailNotificationIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 
 	stmt := snowflake.NewEmailNotificationIntegrationBuilder(d.Id()).Show()
 	row := snowflake.QueryRow(db, stmt)
@@ -128,7 +128,7 @@ func ReadEmailNotificationIntegration(d *schema.ResourceData, meta interface{})
 
 // UpdateEmailNotificationIntegration implements schema.UpdateFunc.
 func UpdateEmailNotificationIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	id := d.Id()
 
 	stmt := snowflake.NewEmailNotificationIntegrationBuilder(id).Alter()
@@ -0,0 +1,230 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var eventTableSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the event table.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the event table.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the event table; must be unique for the schema in which the event table is created.",
+	},
+	"cluster_by": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "A list of one or more columns or expressions to be used as clustering key of the event table.",
+	},
+	"data_retention_time_in_days": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      1,
+		Description:  "Number of days for which Snowflake retains historical data for performing Time Travel actions (SELECT, CLONE, UNDROP) on the event table.",
+		ValidateFunc: validation.IntBetween(0, 90),
+	},
+	"max_data_extension_time_in_days": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      14,
+		Description:  "Maximum number of days for which Snowflake can extend the data retention period for the event table to prevent streams on it from becoming stale.",
+		ValidateFunc: validation.IntBetween(0, 90),
+	},
+	"change_tracking": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Specifies whether to enable change tracking on the event table.",
+	},
+	"default_ddl_collation": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies a default collation specification for the columns in the event table.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the event table.",
+	},
+	"owner": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Role that owns the event table.",
+	},
+}
+
+// EventTable returns a pointer to the resource representing an event table.
+func EventTable() *schema.Resource {
+	return &schema.Resource{
+		Description: "An event table collects the log messages and trace events emitted by functions and procedures. Bind it with the `EVENT_TABLE` parameter, e.g. using `snowflake_account_parameter` or `snowflake_object_parameter`.",
+		Create:      CreateEventTable,
+		Read:        ReadEventTable,
+		Update:      UpdateEventTable,
+		Delete:      DeleteEventTable,
+
+		Schema: eventTableSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateEventTable implements schema.CreateFunc.
+func CreateEventTable(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	database := d.Get("database").(string)
+	schema := d.Get("schema").(string)
+	name := d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(database, schema, name)
+
+	createOptions := &sdk.CreateEventTableOptions{
+		DataRetentionTimeInDays:    sdk.Int(d.Get("data_retention_time_in_days").(int)),
+		MaxDataExtensionTimeInDays: sdk.Int(d.Get("max_data_extension_time_in_days").(int)),
+		ChangeTracking:             sdk.Bool(d.Get("change_tracking").(bool)),
+	}
+	if v, ok := d.GetOk("cluster_by"); ok {
+		createOptions.ClusterBy = expandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("default_ddl_collation"); ok {
+		createOptions.DefaultDDLCollation = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		createOptions.Comment = sdk.String(v.(string))
+	}
+
+	if err := client.EventTables.Create(ctx, id, createOptions); err != nil {
+		return err
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadEventTable(d, meta)
+}
+
+// ReadEventTable implements schema.ReadFunc. SHOW EVENT TABLES does not report the clustering key, the retention
+// parameters or change tracking, so those are kept as configured.
+func ReadEventTable(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	eventTable, err := client.EventTables.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		log.Printf("[DEBUG] event table (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("database", eventTable.DatabaseName); err != nil {
+		return err
+	}
+	if err := d.Set("schema", eventTable.SchemaName); err != nil {
+		return err
+	}
+	if err := d.Set("name", eventTable.Name); err != nil {
+		return err
+	}
+	if err := d.Set("comment", eventTable.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("owner", eventTable.Owner); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateEventTable implements schema.UpdateFunc.
+func UpdateEventTable(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if d.HasChange("cluster_by") {
+		alterOptions := &sdk.AlterEventTableOptions{DropClusteringKey: sdk.Bool(true)}
+		if clusterBy := expandStringList(d.Get("cluster_by").([]interface{})); len(clusterBy) > 0 {
+			alterOptions = &sdk.AlterEventTableOptions{ClusterBy: clusterBy}
+		}
+		if err := client.EventTables.Alter(ctx, id, alterOptions); err != nil {
+			return err
+		}
+	}
+
+	runSet := false
+	set := &sdk.EventTableSet{}
+	if d.HasChange("data_retention_time_in_days") {
+		runSet = true
+		set.DataRetentionTimeInDays = sdk.Int(d.Get("data_retention_time_in_days").(int))
+	}
+	if d.HasChange("max_data_extension_time_in_days") {
+		runSet = true
+		set.MaxDataExtensionTimeInDays = sdk.Int(d.Get("max_data_extension_time_in_days").(int))
+	}
+	if d.HasChange("change_tracking") {
+		runSet = true
+		set.ChangeTracking = sdk.Bool(d.Get("change_tracking").(bool))
+	}
+	runUnset := false
+	unset := &sdk.EventTableUnset{}
+	if d.HasChange("comment") {
+		if comment := d.Get("comment").(string); comment != "" {
+			runSet = true
+			set.Comment = sdk.String(comment)
+		} else {
+			runUnset = true
+			unset.Comment = sdk.Bool(true)
+		}
+	}
+	if runSet {
+		if err := client.EventTables.Alter(ctx, id, &sdk.AlterEventTableOptions{Set: set}); err != nil {
+			return err
+		}
+	}
+	if runUnset {
+		if err := client.EventTables.Alter(ctx, id, &sdk.AlterEventTableOptions{Unset: unset}); err != nil {
+			return err
+		}
+	}
+
+	return ReadEventTable(d, meta)
+}
+
+// DeleteEventTable implements schema.DeleteFunc.
+func DeleteEventTable(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	if err := client.EventTables.Drop(ctx, id, nil); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,92 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_EventTable(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: eventTableConfig(accName, 1, false, "this is a test resource"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_event_table.et", "name", "EVENTS"),
+					resource.TestCheckResourceAttr("snowflake_event_table.et", "database", accName),
+					resource.TestCheckResourceAttr("snowflake_event_table.et", "schema", accName),
+					resource.TestCheckResourceAttr("snowflake_event_table.et", "data_retention_time_in_days", "1"),
+					resource.TestCheckResourceAttr("snowflake_event_table.et", "change_tracking", "false"),
+					resource.TestCheckResourceAttr("snowflake_event_table.et", "comment", "this is a test resource"),
+					resource.TestCheckResourceAttrSet("snowflake_event_table.et", "owner"),
+					resource.TestCheckResourceAttr("snowflake_object_parameter.event_table", "value", fmt.Sprintf("%[1]v.%[1]v.EVENTS", accName)),
+					resource.TestCheckResourceAttr("snowflake_object_parameter.log_level", "value", "INFO"),
+				),
+			},
+			{
+				Config: eventTableConfig(accName, 2, true, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_event_table.et", "data_retention_time_in_days", "2"),
+					resource.TestCheckResourceAttr("snowflake_event_table.et", "change_tracking", "true"),
+					resource.TestCheckResourceAttr("snowflake_event_table.et", "comment", ""),
+				),
+			},
+			{
+				ResourceName:            "snowflake_event_table.et",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cluster_by", "data_retention_time_in_days", "max_data_extension_time_in_days", "change_tracking"},
+			},
+		},
+	})
+}
+
+func eventTableConfig(s string, dataRetentionTimeInDays int, changeTracking bool, comment string) string {
+	return fmt.Sprintf(`
+	resource "snowflake_database" "test" {
+		name    = "%[1]v"
+		comment = "Terraform acceptance test"
+	}
+
+	resource "snowflake_schema" "test" {
+		name     = "%[1]v"
+		database = snowflake_database.test.name
+		comment  = "Terraform acceptance test"
+	}
+
+	resource "snowflake_event_table" "et" {
+		database                    = snowflake_database.test.name
+		schema                      = snowflake_schema.test.name
+		name                        = "EVENTS"
+		cluster_by                  = ["timestamp"]
+		data_retention_time_in_days = %[2]d
+		change_tracking             = %[3]t
+		comment                     = "%[4]v"
+	}
+
+	resource "snowflake_object_parameter" "event_table" {
+		key         = "EVENT_TABLE"
+		value       = "${snowflake_event_table.et.database}.${snowflake_event_table.et.schema}.${snowflake_event_table.et.name}"
+		object_type = "DATABASE"
+		object_identifier {
+			name = snowflake_database.test.name
+		}
+	}
+
+	resource "snowflake_object_parameter" "log_level" {
+		key         = "LOG_LEVEL"
+		value       = "INFO"
+		object_type = "DATABASE"
+		object_identifier {
+			name = snowflake_database.test.name
+		}
+	}
+	`, s, dataRetentionTimeInDays, changeTracking, comment)
+}
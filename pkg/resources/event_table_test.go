@@ -0,0 +1,90 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+var eventTableShowColumns = []string{"created_on", "name", "database_name", "schema_name", "owner", "comment", "owner_role_type"}
+
+func TestEventTable(t *testing.T) {
+	r := require.New(t)
+	err := resources.EventTable().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestEventTableCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"database":                    "test_db",
+		"schema":                      "test_schema",
+		"name":                        "test_events",
+		"cluster_by":                  []interface{}{"timestamp"},
+		"data_retention_time_in_days": 7,
+		"change_tracking":             true,
+		"comment":                     "great comment",
+	}
+	d := schema.TestResourceDataRaw(t, resources.EventTable().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE EVENT TABLE "test_db"."test_schema"."test_events" CLUSTER BY \(timestamp\) DATA_RETENTION_TIME_IN_DAYS = 7 MAX_DATA_EXTENSION_TIME_IN_DAYS = 14 CHANGE_TRACKING = true COMMENT = 'great comment'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadEventTable(mock, "great comment")
+		err := resources.CreateEventTable(d, ProviderContext(db))
+		r.NoError(err)
+		r.Equal("test_db|test_schema|test_events", d.Id())
+		r.Equal("great comment", d.Get("comment"))
+		r.Equal("ACCOUNTADMIN", d.Get("owner"))
+	})
+}
+
+func TestEventTableUpdate(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.EventTable().Schema, map[string]interface{}{
+		"database":                    "test_db",
+		"schema":                      "test_schema",
+		"name":                        "test_events",
+		"cluster_by":                  []interface{}{"timestamp"},
+		"data_retention_time_in_days": 3,
+	})
+	d.SetId("test_db|test_schema|test_events")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER TABLE "test_db"."test_schema"."test_events" CLUSTER BY \(timestamp\)$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`^ALTER TABLE "test_db"."test_schema"."test_events" SET DATA_RETENTION_TIME_IN_DAYS = 3 MAX_DATA_EXTENSION_TIME_IN_DAYS = 14$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadEventTable(mock, "")
+		err := resources.UpdateEventTable(d, ProviderContext(db))
+		r.NoError(err)
+	})
+}
+
+func TestEventTableReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.EventTable().Schema, map[string]interface{}{})
+	d.SetId("test_db|test_schema|test_events")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows(eventTableShowColumns)
+		mock.ExpectQuery(`^SHOW EVENT TABLES LIKE 'test_events' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+		err := resources.ReadEventTable(d, ProviderContext(db))
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func expectReadEventTable(mock sqlmock.Sqlmock, comment string) {
+	rows := sqlmock.NewRows(eventTableShowColumns).
+		AddRow(time.Now(), "test_events", "test_db", "test_schema", "ACCOUNTADMIN", comment, "ROLE")
+	mock.ExpectQuery(`^SHOW EVENT TABLES LIKE 'test_events' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+}
@@ -0,0 +1,247 @@
+package resources
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var externalAccessIntegrationSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the name of the external access integration. This name follows the rules for Object Identifiers. The name should be unique among integrations in your account.",
+	},
+	"allowed_network_rules": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Required:    true,
+		MinItems:    1,
+		Description: "Specifies the fully qualified names of the egress network rules (e.g. the `qualified_name` of a `snowflake_network_rule`) that define the external network locations the integration allows access to.",
+	},
+	"allowed_api_authentication_integrations": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies the names of the API_AUTHENTICATION security integrations whose OAuth flows the functions and procedures using this integration are allowed to use.",
+	},
+	"allowed_authentication_secrets": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies the fully qualified names of the secrets (e.g. `db.schema.secret`) that the functions and procedures using this integration are allowed to use.",
+	},
+	"enabled": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Specifies whether this external access integration is enabled or disabled. If the integration is disabled, any function or procedure that relies on it will not be able to reach the external network locations.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the external access integration.",
+	},
+	"created_on": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date and time when the external access integration was created.",
+	},
+}
+
+// ExternalAccessIntegration returns a pointer to the resource representing an external access integration.
+func ExternalAccessIntegration() *schema.Resource {
+	return &schema.Resource{
+		Description: "An external access integration allows functions and procedures to reach the external network locations of egress network rules, using the allowed secrets to authenticate.",
+		Create:      CreateExternalAccessIntegration,
+		Read:        ReadExternalAccessIntegration,
+		Update:      UpdateExternalAccessIntegration,
+		Delete:      DeleteExternalAccessIntegration,
+
+		Schema: externalAccessIntegrationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateExternalAccessIntegration implements schema.CreateFunc.
+func CreateExternalAccessIntegration(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*provider.Context).DB
+	name := d.Get("name").(string)
+
+	stmt := snowflake.NewExternalAccessIntegrationBuilder(name).Create()
+
+	// Set required fields
+	stmt.SetRaw("ALLOWED_NETWORK_RULES=" + snowflake.IdentifierList(expandStringList(d.Get("allowed_network_rules").(*schema.Set).List())))
+	stmt.SetBool(`ENABLED`, d.Get("enabled").(bool))
+
+	// Set optional fields
+	if v, ok := d.GetOk("allowed_api_authentication_integrations"); ok {
+		stmt.SetRaw("ALLOWED_API_AUTHENTICATION_INTEGRATIONS=" + snowflake.IdentifierList(expandStringList(v.(*schema.Set).List())))
+	}
+
+	if v, ok := d.GetOk("allowed_authentication_secrets"); ok {
+		stmt.SetRaw("ALLOWED_AUTHENTICATION_SECRETS=" + snowflake.IdentifierList(expandStringList(v.(*schema.Set).List())))
+	}
+
+	if v, ok := d.GetOk("comment"); ok {
+		stmt.SetString("COMMENT", v.(string))
+	}
+
+	if err := snowflake.Exec(db, stmt.Statement()); err != nil {
+		return fmt.Errorf("error creating external access integration: %w", err)
+	}
+
+	d.SetId(name)
+
+	return ReadExternalAccessIntegration(d, meta)
+}
+
+// ReadExternalAccessIntegration implements schema.ReadFunc.
+func ReadExternalAccessIntegration(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*provider.Context).DB
+	id := d.Id()
+
+	stmt := snowflake.NewExternalAccessIntegrationBuilder(id).Show()
+	row := snowflake.QueryRow(db, stmt)
+
+	// Some properties can come from the SHOW INTEGRATION call
+
+	s, err := snowflake.ScanExternalAccessIntegration(row)
+	if err != nil {
+		// If no such resource exists, it is not an error but rather not exist
+		if err.Error() == snowflake.ErrNoRowInRS {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("could not show external access integration: %w", err)
+	}
+
+	// Note: type must be EXTERNAL_ACCESS or something is broken
+	if c := s.IntegrationType.String; c != "EXTERNAL_ACCESS" {
+		return fmt.Errorf("expected %v to be an external access integration, got %v", id, c)
+	}
+
+	if err := d.Set("name", s.Name.String); err != nil {
+		return err
+	}
+
+	if err := d.Set("comment", s.Comment.String); err != nil {
+		return err
+	}
+
+	if err := d.Set("created_on", s.CreatedOn.String); err != nil {
+		return err
+	}
+
+	if err := d.Set("enabled", s.Enabled.Bool); err != nil {
+		return err
+	}
+
+	// Some properties come from the DESCRIBE INTEGRATION call
+	// We need to grab them in a loop
+	var k, pType string
+	var v, unused interface{}
+	stmt = snowflake.NewExternalAccessIntegrationBuilder(id).Describe()
+	rows, err := db.Query(stmt)
+	if err != nil {
+		return fmt.Errorf("could not describe external access integration: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err := rows.Scan(&k, &pType, &v, &unused); err != nil {
+			return err
+		}
+		switch k {
+		case "ENABLED", "COMMENT":
+			// We set these using the SHOW INTEGRATION call so let's ignore them here
+		case "ALLOWED_NETWORK_RULES":
+			configured := expandStringList(d.Get("allowed_network_rules").(*schema.Set).List())
+			if err := d.Set("allowed_network_rules", identifiersWithConfiguredNames(configured, parseIdentifierList(v.(string)))); err != nil {
+				return err
+			}
+		case "ALLOWED_API_AUTHENTICATION_INTEGRATIONS":
+			configured := expandStringList(d.Get("allowed_api_authentication_integrations").(*schema.Set).List())
+			if err := d.Set("allowed_api_authentication_integrations", identifiersWithConfiguredNames(configured, parseIdentifierList(v.(string)))); err != nil {
+				return err
+			}
+		case "ALLOWED_AUTHENTICATION_SECRETS":
+			configured := expandStringList(d.Get("allowed_authentication_secrets").(*schema.Set).List())
+			if err := d.Set("allowed_authentication_secrets", identifiersWithConfiguredNames(configured, parseIdentifierList(v.(string)))); err != nil {
+				return err
+			}
+		default:
+			log.Printf("[WARN] unexpected external access integration property %v returned from Snowflake", k)
+		}
+	}
+
+	return err
+}
+
+// UpdateExternalAccessIntegration implements schema.UpdateFunc.
+func UpdateExternalAccessIntegration(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*provider.Context).DB
+	id := d.Id()
+
+	stmt := snowflake.NewExternalAccessIntegrationBuilder(id).Alter()
+
+	var runSetStatement bool
+
+	if d.HasChange("enabled") {
+		runSetStatement = true
+		stmt.SetBool(`ENABLED`, d.Get("enabled").(bool))
+	}
+
+	if d.HasChange("allowed_network_rules") {
+		runSetStatement = true
+		stmt.SetRaw("ALLOWED_NETWORK_RULES=" + snowflake.IdentifierList(expandStringList(d.Get("allowed_network_rules").(*schema.Set).List())))
+	}
+
+	if d.HasChange("comment") {
+		runSetStatement = true
+		stmt.SetString("COMMENT", d.Get("comment").(string))
+	}
+
+	// We need to UNSET these if we remove all the allowed integrations or secrets.
+	if d.HasChange("allowed_api_authentication_integrations") {
+		v := expandStringList(d.Get("allowed_api_authentication_integrations").(*schema.Set).List())
+		if len(v) == 0 {
+			if err := snowflake.Exec(db, fmt.Sprintf(`ALTER EXTERNAL ACCESS INTEGRATION "%v" UNSET ALLOWED_API_AUTHENTICATION_INTEGRATIONS`, id)); err != nil {
+				return fmt.Errorf("error unsetting allowed_api_authentication_integrations: %w", err)
+			}
+		} else {
+			runSetStatement = true
+			stmt.SetRaw("ALLOWED_API_AUTHENTICATION_INTEGRATIONS=" + snowflake.IdentifierList(v))
+		}
+	}
+
+	if d.HasChange("allowed_authentication_secrets") {
+		v := expandStringList(d.Get("allowed_authentication_secrets").(*schema.Set).List())
+		if len(v) == 0 {
+			if err := snowflake.Exec(db, fmt.Sprintf(`ALTER EXTERNAL ACCESS INTEGRATION "%v" UNSET ALLOWED_AUTHENTICATION_SECRETS`, id)); err != nil {
+				return fmt.Errorf("error unsetting allowed_authentication_secrets: %w", err)
+			}
+		} else {
+			runSetStatement = true
+			stmt.SetRaw("ALLOWED_AUTHENTICATION_SECRETS=" + snowflake.IdentifierList(v))
+		}
+	}
+
+	if runSetStatement {
+		if err := snowflake.Exec(db, stmt.Statement()); err != nil {
+			return fmt.Errorf("error updating external access integration: %w", err)
+		}
+	}
+
+	return ReadExternalAccessIntegration(d, meta)
+}
+
+// DeleteExternalAccessIntegration implements schema.DeleteFunc.
+func DeleteExternalAccessIntegration(d *schema.ResourceData, meta interface{}) error {
+	return DeleteResource("", snowflake.NewExternalAccessIntegrationBuilder)(d, meta)
+}
@@ -0,0 +1,91 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_ExternalAccessIntegration(t *testing.T) {
+	if _, ok := os.LookupEnv("SKIP_EXTERNAL_ACCESS_INTEGRATION_TESTS"); ok {
+		t.Skip("Skipping TestAcc_ExternalAccessIntegration")
+	}
+
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: externalAccessIntegrationConfig(accName, true, "this is a test resource"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "allowed_network_rules.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "allowed_authentication_secrets.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "comment", "this is a test resource"),
+					resource.TestCheckResourceAttrSet("snowflake_external_access_integration.test", "created_on"),
+				),
+			},
+			{
+				Config: externalAccessIntegrationConfig(accName, false, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("snowflake_external_access_integration.test", "comment", ""),
+				),
+			},
+			{
+				ResourceName:      "snowflake_external_access_integration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the configured names are only kept while they are in the configuration
+				ImportStateVerifyIgnore: []string{"allowed_network_rules", "allowed_authentication_secrets"},
+			},
+		},
+	})
+}
+
+func externalAccessIntegrationConfig(s string, enabled bool, comment string) string {
+	return fmt.Sprintf(`
+	resource "snowflake_database" "test" {
+		name    = "%[1]v"
+		comment = "Terraform acceptance test"
+	}
+
+	resource "snowflake_schema" "test" {
+		name     = "%[1]v"
+		database = snowflake_database.test.name
+		comment  = "Terraform acceptance test"
+	}
+
+	resource "snowflake_network_rule" "rule" {
+		database   = snowflake_database.test.name
+		schema     = snowflake_schema.test.name
+		name       = "RULE"
+		type       = "HOST_PORT"
+		mode       = "EGRESS"
+		value_list = ["example.com"]
+	}
+
+	resource "snowflake_secret" "secret" {
+		database      = snowflake_database.test.name
+		schema        = snowflake_schema.test.name
+		name          = "API_KEY"
+		type          = "GENERIC_STRING"
+		secret_string = "s3cr3t"
+	}
+
+	resource "snowflake_external_access_integration" "test" {
+		name                           = "%[1]v"
+		allowed_network_rules          = [snowflake_network_rule.rule.qualified_name]
+		allowed_authentication_secrets = ["\"%[1]v\".\"%[1]v\".\"${snowflake_secret.secret.name}\""]
+		enabled                        = %[2]t
+		comment                        = "%[3]v"
+	}
+	`, s, enabled, comment)
+}
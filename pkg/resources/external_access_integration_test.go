@@ -0,0 +1,96 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExternalAccessIntegration(t *testing.T) {
+	r := require.New(t)
+	err := resources.ExternalAccessIntegration().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestExternalAccessIntegrationCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                           "test_external_access_integration",
+		"allowed_network_rules":          []interface{}{`"test_db"."test_schema"."test_rule"`},
+		"allowed_authentication_secrets": []interface{}{"test_db.test_schema.test_secret"},
+		"comment":                        "great comment",
+	}
+	d := schema.TestResourceDataRaw(t, resources.ExternalAccessIntegration().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^CREATE EXTERNAL ACCESS INTEGRATION "test_external_access_integration" ALLOWED_NETWORK_RULES=\("test_db"."test_schema"."test_rule"\) ALLOWED_AUTHENTICATION_SECRETS=\(test_db.test_schema.test_secret\) COMMENT='great comment' ENABLED=true$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadExternalAccessIntegration(mock)
+
+		err := resources.CreateExternalAccessIntegration(d, ProviderContext(db))
+		r.NoError(err)
+		r.Equal([]interface{}{`"test_db"."test_schema"."test_rule"`}, d.Get("allowed_network_rules").(*schema.Set).List())
+		r.Equal([]interface{}{"test_db.test_schema.test_secret"}, d.Get("allowed_authentication_secrets").(*schema.Set).List())
+	})
+}
+
+func TestExternalAccessIntegrationUpdate(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.ExternalAccessIntegration().Schema, map[string]interface{}{
+		"name":                  "test_external_access_integration",
+		"allowed_network_rules": []interface{}{"TEST_DB.TEST_SCHEMA.TEST_RULE"},
+		"enabled":               true,
+	})
+	d.SetId("test_external_access_integration")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`^ALTER EXTERNAL ACCESS INTEGRATION "test_external_access_integration" SET ALLOWED_NETWORK_RULES=\(TEST_DB.TEST_SCHEMA.TEST_RULE\) ENABLED=true$`,
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadExternalAccessIntegration(mock)
+
+		err := resources.UpdateExternalAccessIntegration(d, ProviderContext(db))
+		r.NoError(err)
+	})
+}
+
+func TestExternalAccessIntegrationDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.ExternalAccessIntegration().Schema, map[string]interface{}{"name": "drop_it"})
+	d.SetId("drop_it")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`DROP EXTERNAL ACCESS INTEGRATION "drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
+		err := resources.DeleteExternalAccessIntegration(d, ProviderContext(db))
+		r.NoError(err)
+	})
+}
+
+func expectReadExternalAccessIntegration(mock sqlmock.Sqlmock) {
+	showRows := sqlmock.NewRows([]string{
+		"name", "type", "category", "enabled", "comment", "created_on",
+	},
+	).AddRow("test_external_access_integration", "EXTERNAL_ACCESS", "SECURITY", true, "great comment", "now")
+	mock.ExpectQuery(`^SHOW EXTERNAL ACCESS INTEGRATIONS LIKE 'test_external_access_integration'$`).WillReturnRows(showRows)
+
+	descRows := sqlmock.NewRows([]string{
+		"property", "property_type", "property_value", "property_default",
+	}).AddRow("ENABLED", "Boolean", "true", "false").
+		AddRow("ALLOWED_NETWORK_RULES", "List", "[TEST_DB.TEST_SCHEMA.TEST_RULE]", "[]").
+		AddRow("ALLOWED_API_AUTHENTICATION_INTEGRATIONS", "List", "[]", "[]").
+		AddRow("ALLOWED_AUTHENTICATION_SECRETS", "List", "[TEST_DB.TEST_SCHEMA.TEST_SECRET]", "[]").
+		AddRow("COMMENT", "String", "great comment", nil)
+
+	mock.ExpectQuery(`DESCRIBE EXTERNAL ACCESS INTEGRATION "test_external_access_integration"$`).WillReturnRows(descRows)
+}
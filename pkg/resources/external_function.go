@@ -2,7 +2,6 @@ package resources
 
 import (
 	"bytes"
-	"database/sql"
 	"encoding/csv"
 	"fmt"
 	"log"
@@ -10,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -239,7 +239,7 @@ func externalFunctionIDFromString(stringID string) (*externalFunctionID, error)
 
 // CreateExternalFunction implements schema.CreateFunc.
 func CreateExternalFunction(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	database := d.Get("database").(string)
 	dbSchema := d.Get("schema").(string)
 	name := d.Get("name").(string)
@@ -341,7 +341,7 @@ func CreateExternalFunction(d *schema.ResourceData, meta interface{}) error {
 
 // ReadExternalFunction implements schema.ReadFunc.
 func ReadExternalFunction(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	externalFunctionID, err := externalFunctionIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -516,7 +516,7 @@ func ReadExternalFunction(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteExternalFunction implements schema.DeleteFunc.
 func DeleteExternalFunction(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	externalFunctionID, err := externalFunctionIDFromString(d.Id())
 	if err != nil {
 		return err
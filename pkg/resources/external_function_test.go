@@ -39,7 +39,7 @@ func TestExternalFunctionCreate(t *testing.T) {
 		mock.ExpectExec(`CREATE EXTERNAL FUNCTION "database_name"."schema_name"."my_test_function" \(data varchar\) RETURNS varchar NULL CALLED ON NULL INPUT IMMUTABLE COMMENT = 'user-defined function' API_INTEGRATION = 'test_api_integration_01' HEADERS = \('x-custom-header' = 'snowflake'\) CONTEXT_HEADERS = \(current_timestamp\) COMPRESSION = 'AUTO' AS 'https://123456.execute-api.us-west-2.amazonaws.com/prod/my_test_function'`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectExternalFunctionRead(mock)
-		err := resources.CreateExternalFunction(d, db)
+		err := resources.CreateExternalFunction(d, ProviderContext(db))
 		r.NoError(err)
 		r.Equal("my_test_function", d.Get("name").(string))
 	})
@@ -87,7 +87,7 @@ func TestExternalFunctionRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectExternalFunctionRead(mock)
 
-		err := resources.ReadExternalFunction(d, db)
+		err := resources.ReadExternalFunction(d, ProviderContext(db))
 		r.NoError(err)
 		r.Equal("my_test_function", d.Get("name").(string))
 		r.Equal("mock comment", d.Get("comment").(string))
@@ -123,7 +123,7 @@ func TestExternalFunctionReadReturnTypeVariant(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectExternalFunctionReadVariant(mock)
 
-		err := resources.ReadExternalFunction(d, db)
+		err := resources.ReadExternalFunction(d, ProviderContext(db))
 		r.NoError(err)
 		r.Equal("my_test_function", d.Get("name").(string))
 		r.Equal("mock comment", d.Get("comment").(string))
@@ -158,7 +158,7 @@ func TestExternalFunctionDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP FUNCTION "database_name"."schema_name"."drop_it" ()`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteExternalFunction(d, db)
+		err := resources.DeleteExternalFunction(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
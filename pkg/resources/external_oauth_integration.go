@@ -1,10 +1,10 @@
 package resources
 
 import (
-	"database/sql"
 	"fmt"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -209,7 +209,7 @@ func CreateExternalOauthIntegration(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("couldn't generate create statement: %w", err)
 	}
 
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	_, err = db.Exec(stmt)
 	if err != nil {
 		return fmt.Errorf("error executing create statement: %w", err)
@@ -229,7 +229,7 @@ func ReadExternalOauthIntegration(d *schema.ResourceData, meta interface{}) erro
 
 	input := ExternalOauthIntegrationIdentifier(d.Id())
 
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 
 	// This resource needs a SHOW and a DESCRIBE
 
@@ -517,7 +517,7 @@ func UpdateExternalOauthIntegration(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 
 	if runAlter {
 		stmt, err := manager.Update(alterInput)
@@ -564,7 +564,7 @@ func DeleteExternalOauthIntegration(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("couldn't generate drop statement: %w", err)
 	}
 
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	_, err = db.Exec(stmt)
 	if err != nil {
 		return fmt.Errorf("error executing drop statement: %w", err)
@@ -2,12 +2,12 @@ package resources
 
 import (
 	"bytes"
-	"database/sql"
 	"encoding/csv"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -191,7 +191,7 @@ func externalTableIDFromString(stringID string) (*externalTableID, error) {
 
 // CreateExternalTable implements schema.CreateFunc.
 func CreateExternalTable(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	database := d.Get("database").(string)
 	dbSchema := d.Get("schema").(string)
 	name := d.Get("name").(string)
@@ -259,7 +259,7 @@ func CreateExternalTable(d *schema.ResourceData, meta interface{}) error {
 
 // ReadExternalTable implements schema.ReadFunc.
 func ReadExternalTable(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	externalTableID, err := externalTableIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -293,7 +293,7 @@ func ReadExternalTable(d *schema.ResourceData, meta interface{}) error {
 
 // UpdateExternalTable implements schema.UpdateFunc.
 func UpdateExternalTable(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	database := d.Get("database").(string)
 	dbSchema := d.Get("schema").(string)
 	name := d.Get("name").(string)
@@ -327,7 +327,7 @@ func UpdateExternalTable(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteExternalTable implements schema.DeleteFunc.
 func DeleteExternalTable(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	externalTableID, err := externalTableIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -36,7 +36,7 @@ func TestExternalTableCreate(t *testing.T) {
 		mock.ExpectExec(`CREATE EXTERNAL TABLE "database_name"."schema_name"."good_name" \("column1" OBJECT AS a, "column2" VARCHAR AS b\) WITH LOCATION = location REFRESH_ON_CREATE = true AUTO_REFRESH = true PATTERN = 'pattern' FILE_FORMAT = \( FORMAT_NAME = 'format' \) COMMENT = 'great comment'`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectExternalTableRead(mock)
-		err := resources.CreateExternalTable(d, db)
+		err := resources.CreateExternalTable(d, ProviderContext(db))
 		r.NoError(err)
 		r.Equal("good_name", d.Get("name").(string))
 	})
@@ -55,7 +55,7 @@ func TestExternalTableRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectExternalTableRead(mock)
 
-		err := resources.ReadExternalTable(d, db)
+		err := resources.ReadExternalTable(d, ProviderContext(db))
 		r.NoError(err)
 		r.Equal("good_name", d.Get("name").(string))
 		r.Equal("mock comment", d.Get("comment").(string))
@@ -69,7 +69,7 @@ func TestExternalTableDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP EXTERNAL TABLE "database_name"."schema_name"."drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteExternalTable(d, db)
+		err := resources.DeleteExternalTable(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
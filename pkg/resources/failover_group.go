@@ -2,7 +2,6 @@ package resources
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"fmt"
 	"strconv"
@@ -12,6 +11,7 @@ import (
 
 	"golang.org/x/exp/slices"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 )
 
@@ -151,8 +151,7 @@ func FailoverGroup() *schema.Resource {
 
 // CreateFailoverGroup implements schema.CreateFunc.
 func CreateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 	// getting required attributes
 	name := d.Get("name").(string)
@@ -266,8 +265,7 @@ func CreateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 
 // ReadFailoverGroup implements schema.ReadFunc.
 func ReadFailoverGroup(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 	name := d.Id()
 	id := sdk.NewAccountObjectIdentifier(name)
@@ -394,8 +392,7 @@ func ReadFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 
 // UpdateFailoverGroup implements schema.UpdateFunc.
 func UpdateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 	name := d.Id()
 	id := sdk.NewAccountObjectIdentifier(name)
@@ -624,8 +621,7 @@ func UpdateFailoverGroup(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteFailoverGroup implements schema.DeleteFunc.
 func DeleteFailoverGroup(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	name := d.Id()
 	id := sdk.NewAccountObjectIdentifier(name)
 	ctx := context.Background()
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 )
 
@@ -305,7 +306,7 @@ func FileFormat() *schema.Resource {
 
 // CreateFileFormat implements schema.CreateFunc.
 func CreateFileFormat(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 
 	dbName := d.Get("database").(string)
 	schemaName := d.Get("schema").(string)
@@ -523,7 +524,7 @@ func CreateFileFormat(d *schema.ResourceData, meta interface{}) error {
 
 // ReadFileFormat implements schema.ReadFunc.
 func ReadFileFormat(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	fileFormatID, err := fileFormatIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -708,7 +709,7 @@ func UpdateFileFormat(d *schema.ResourceData, meta interface{}) error {
 	builder := snowflake.FileFormat(fileFormatName, dbName, schemaName)
 	fmt.Println(builder)
 
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	if d.HasChange("compression") {
 		change := d.Get("compression")
 		q := builder.ChangeCompression(change.(string))
@@ -964,7 +965,7 @@ func UpdateFileFormat(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteFileFormat implements schema.DeleteFunc.
 func DeleteFileFormat(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	fileFormatID, err := fileFormatIDFromString(d.Id())
 	if err != nil {
 		return err
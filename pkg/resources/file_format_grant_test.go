@@ -37,7 +37,7 @@ func TestFileFormatGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT USAGE ON FILE FORMAT "test-db"."PUBLIC"."test-file-format" TO ROLE "test-role-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT USAGE ON FILE FORMAT "test-db"."PUBLIC"."test-file-format" TO ROLE "test-role-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFileFormatGrant(mock)
-		err := resources.CreateFileFormatGrant(d, db)
+		err := resources.CreateFileFormatGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -58,7 +58,7 @@ func TestFileFormatGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadFileFormatGrant(mock)
-		err := resources.ReadFileFormatGrant(d, db)
+		err := resources.ReadFileFormatGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 
@@ -101,7 +101,7 @@ func TestFutureFileFormatGrantCreate(t *testing.T) {
 			`^GRANT USAGE ON FUTURE FILE FORMATS IN SCHEMA "test-db"."PUBLIC" TO ROLE "test-role-2" WITH GRANT OPTION$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureFileFormatGrant(mock)
-		err := resources.CreateFileFormatGrant(d, db)
+		err := resources.CreateFileFormatGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 
@@ -125,7 +125,7 @@ func TestFutureFileFormatGrantCreate(t *testing.T) {
 			`^GRANT USAGE ON FUTURE FILE FORMATS IN DATABASE "test-db" TO ROLE "test-role-2"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureFileFormatDatabaseGrant(mock)
-		err := resources.CreateFileFormatGrant(d, db)
+		err := resources.CreateFileFormatGrant(d, ProviderContext(db))
 		b.NoError(err)
 	})
 }
@@ -39,7 +39,7 @@ func TestFileFormatCreate(t *testing.T) {
 			`^CREATE FILE FORMAT "test_db"."test_schema"."test_file_format" TYPE = 'CSV' NULL_IF = \('NULL'\) SKIP_BLANK_LINES = false TRIM_SPACE = false ERROR_ON_COLUMN_COUNT_MISMATCH = true REPLACE_INVALID_CHARACTERS = false EMPTY_FIELD_AS_NULL = false SKIP_BYTE_ORDER_MARK = false COMMENT = 'great comment'$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFileFormat(mock)
-		err := resources.CreateFileFormat(d, db)
+		err := resources.CreateFileFormat(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -60,7 +60,7 @@ func TestFileFormatCreateInvalidOptions(t *testing.T) {
 	r.NotNil(d)
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
-		err := resources.CreateFileFormat(d, db)
+		err := resources.CreateFileFormat(d, ProviderContext(db))
 		r.EqualError(err, "field_delimiter is an invalid format type option for format type JSON")
 	})
 }
@@ -93,7 +93,7 @@ func TestFileFormatWhenMissing(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.FileFormat("test_file_format", "test_db", "test_schema").Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadFileFormat(d, db)
+		err := resources.ReadFileFormat(d, ProviderContext(db))
 		r.Empty(d.State())
 		r.Nil(err)
 	})
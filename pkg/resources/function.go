@@ -8,6 +8,8 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -144,6 +146,33 @@ var functionSchema = map[string]*schema.Schema{
 		ForceNew:    true,
 		Description: "The target path for the Java / Python functions. For Java, it is the path of compiled jar files and for the Python it is the path of the Python files.",
 	},
+	"external_access_integrations": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The names of the external access integrations the Java / Python / Scala functions can use to reach external network locations.",
+	},
+	"secrets": {
+		Type: schema.TypeSet,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"secret_variable_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The variable name used in the handler code to retrieve the secret.",
+				},
+				"secret_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The fully qualified name of the secret, e.g. `db.schema.secret`. The secret must be allowed by one of the external access integrations.",
+				},
+			},
+		},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The secrets the Java / Python / Scala function can use, each assigned to the variable name the handler code retrieves it with.",
+	},
 }
 
 // Function returns a pointer to the resource representing a stored function.
@@ -163,14 +192,14 @@ func Function() *schema.Resource {
 
 // CreateFunction implements schema.CreateFunc.
 func CreateFunction(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	name := d.Get("name").(string)
-	schema := d.Get("schema").(string)
+	schemaName := d.Get("schema").(string)
 	database := d.Get("database").(string)
 	s := d.Get("statement").(string)
 	ret := d.Get("return_type").(string)
 
-	builder := snowflake.NewFunctionBuilder(database, schema, name, []string{}).WithStatement(s).WithReturnType(ret)
+	builder := snowflake.NewFunctionBuilder(database, schemaName, name, []string{}).WithStatement(s).WithReturnType(ret)
 
 	// Set optionals, args
 	if _, ok := d.GetOk("arguments"); ok {
@@ -242,6 +271,16 @@ func CreateFunction(d *schema.ResourceData, meta interface{}) error {
 		builder.WithTargetPath(v.(string))
 	}
 
+	// external access integrations for Java / Python / Scala
+	if v, ok := d.GetOk("external_access_integrations"); ok {
+		builder.WithExternalAccessIntegrations(expandStringList(v.(*schema.Set).List()))
+	}
+
+	// secrets for Java / Python / Scala
+	if v, ok := d.GetOk("secrets"); ok {
+		builder.WithSecrets(expandSecretReferences(v.(*schema.Set).List()))
+	}
+
 	q, err := builder.Create()
 	if err != nil {
 		return err
@@ -252,7 +291,7 @@ func CreateFunction(d *schema.ResourceData, meta interface{}) error {
 
 	functionID := &functionID{
 		DatabaseName: database,
-		SchemaName:   schema,
+		SchemaName:   schemaName,
 		FunctionName: name,
 		ArgTypes:     builder.ArgTypes(),
 	}
@@ -264,7 +303,7 @@ func CreateFunction(d *schema.ResourceData, meta interface{}) error {
 
 // ReadFunction implements schema.ReadFunc.
 func ReadFunction(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	functionID, err := splitFunctionID(d.Id())
 	if err != nil {
 		return err
@@ -282,12 +321,15 @@ func ReadFunction(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 	rows, err := snowflake.Query(db, stmt)
-	if err != nil && snowflake.IsResourceNotExistOrNotAuthorized(err.Error(), "Function") {
+	if sdk.IsNotFound(err) {
 		// If not found, mark resource to be removed from state file during apply or refresh
 		log.Printf("[DEBUG] function (%s) not found or we are not authorized.Err:\n%s", d.Id(), err.Error())
 		d.SetId("")
 		return nil
 	}
+	if err != nil {
+		return err
+	}
 	defer rows.Close()
 	descPropValues, err := snowflake.ScanFunctionDescription(rows)
 	if err != nil {
@@ -373,6 +415,19 @@ func ReadFunction(d *schema.ResourceData, meta interface{}) error {
 			if err := d.Set("runtime_version", desc.Value.String); err != nil {
 				return err
 			}
+		case "external_access_integrations":
+			configured := expandStringList(d.Get("external_access_integrations").(*schema.Set).List())
+			if err := d.Set("external_access_integrations", identifiersWithConfiguredNames(configured, parseIdentifierList(desc.Value.String))); err != nil {
+				return err
+			}
+		case "secrets":
+			secrets, err := parseSecretReferences(desc.Value.String, d.Get("secrets").(*schema.Set).List())
+			if err != nil {
+				return err
+			}
+			if err := d.Set("secrets", secrets); err != nil {
+				return err
+			}
 		default:
 			log.Printf("[WARN] unexpected function property %v returned from Snowflake", desc.Property.String)
 		}
@@ -431,7 +486,7 @@ func UpdateFunction(d *schema.ResourceData, meta interface{}) error {
 		pID.ArgTypes,
 	)
 
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	if d.HasChange("name") {
 		name := d.Get("name")
 		q, err := builder.Rename(name.(string))
@@ -498,7 +553,7 @@ func UpdateFunction(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteFunction implements schema.DeleteFunc.
 func DeleteFunction(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	pID, err := splitFunctionID(d.Id())
 	if err != nil {
 		return err
@@ -0,0 +1,74 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+)
+
+func TestFunctionGrant(t *testing.T) {
+	r := require.New(t)
+	err := resources.FunctionGrant().Resource.InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestAllFunctionGrantRead(t *testing.T) {
+	r := require.New(t)
+
+	d := functionGrant(t, "test-db|PUBLIC|||USAGE|false|false|true|test-role-1,test-role-2|", map[string]interface{}{
+		"on_all":            true,
+		"schema_name":       "PUBLIC",
+		"database_name":     "test-db",
+		"privilege":         "USAGE",
+		"roles":             []interface{}{"test-role-1", "test-role-2"},
+		"with_grant_option": false,
+	})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// SHOW FUNCTIONS reports the database as catalog_name and lists the built-in functions as well
+		mock.ExpectQuery(`^SHOW FUNCTIONS IN SCHEMA "test-db"."PUBLIC"$`).WillReturnRows(
+			sqlmock.NewRows([]string{
+				"created_on", "name", "schema_name", "is_builtin", "arguments", "catalog_name",
+			}).AddRow(
+				time.Now(), "ABS", "", "Y", "ABS(NUMBER) RETURN NUMBER", "",
+			).AddRow(
+				time.Now(), "FN_1", "PUBLIC", "N", "FN_1(VARCHAR) RETURN VARCHAR", "test-db",
+			).AddRow(
+				time.Now(), "FN_2", "PUBLIC", "N", "FN_2() RETURN NUMBER", "test-db",
+			),
+		)
+		mock.ExpectQuery(`^SHOW GRANTS TO ROLE "test-role-1"$`).WillReturnRows(
+			sqlmock.NewRows([]string{
+				"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+			}).AddRow(
+				time.Now(), "USAGE", "FUNCTION", `"test-db"."PUBLIC"."FN_1(A VARCHAR):VARCHAR(16777216)"`, "ROLE", "test-role-1", false, "bob",
+			).AddRow(
+				time.Now(), "USAGE", "FUNCTION", `"test-db"."PUBLIC"."FN_2():NUMBER(38,0)"`, "ROLE", "test-role-1", false, "bob",
+			),
+		)
+		// test-role-2 is missing the privilege on FN_2, e.g. because the function was created after the grant
+		mock.ExpectQuery(`^SHOW GRANTS TO ROLE "test-role-2"$`).WillReturnRows(
+			sqlmock.NewRows([]string{
+				"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+			}).AddRow(
+				time.Now(), "USAGE", "FUNCTION", `"test-db"."PUBLIC"."FN_1(A VARCHAR):VARCHAR(16777216)"`, "ROLE", "test-role-2", false, "bob",
+			),
+		)
+		err := resources.ReadFunctionGrant(d, ProviderContext(db))
+		r.NoError(err)
+	})
+
+	roles := d.Get("roles").(*schema.Set)
+	r.True(roles.Contains("test-role-1"))
+	r.Equal(1, roles.Len())
+}
@@ -50,7 +50,7 @@ func TestFunctionCreate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`CREATE OR REPLACE FUNCTION "my_db"."my_schema"."my_funct"\(data VARCHAR, event_dt DATE\) RETURNS VARCHAR LANGUAGE PYTHON CALLED ON NULL INPUT VOLATILE RUNTIME_VERSION = '3.8' PACKAGES = \('numpy', 'pandas'\) COMMENT = 'user-defined function' HANDLER = 'add_py' AS \$\$def add_py\(i, j\)\: return i\+j\$\$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectFunctionRead(mock)
-		err := resources.CreateFunction(d, db)
+		err := resources.CreateFunction(d, ProviderContext(db))
 		r.NoError(err)
 		r.Equal("my_funct", d.Get("name").(string))
 		r.Equal("VARCHAR", d.Get("return_type").(string))
@@ -80,7 +80,7 @@ func TestFunctionRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectFunctionRead(mock)
 
-		err := resources.ReadFunction(d, db)
+		err := resources.ReadFunction(d, ProviderContext(db))
 		r.NoError(err)
 		r.Equal("my_funct", d.Get("name").(string))
 		r.Equal("user-defined function", d.Get("comment").(string))
@@ -112,6 +112,39 @@ func TestFunctionRead(t *testing.T) {
 	})
 }
 
+func TestFunctionReadWithExternalAccess(t *testing.T) {
+	r := require.New(t)
+
+	d := prepDummyFunctionResource(t)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"created_on", "name", "schema_name", "is_builtin", "is_aggregate", "is_ansi", "min_num_arguments", "max_num_arguments", "arguments", "description", "catalog_name", "is_table_function", "valid_for_clustering", "is_secure"}).
+			AddRow("now", "my_funct", "my_schema", "N", "N", "N", "1", "1", "MY_TEST_FUNCTION(VARCHAR) RETURN VARCHAR", "mock comment", "my_db", "N", "N", "N")
+		mock.ExpectQuery(`SHOW USER FUNCTIONS LIKE 'my_funct' IN SCHEMA "my_db"."my_schema"`).WillReturnRows(rows)
+
+		describeRows := sqlmock.NewRows([]string{"property", "value"}).
+			AddRow("signature", "(data VARCHAR, event_dt DATE)").
+			AddRow("returns", "VARCHAR(123456789)").
+			AddRow("language", "PYTHON").
+			AddRow("body", functionBody).
+			AddRow("external_access_integrations", "[MY_INTEGRATION]").
+			AddRow("secrets", `{"cred":"\"MY_DB\".\"MY_SCHEMA\".\"MY_SECRET\""}`)
+		mock.ExpectQuery(`DESCRIBE FUNCTION "my_db"."my_schema"."my_funct"\(VARCHAR, DATE\)`).WillReturnRows(describeRows)
+
+		err := resources.ReadFunction(d, ProviderContext(db))
+		r.NoError(err)
+
+		integrations := d.Get("external_access_integrations").(*schema.Set).List()
+		r.Equal([]interface{}{"MY_INTEGRATION"}, integrations)
+
+		secrets := d.Get("secrets").(*schema.Set).List()
+		r.Len(secrets, 1)
+		secret := secrets[0].(map[string]interface{})
+		r.Equal("cred", secret["secret_variable_name"].(string))
+		r.Equal(`"MY_DB"."MY_SCHEMA"."MY_SECRET"`, secret["secret_id"].(string))
+	})
+}
+
 func TestFunctionDelete(t *testing.T) {
 	r := require.New(t)
 
@@ -119,7 +152,7 @@ func TestFunctionDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP FUNCTION "my_db"."my_schema"."my_funct"\(VARCHAR, DATE\)`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteFunction(d, db)
+		err := resources.DeleteFunction(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
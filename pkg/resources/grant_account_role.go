@@ -0,0 +1,203 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var grantAccountRoleParents = []string{"parent_role_name", "user_name"}
+
+var grantAccountRoleSchema = map[string]*schema.Schema{
+	"role_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the role which will be granted.",
+	},
+	"parent_role_name": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "The name of the role the role is granted to.",
+		ExactlyOneOf: grantAccountRoleParents,
+	},
+	"user_name": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "The name of the user the role is granted to.",
+		ExactlyOneOf: grantAccountRoleParents,
+	},
+}
+
+// GrantAccountRole returns a pointer to the resource representing a grant of an account role
+// to another role or to a user.
+func GrantAccountRole() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateGrantAccountRole,
+		Read:   ReadGrantAccountRole,
+		Delete: DeleteGrantAccountRole,
+
+		Schema: grantAccountRoleSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: importGrantAccountRole,
+		},
+	}
+}
+
+// grantAccountRoleID identifies a single grant of an account role. It is encoded as
+// role_name|grantee_object_type|grantee_name, e.g. "role"|USER|"user".
+type grantAccountRoleID struct {
+	RoleName    string
+	GranteeType sdk.ObjectType
+	GranteeName string
+}
+
+func (v *grantAccountRoleID) String() string {
+	return helpers.EncodeSnowflakeID(
+		sdk.NewAccountObjectIdentifier(v.RoleName).FullyQualifiedName(),
+		v.GranteeType.String(),
+		sdk.NewAccountObjectIdentifier(v.GranteeName).FullyQualifiedName(),
+	)
+}
+
+func grantAccountRoleIDFromString(s string) (*grantAccountRoleID, error) {
+	parts := strings.Split(s, helpers.IDDelimiter)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid ID specified for account role grant: %v, expected role_name|grantee_object_type|grantee_name", s)
+	}
+	id := &grantAccountRoleID{
+		RoleName:    strings.Trim(parts[0], `"`),
+		GranteeType: sdk.ObjectType(parts[1]),
+		GranteeName: strings.Trim(parts[2], `"`),
+	}
+	if id.GranteeType != sdk.ObjectTypeRole && id.GranteeType != sdk.ObjectTypeUser {
+		return nil, fmt.Errorf("invalid grantee object type %v in account role grant ID, expected one of ROLE or USER", parts[1])
+	}
+	return id, nil
+}
+
+func grantAccountRoleIDFromData(d *schema.ResourceData) *grantAccountRoleID {
+	id := &grantAccountRoleID{
+		RoleName: d.Get("role_name").(string),
+	}
+	if v, ok := d.GetOk("parent_role_name"); ok {
+		id.GranteeType = sdk.ObjectTypeRole
+		id.GranteeName = v.(string)
+	}
+	if v, ok := d.GetOk("user_name"); ok {
+		id.GranteeType = sdk.ObjectTypeUser
+		id.GranteeName = v.(string)
+	}
+	return id
+}
+
+func importGrantAccountRole(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	id, err := grantAccountRoleIDFromString(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("role_name", id.RoleName); err != nil {
+		return nil, err
+	}
+	granteeAttribute := "parent_role_name"
+	if id.GranteeType == sdk.ObjectTypeUser {
+		granteeAttribute = "user_name"
+	}
+	if err := d.Set(granteeAttribute, id.GranteeName); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func (v *grantAccountRoleID) grantRole() sdk.GrantRole {
+	if v.GranteeType == sdk.ObjectTypeUser {
+		return sdk.GrantRole{User: sdk.NewAccountObjectIdentifier(v.GranteeName)}
+	}
+	return sdk.GrantRole{Role: sdk.NewAccountObjectIdentifier(v.GranteeName)}
+}
+
+// CreateGrantAccountRole implements schema.CreateFunc.
+func CreateGrantAccountRole(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id := grantAccountRoleIDFromData(d)
+	err := client.Roles.Grant(ctx, sdk.NewAccountObjectIdentifier(id.RoleName), &sdk.RoleGrantOptions{
+		Grant: id.grantRole(),
+	})
+	if err != nil {
+		return fmt.Errorf("error granting role %v to %v %v err = %w", id.RoleName, id.GranteeType, id.GranteeName, err)
+	}
+
+	d.SetId(id.String())
+	return ReadGrantAccountRole(d, meta)
+}
+
+// ReadGrantAccountRole implements schema.ReadFunc.
+func ReadGrantAccountRole(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id, err := grantAccountRoleIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{
+		Of: &sdk.ShowGrantsOf{
+			Role: sdk.NewAccountObjectIdentifier(id.RoleName),
+		},
+	})
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		log.Printf("[DEBUG] role of (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading grants of role %v err = %w", id.RoleName, err)
+	}
+
+	for _, grant := range grants {
+		if grant.GrantedTo == id.GranteeType && strings.Trim(grant.GranteeName.Name(), `"`) == id.GranteeName {
+			return nil
+		}
+	}
+	// the grant was revoked, or the grantee was dropped
+	log.Printf("[DEBUG] role grant (%s) not found, removing from state", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// DeleteGrantAccountRole implements schema.DeleteFunc.
+func DeleteGrantAccountRole(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id, err := grantAccountRoleIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+	grant := id.grantRole()
+	err = client.Roles.Revoke(ctx, sdk.NewAccountObjectIdentifier(id.RoleName), &sdk.RoleRevokeOptions{
+		Revoke: sdk.RevokeRole{
+			Role: grant.Role,
+			User: grant.User,
+		},
+	})
+	// if either side of the grant is gone, so is the grant
+	if err != nil && !errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		return fmt.Errorf("error revoking role %v from %v %v err = %w", id.RoleName, id.GranteeType, id.GranteeName, err)
+	}
+
+	d.SetId("")
+	return nil
+}
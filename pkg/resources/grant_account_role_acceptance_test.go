@@ -0,0 +1,66 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_GrantAccountRole_role(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: grantAccountRoleConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_grant_account_role.role", "role_name", name),
+					resource.TestCheckResourceAttr("snowflake_grant_account_role.role", "parent_role_name", name+"_PARENT"),
+					resource.TestCheckResourceAttr("snowflake_grant_account_role.user", "user_name", name),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_grant_account_role.role",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "snowflake_grant_account_role.user",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func grantAccountRoleConfig(name string) string {
+	return fmt.Sprintf(`
+resource "snowflake_role" "test" {
+	name = "%[1]v"
+}
+
+resource "snowflake_role" "parent" {
+	name = "%[1]v_PARENT"
+}
+
+resource "snowflake_user" "test" {
+	name = "%[1]v"
+}
+
+resource "snowflake_grant_account_role" "role" {
+	role_name        = snowflake_role.test.name
+	parent_role_name = snowflake_role.parent.name
+}
+
+resource "snowflake_grant_account_role" "user" {
+	role_name = snowflake_role.test.name
+	user_name = snowflake_user.test.name
+}
+`, name)
+}
@@ -0,0 +1,101 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+)
+
+func TestGrantAccountRole(t *testing.T) {
+	r := require.New(t)
+	err := resources.GrantAccountRole().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestGrantAccountRoleCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"role_name": "test-role",
+		"user_name": "test-user",
+	}
+	d := schema.TestResourceDataRaw(t, resources.GrantAccountRole().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^GRANT ROLE "test-role" TO USER "test-user"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadGrantsOfRole(mock)
+		err := resources.CreateGrantAccountRole(d, ProviderContext(db))
+		r.NoError(err)
+	})
+	r.Equal(`"test-role"|USER|"test-user"`, d.Id())
+}
+
+func TestGrantAccountRoleRead(t *testing.T) {
+	r := require.New(t)
+
+	d := grantAccountRole(t, `"test-role"|ROLE|"test-parent"`, map[string]interface{}{
+		"role_name":        "test-role",
+		"parent_role_name": "test-parent",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadGrantsOfRole(mock)
+		err := resources.ReadGrantAccountRole(d, ProviderContext(db))
+		r.NoError(err)
+	})
+	r.Equal(`"test-role"|ROLE|"test-parent"`, d.Id())
+}
+
+func TestGrantAccountRoleReadGranteeDropped(t *testing.T) {
+	r := require.New(t)
+
+	// a user and a role may share the name, only the grant to the role is managed here
+	d := grantAccountRole(t, `"test-role"|ROLE|"test-user"`, map[string]interface{}{
+		"role_name":        "test-role",
+		"parent_role_name": "test-user",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadGrantsOfRole(mock)
+		err := resources.ReadGrantAccountRole(d, ProviderContext(db))
+		r.NoError(err)
+	})
+	r.Empty(d.Id())
+}
+
+func TestGrantAccountRoleDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := grantAccountRole(t, `"test-role"|USER|"test-user"`, map[string]interface{}{
+		"role_name": "test-role",
+		"user_name": "test-user",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^REVOKE ROLE "test-role" FROM USER "test-user"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		err := resources.DeleteGrantAccountRole(d, ProviderContext(db))
+		r.NoError(err)
+	})
+	r.Empty(d.Id())
+}
+
+func expectReadGrantsOfRole(mock sqlmock.Sqlmock) {
+	rows := sqlmock.NewRows([]string{
+		"created_on", "role", "granted_to", "grantee_name", "granted_by",
+	}).AddRow(
+		time.Now(), "test-role", "ROLE", "test-parent", "ACCOUNTADMIN",
+	).AddRow(
+		time.Now(), "test-role", "USER", "test-user", "ACCOUNTADMIN",
+	)
+	mock.ExpectQuery(`^SHOW GRANTS OF ROLE "test-role"$`).WillReturnRows(rows)
+}
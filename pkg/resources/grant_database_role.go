@@ -0,0 +1,270 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var databaseObjectNameRegexp = regexp.MustCompile(`^"?[^".]+"?\."?[^".]+"?$`)
+
+var grantDatabaseRoleParents = []string{"parent_role_name", "parent_database_role_name", "share_name"}
+
+var grantDatabaseRoleSchema = map[string]*schema.Schema{
+	"database_role_name": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  "The fully qualified name of the database role which will be granted, in the form `\"<database_name>\".\"<database_role_name>\"`.",
+		ValidateFunc: validation.StringMatch(databaseObjectNameRegexp, "must be a fully qualified database role name"),
+	},
+	"parent_role_name": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "The name of the account role the database role is granted to.",
+		ExactlyOneOf: grantDatabaseRoleParents,
+	},
+	"parent_database_role_name": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "The fully qualified name of the database role the database role is granted to. It has to be in the same database.",
+		ValidateFunc: validation.StringMatch(databaseObjectNameRegexp, "must be a fully qualified database role name"),
+		ExactlyOneOf: grantDatabaseRoleParents,
+	},
+	"share_name": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "The name of the share the database role is granted to.",
+		ExactlyOneOf: grantDatabaseRoleParents,
+	},
+}
+
+// GrantDatabaseRole returns a pointer to the resource representing a grant of a database role
+// to an account role, another database role or a share.
+func GrantDatabaseRole() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateGrantDatabaseRole,
+		Read:   ReadGrantDatabaseRole,
+		Delete: DeleteGrantDatabaseRole,
+
+		Schema: grantDatabaseRoleSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: importGrantDatabaseRole,
+		},
+	}
+}
+
+// grantDatabaseRoleID identifies a single grant of a database role. It is encoded as
+// database_role_name|parent_object_type|parent_name, e.g. "db"."role"|ROLE|"parent".
+type grantDatabaseRoleID struct {
+	DatabaseRole sdk.DatabaseObjectIdentifier
+	ParentType   sdk.ObjectType
+	ParentName   string
+}
+
+func (v *grantDatabaseRoleID) String() string {
+	return helpers.EncodeSnowflakeID(v.DatabaseRole.FullyQualifiedName(), v.ParentType.String(), v.ParentName)
+}
+
+func grantDatabaseRoleIDFromString(s string) (*grantDatabaseRoleID, error) {
+	parts := strings.Split(s, helpers.IDDelimiter)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid ID specified for database role grant: %v, expected database_role_name|parent_object_type|parent_name", s)
+	}
+	if !databaseObjectNameRegexp.MatchString(parts[0]) {
+		return nil, fmt.Errorf("invalid database role name %v in database role grant ID", parts[0])
+	}
+	id := &grantDatabaseRoleID{
+		DatabaseRole: sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(parts[0]),
+		ParentType:   sdk.ObjectType(parts[1]),
+		ParentName:   parts[2],
+	}
+	switch id.ParentType {
+	case sdk.ObjectTypeRole, sdk.ObjectTypeShare:
+	case sdk.ObjectTypeDatabaseRole:
+		if !databaseObjectNameRegexp.MatchString(id.ParentName) {
+			return nil, fmt.Errorf("invalid parent database role name %v in database role grant ID", id.ParentName)
+		}
+	default:
+		return nil, fmt.Errorf("invalid parent object type %v in database role grant ID, expected one of ROLE, DATABASE ROLE or SHARE", parts[1])
+	}
+	return id, nil
+}
+
+func grantDatabaseRoleIDFromData(d *schema.ResourceData) *grantDatabaseRoleID {
+	id := &grantDatabaseRoleID{
+		DatabaseRole: sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(d.Get("database_role_name").(string)),
+	}
+	if v, ok := d.GetOk("parent_role_name"); ok {
+		id.ParentType = sdk.ObjectTypeRole
+		id.ParentName = sdk.NewAccountObjectIdentifier(v.(string)).FullyQualifiedName()
+	}
+	if v, ok := d.GetOk("parent_database_role_name"); ok {
+		id.ParentType = sdk.ObjectTypeDatabaseRole
+		id.ParentName = sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(v.(string)).FullyQualifiedName()
+	}
+	if v, ok := d.GetOk("share_name"); ok {
+		id.ParentType = sdk.ObjectTypeShare
+		id.ParentName = sdk.NewAccountObjectIdentifier(v.(string)).FullyQualifiedName()
+	}
+	return id
+}
+
+func importGrantDatabaseRole(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	id, err := grantDatabaseRoleIDFromString(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("database_role_name", id.DatabaseRole.FullyQualifiedName()); err != nil {
+		return nil, err
+	}
+	switch id.ParentType {
+	case sdk.ObjectTypeRole:
+		err = d.Set("parent_role_name", strings.Trim(id.ParentName, `"`))
+	case sdk.ObjectTypeDatabaseRole:
+		err = d.Set("parent_database_role_name", id.ParentName)
+	case sdk.ObjectTypeShare:
+		err = d.Set("share_name", strings.Trim(id.ParentName, `"`))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// CreateGrantDatabaseRole implements schema.CreateFunc.
+func CreateGrantDatabaseRole(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id := grantDatabaseRoleIDFromData(d)
+	var err error
+	switch id.ParentType {
+	case sdk.ObjectTypeRole:
+		err = client.DatabaseRoles.Grant(ctx, id.DatabaseRole, &sdk.DatabaseRoleGrantOptions{
+			Grant: sdk.GrantDatabaseRole{
+				Role: sdk.NewAccountObjectIdentifier(strings.Trim(id.ParentName, `"`)),
+			},
+		})
+	case sdk.ObjectTypeDatabaseRole:
+		err = client.DatabaseRoles.Grant(ctx, id.DatabaseRole, &sdk.DatabaseRoleGrantOptions{
+			Grant: sdk.GrantDatabaseRole{
+				DatabaseRole: sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(id.ParentName),
+			},
+		})
+	case sdk.ObjectTypeShare:
+		err = client.DatabaseRoles.GrantToShare(ctx, id.DatabaseRole, sdk.NewAccountObjectIdentifier(strings.Trim(id.ParentName, `"`)))
+	}
+	if err != nil {
+		return fmt.Errorf("error granting database role %v to %v %v err = %w", id.DatabaseRole.FullyQualifiedName(), id.ParentType, id.ParentName, err)
+	}
+
+	d.SetId(id.String())
+	return ReadGrantDatabaseRole(d, meta)
+}
+
+// ReadGrantDatabaseRole implements schema.ReadFunc.
+func ReadGrantDatabaseRole(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id, err := grantDatabaseRoleIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// grants to shares are only listed from the side of the share
+	opts := &sdk.ShowGrantOptions{
+		Of: &sdk.ShowGrantsOf{
+			DatabaseRole: id.DatabaseRole,
+		},
+	}
+	if id.ParentType == sdk.ObjectTypeShare {
+		opts = &sdk.ShowGrantOptions{
+			To: &sdk.ShowGrantsTo{
+				Share: sdk.NewAccountObjectIdentifier(strings.Trim(id.ParentName, `"`)),
+			},
+		}
+	}
+	grants, err := client.Grants.Show(ctx, opts)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		log.Printf("[DEBUG] database role or share of (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading grants of database role %v err = %w", id.DatabaseRole.FullyQualifiedName(), err)
+	}
+
+	found := false
+	for _, grant := range grants {
+		if id.matches(grant) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		// the grant was revoked, or its parent role was dropped
+		log.Printf("[DEBUG] database role grant (%s) not found, removing from state", d.Id())
+		d.SetId("")
+	}
+	return nil
+}
+
+func (v *grantDatabaseRoleID) matches(grant *sdk.Grant) bool {
+	unquote := func(s string) string {
+		return strings.ReplaceAll(s, `"`, "")
+	}
+	if v.ParentType == sdk.ObjectTypeShare {
+		return strings.ReplaceAll(grant.GrantedOn.String(), "_", " ") == sdk.ObjectTypeDatabaseRole.String() &&
+			unquote(grant.Name.Name()) == unquote(v.DatabaseRole.FullyQualifiedName())
+	}
+	return strings.ReplaceAll(grant.GrantedTo.String(), "_", " ") == v.ParentType.String() &&
+		unquote(grant.GranteeName.Name()) == unquote(v.ParentName)
+}
+
+// DeleteGrantDatabaseRole implements schema.DeleteFunc.
+func DeleteGrantDatabaseRole(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id, err := grantDatabaseRoleIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+	switch id.ParentType {
+	case sdk.ObjectTypeRole:
+		err = client.DatabaseRoles.Revoke(ctx, id.DatabaseRole, &sdk.DatabaseRoleRevokeOptions{
+			Revoke: sdk.RevokeDatabaseRole{
+				Role: sdk.NewAccountObjectIdentifier(strings.Trim(id.ParentName, `"`)),
+			},
+		})
+	case sdk.ObjectTypeDatabaseRole:
+		err = client.DatabaseRoles.Revoke(ctx, id.DatabaseRole, &sdk.DatabaseRoleRevokeOptions{
+			Revoke: sdk.RevokeDatabaseRole{
+				DatabaseRole: sdk.NewDatabaseObjectIdentifierFromFullyQualifiedName(id.ParentName),
+			},
+		})
+	case sdk.ObjectTypeShare:
+		err = client.DatabaseRoles.RevokeFromShare(ctx, id.DatabaseRole, sdk.NewAccountObjectIdentifier(strings.Trim(id.ParentName, `"`)))
+	}
+	// if either side of the grant is gone, so is the grant
+	if err != nil && !errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		return fmt.Errorf("error revoking database role %v from %v %v err = %w", id.DatabaseRole.FullyQualifiedName(), id.ParentType, id.ParentName, err)
+	}
+
+	d.SetId("")
+	return nil
+}
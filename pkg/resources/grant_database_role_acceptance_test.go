@@ -0,0 +1,103 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_GrantDatabaseRole_accountRole(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: grantDatabaseRoleToAccountRoleConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_grant_database_role.test", "database_role_name", fmt.Sprintf(`"%[1]v"."%[1]v"`, name)),
+					resource.TestCheckResourceAttr("snowflake_grant_database_role.test", "parent_role_name", name),
+					resource.TestCheckResourceAttr("snowflake_grant_database_role.test", "id", fmt.Sprintf(`"%[1]v"."%[1]v"|ROLE|"%[1]v"`, name)),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_grant_database_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func grantDatabaseRoleToAccountRoleConfig(name string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name = "%[1]v"
+}
+
+resource "snowflake_database_role" "test" {
+	database = snowflake_database.test.name
+	name     = "%[1]v"
+}
+
+resource "snowflake_role" "test" {
+	name = "%[1]v"
+}
+
+resource "snowflake_grant_database_role" "test" {
+	database_role_name = "\"${snowflake_database.test.name}\".\"${snowflake_database_role.test.name}\""
+	parent_role_name   = snowflake_role.test.name
+}
+`, name)
+}
+
+func TestAcc_GrantDatabaseRole_databaseRole(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: grantDatabaseRoleToDatabaseRoleConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_grant_database_role.test", "parent_database_role_name", fmt.Sprintf(`"%[1]v"."%[1]v_PARENT"`, name)),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_grant_database_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func grantDatabaseRoleToDatabaseRoleConfig(name string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name = "%[1]v"
+}
+
+resource "snowflake_database_role" "test" {
+	database = snowflake_database.test.name
+	name     = "%[1]v"
+}
+
+resource "snowflake_database_role" "parent" {
+	database = snowflake_database.test.name
+	name     = "%[1]v_PARENT"
+}
+
+resource "snowflake_grant_database_role" "test" {
+	database_role_name        = "\"${snowflake_database.test.name}\".\"${snowflake_database_role.test.name}\""
+	parent_database_role_name = "\"${snowflake_database.test.name}\".\"${snowflake_database_role.parent.name}\""
+}
+`, name)
+}
@@ -0,0 +1,143 @@
+package resources_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+)
+
+func TestGrantDatabaseRole(t *testing.T) {
+	r := require.New(t)
+	err := resources.GrantDatabaseRole().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestGrantDatabaseRoleCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"database_role_name": `"test-db"."test-role"`,
+		"parent_role_name":   "test-parent",
+	}
+	d := schema.TestResourceDataRaw(t, resources.GrantDatabaseRole().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^GRANT DATABASE ROLE "test-db"."test-role" TO ROLE "test-parent"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadGrantsOfDatabaseRole(mock)
+		err := resources.CreateGrantDatabaseRole(d, ProviderContext(db))
+		r.NoError(err)
+	})
+	r.Equal(`"test-db"."test-role"|ROLE|"test-parent"`, d.Id())
+}
+
+func TestGrantDatabaseRoleCreateToShare(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"database_role_name": "test-db.test-role",
+		"share_name":         "test-share",
+	}
+	d := schema.TestResourceDataRaw(t, resources.GrantDatabaseRole().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^GRANT DATABASE ROLE "test-db"."test-role" TO SHARE "test-share"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		rows := sqlmock.NewRows([]string{
+			"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+		}).AddRow(
+			time.Now(), "USAGE", "DATABASE_ROLE", "test-db.test-role", "SHARE", "test-account.test-share", false, "",
+		)
+		mock.ExpectQuery(`^SHOW GRANTS TO SHARE "test-share"$`).WillReturnRows(rows)
+		err := resources.CreateGrantDatabaseRole(d, ProviderContext(db))
+		r.NoError(err)
+	})
+	r.Equal(`"test-db"."test-role"|SHARE|"test-share"`, d.Id())
+}
+
+func TestGrantDatabaseRoleRead(t *testing.T) {
+	r := require.New(t)
+
+	d := grantDatabaseRole(t, `"test-db"."test-role"|DATABASE ROLE|"test-db"."test-parent"`, map[string]interface{}{
+		"database_role_name":        `"test-db"."test-role"`,
+		"parent_database_role_name": `"test-db"."test-parent"`,
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadGrantsOfDatabaseRole(mock)
+		err := resources.ReadGrantDatabaseRole(d, ProviderContext(db))
+		r.NoError(err)
+	})
+	r.Equal(`"test-db"."test-role"|DATABASE ROLE|"test-db"."test-parent"`, d.Id())
+}
+
+func TestGrantDatabaseRoleReadParentDropped(t *testing.T) {
+	r := require.New(t)
+
+	d := grantDatabaseRole(t, `"test-db"."test-role"|ROLE|"dropped-parent"`, map[string]interface{}{
+		"database_role_name": `"test-db"."test-role"`,
+		"parent_role_name":   "dropped-parent",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadGrantsOfDatabaseRole(mock)
+		err := resources.ReadGrantDatabaseRole(d, ProviderContext(db))
+		r.NoError(err)
+	})
+	r.Empty(d.Id())
+}
+
+func TestGrantDatabaseRoleReadDatabaseRoleDropped(t *testing.T) {
+	r := require.New(t)
+
+	d := grantDatabaseRole(t, `"test-db"."test-role"|ROLE|"test-parent"`, map[string]interface{}{
+		"database_role_name": `"test-db"."test-role"`,
+		"parent_role_name":   "test-parent",
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`^SHOW GRANTS OF DATABASE ROLE "test-db"."test-role"$`).WillReturnError(
+			errors.New("002003 (02000): SQL compilation error:\nDatabase role 'TEST-ROLE' does not exist or not authorized."),
+		)
+		err := resources.ReadGrantDatabaseRole(d, ProviderContext(db))
+		r.NoError(err)
+	})
+	r.Empty(d.Id())
+}
+
+func TestGrantDatabaseRoleDelete(t *testing.T) {
+	r := require.New(t)
+
+	d := grantDatabaseRole(t, `"test-db"."test-role"|DATABASE ROLE|"test-db"."test-parent"`, map[string]interface{}{
+		"database_role_name":        `"test-db"."test-role"`,
+		"parent_database_role_name": `"test-db"."test-parent"`,
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^REVOKE DATABASE ROLE "test-db"."test-role" FROM DATABASE ROLE "test-db"."test-parent"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		err := resources.DeleteGrantDatabaseRole(d, ProviderContext(db))
+		r.NoError(err)
+	})
+	r.Empty(d.Id())
+}
+
+func expectReadGrantsOfDatabaseRole(mock sqlmock.Sqlmock) {
+	rows := sqlmock.NewRows([]string{
+		"created_on", "role", "granted_to", "grantee_name", "granted_by",
+	}).AddRow(
+		time.Now(), "test-db.test-role", "ROLE", "test-parent", "",
+	).AddRow(
+		time.Now(), "test-db.test-role", "DATABASE_ROLE", "test-db.test-parent", "",
+	)
+	mock.ExpectQuery(`^SHOW GRANTS OF DATABASE ROLE "test-db"."test-role"$`).WillReturnRows(rows)
+}
@@ -1,16 +1,19 @@
 package resources
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jmoiron/sqlx"
-	"github.com/snowflakedb/gosnowflake"
 )
 
 // TerraformGrantResource augments terraform's *schema.Resource with extra context.
@@ -76,7 +79,7 @@ func createGenericGrantRolesAndShares(
 	roles []string,
 	shares []string,
 ) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	for _, role := range roles {
 		if err := snowflake.Exec(db, builder.Role(role).Grant(priv, grantOption)); err != nil {
 			return err
@@ -113,37 +116,35 @@ func readGenericGrant(
 	builder snowflake.GrantBuilder,
 	futureObjects bool,
 	allObjects bool,
-	_ PrivilegeSet,
+	validPrivileges PrivilegeSet,
 ) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	var grants []*grant
 	var err error
 
 	priv := d.Get("privilege").(string)
 
-	if priv == "ALL PRIVILEGES" {
-		// When running e.g. GRANT ALL PRIVILEGES ON TABLE <table_name> TO ROLE <role_name>..., then Snowflake creates a grant for each individual permission
-		// There is no way to attribute existing grants to a GRANT ALL PRIVILEGES grant. Thus they cannot be checked. However they can still be revoked.
-		return nil
+	existingRoles := schema.NewSet(schema.HashString, []interface{}{})
+	if v, ok := d.GetOk("roles"); ok && v != nil {
+		existingRoles = v.(*schema.Set)
 	}
+
 	switch {
 	case futureObjects:
 		grants, err = readGenericFutureGrants(db, builder)
 	case allObjects:
-		// When running e.g. GRANT SELECT ON ALL TABLES IN ..., then Snowflake creates a grant for each individual existing table.
-		// There is no way to attribute existing table grants to a GRANT SELECT ON ALL TABLES grant. Thus they cannot be checked (or removed).
-		return nil
+		var objectsExist bool
+		grants, objectsExist, err = readGenericAllGrants(db, meta.(*provider.Context).Client, builder, expandStringList(existingRoles.List()))
+		if err == nil && !objectsExist {
+			// Without any existing objects there is nothing the grant could be verified against.
+			return nil
+		}
 	default:
 		grants, err = readGenericCurrentGrants(db, builder)
 	}
 	if err != nil {
-		// HACK HACK: If the object doesn't exist or not authorized then we can assume someone deleted it
-		// We also check the error number matches
-		// We set the tf id == blank and return.
-		// I don't know of a better way to work around this issue
-		if snowflakeErr, ok := err.(*gosnowflake.SnowflakeError); ok && //nolint:errorlint // todo: should be fixed
-			snowflakeErr.Number == 2003 &&
-			strings.Contains(err.Error(), "does not exist or not authorized") {
+		// If the object doesn't exist or not authorized then we can assume someone deleted it
+		if sdk.IsNotFound(err) {
 			log.Printf("[WARN] resource (%s) not found, removing from state file", d.Id())
 			d.SetId("")
 			return nil
@@ -193,15 +194,11 @@ func readGenericGrant(
 		}
 	}
 
-	existingRoles := schema.NewSet(schema.HashString, []interface{}{})
-	if v, ok := d.GetOk("roles"); ok && v != nil {
-		existingRoles = v.(*schema.Set)
-	}
 	multipleGrantFeatureFlag := d.Get("enable_multiple_grants").(bool)
 	var roles, shares []string
 	// Now see which roles have our privilege.
 	for roleName, privileges := range rolePrivileges {
-		if privileges.hasString(priv) {
+		if privileges.hasPrivilege(priv, validPrivileges) {
 			// CASE A: Whatever role we were already managing, continue to do so.
 			caseA := existingRoles.Contains(roleName)
 			// CASE B : If multiple grants is not enabled (meaning this is an authoritative resource) then we care about what roles have privilege unless on_future is enabled in which case we don't care (because we will get flooded with diffs)
@@ -218,7 +215,7 @@ func readGenericGrant(
 	}
 	// Now see which shares have our privilege.
 	for shareName, privileges := range sharePrivileges {
-		if privileges.hasString(priv) {
+		if privileges.hasPrivilege(priv, validPrivileges) {
 			// CASE A: Whatever share we were already managing, continue to do so.
 			caseA := existingShares.Contains(shareName)
 			// CASE B : If multiple grants is not enabled (meaning this is an authoritative resource) then we care about what shares have privilege unless on_future is enabled in which case we don't care (because we will get flooded with diffs)
@@ -315,6 +312,141 @@ func readGenericFutureGrants(db *sql.DB, builder snowflake.GrantBuilder) ([]*gra
 	return grants, nil
 }
 
+// allGrantObject represents the columns in the response from `SHOW <objects> IN ...`
+// needed to identify the objects an on_all grant applies to. `SHOW FUNCTIONS` and
+// `SHOW PROCEDURES` report the database as catalog_name instead of database_name.
+type allGrantObject struct {
+	Name         string         `db:"name"`
+	DatabaseName sql.NullString `db:"database_name"`
+	CatalogName  sql.NullString `db:"catalog_name"`
+	SchemaName   string         `db:"schema_name"`
+	IsBuiltin    sql.NullString `db:"is_builtin"`
+}
+
+// key returns the name of the object the way allGrantObjectKey normalizes names from `SHOW GRANTS`.
+func (o *allGrantObject) key() string {
+	databaseName := o.DatabaseName.String
+	if databaseName == "" {
+		databaseName = o.CatalogName.String
+	}
+	parts := []string{databaseName}
+	if o.SchemaName != "" {
+		parts = append(parts, o.SchemaName)
+	}
+	parts = append(parts, o.Name)
+	return strings.Join(parts, ".")
+}
+
+// allGrantObjectKey normalizes a possibly quoted object name from `SHOW GRANTS`, so it
+// can be compared with the names listed by `SHOW <objects>`. Function and procedure
+// arguments are dropped.
+func allGrantObjectKey(name string) string {
+	name = strings.ReplaceAll(name, `"`, "")
+	if i := strings.Index(name, "("); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
+
+// readGenericAllGrants checks which of the given roles hold privileges on all existing
+// objects an on_all grant applies to. Granting ON ALL creates one grant per object, so a
+// privilege is only reported for a role if every one of the objects carries it.
+// The returned bool is false if there are no objects to check.
+func readGenericAllGrants(db *sql.DB, client *sdk.Client, builder snowflake.GrantBuilder, roles []string) ([]*grant, bool, error) {
+	allBuilder, ok := builder.(*snowflake.AllGrantBuilder)
+	if !ok {
+		return nil, false, fmt.Errorf("unexpected grant builder %T for grant on all objects", builder)
+	}
+	rows, err := snowflake.Query(db, allBuilder.ShowObjects())
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	objects := map[string]struct{}{}
+	for rows.Next() {
+		object := &allGrantObject{}
+		if err := rows.StructScan(object); err != nil {
+			return nil, false, err
+		}
+		// GRANT ... ON ALL SCHEMAS does not apply to INFORMATION_SCHEMA
+		if allBuilder.GrantType() == string(snowflake.AllGrantTypeSchema) && object.Name == "INFORMATION_SCHEMA" {
+			continue
+		}
+		// SHOW FUNCTIONS lists the built-in functions as well, which cannot be granted on
+		if object.IsBuiltin.String == "Y" {
+			continue
+		}
+		objects[object.key()] = struct{}{}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+	if len(objects) == 0 {
+		return nil, false, nil
+	}
+
+	ctx := context.Background()
+	grantType := strings.ReplaceAll(builder.GrantType(), " ", "_")
+	var grants []*grant
+	for _, role := range roles {
+		roleGrants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{
+			To: &sdk.ShowGrantsTo{
+				Role: sdk.NewAccountObjectIdentifier(role),
+			},
+		})
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			// a dropped role holds no privileges
+			continue
+		}
+		if err != nil {
+			return nil, true, err
+		}
+
+		objectPrivileges := map[string]PrivilegeSet{}
+		for _, roleGrant := range roleGrants {
+			if strings.ReplaceAll(roleGrant.GrantedOn.String(), " ", "_") != grantType {
+				continue
+			}
+			key := allGrantObjectKey(roleGrant.Name.Name())
+			if _, ok := objects[key]; !ok {
+				continue
+			}
+			if _, ok := objectPrivileges[key]; !ok {
+				objectPrivileges[key] = PrivilegeSet{}
+			}
+			objectPrivileges[key].addString(string(roleGrant.Privilege))
+		}
+
+		// Keep only the privileges every object carries
+		var common PrivilegeSet
+		for key := range objects {
+			privileges := objectPrivileges[key]
+			if common == nil {
+				common = PrivilegeSet{}
+				for p := range privileges {
+					common[p] = struct{}{}
+				}
+				continue
+			}
+			for p := range common {
+				if _, ok := privileges[p]; !ok {
+					delete(common, p)
+				}
+			}
+		}
+		for p := range common {
+			grants = append(grants, &grant{
+				Privilege:   p.String(),
+				GrantType:   grantType,
+				GranteeType: "ROLE",
+				GranteeName: role,
+			})
+		}
+	}
+	return grants, true, nil
+}
+
 // Deletes specific roles and shares from a grant
 // Does not modify TF remote state.
 func deleteGenericGrantRolesAndShares(
@@ -325,7 +457,7 @@ func deleteGenericGrantRolesAndShares(
 	roles []string,
 	shares []string,
 ) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 
 	for _, role := range roles {
 		executable := builder.Role(role).Revoke(priv)
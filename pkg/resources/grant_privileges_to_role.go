@@ -0,0 +1,819 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var grantPrivilegesToRoleOnTargets = []string{"on_account", "on_account_object", "on_schema", "on_schema_object"}
+
+var validAccountObjectTypes = []string{
+	sdk.ObjectTypeUser.String(),
+	sdk.ObjectTypeResourceMonitor.String(),
+	sdk.ObjectTypeWarehouse.String(),
+	sdk.ObjectTypeDatabase.String(),
+	sdk.ObjectTypeIntegration.String(),
+	sdk.ObjectTypeFailoverGroup.String(),
+	sdk.ObjectTypeReplicationGroup.String(),
+}
+
+var validSchemaObjectTypes = []string{
+	sdk.ObjectTypeAlert.String(),
+	sdk.ObjectTypeExternalTable.String(),
+	sdk.ObjectTypeFileFormat.String(),
+	sdk.ObjectTypeFunction.String(),
+	sdk.ObjectTypeMaskingPolicy.String(),
+	sdk.ObjectTypeMaterializedView.String(),
+	sdk.ObjectTypePasswordPolicy.String(),
+	sdk.ObjectTypePipe.String(),
+	sdk.ObjectTypeProcedure.String(),
+	sdk.ObjectTypeRowAccessPolicy.String(),
+	sdk.ObjectTypeSequence.String(),
+	sdk.ObjectTypeSessionPolicy.String(),
+	sdk.ObjectTypeStage.String(),
+	sdk.ObjectTypeStream.String(),
+	sdk.ObjectTypeTable.String(),
+	sdk.ObjectTypeTag.String(),
+	sdk.ObjectTypeTask.String(),
+	sdk.ObjectTypeView.String(),
+}
+
+func validPluralSchemaObjectTypes() []string {
+	plurals := make([]string, len(validSchemaObjectTypes))
+	for i, objectType := range validSchemaObjectTypes {
+		plurals[i] = sdk.ObjectType(objectType).Plural().String()
+	}
+	return plurals
+}
+
+func grantPrivilegesToRoleSchemaObjectInSchema(blockName string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: fmt.Sprintf("Grants the privileges on %s objects of the given type in a database or schema.", strings.ToLower(blockName)),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object_type_plural": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Description:  "The plural object type of the schema objects, e.g. TABLES or VIEWS.",
+					ValidateFunc: validation.StringInSlice(validPluralSchemaObjectTypes(), true),
+				},
+				"in_database": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "The name of the database.",
+				},
+				"in_schema": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "The fully qualified name of the schema, i.e. `database.schema`.",
+				},
+			},
+		},
+	}
+}
+
+var grantPrivilegesToRoleSchema = map[string]*schema.Schema{
+	"role_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the role to grant the privileges to.",
+	},
+	"privileges": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		Description:   "The privileges to grant on the target.",
+		ConflictsWith: []string{"all_privileges"},
+	},
+	"all_privileges": {
+		Type:          schema.TypeBool,
+		Optional:      true,
+		Default:       false,
+		Description:   "Grant all privileges available on the target.",
+		ConflictsWith: []string{"privileges"},
+	},
+	"with_grant_option": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+		Description: "When this is set to true, allows the recipient role to grant the privileges to other roles.",
+	},
+	"on_account": {
+		Type:         schema.TypeBool,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "If true, the privileges will be granted on the account.",
+		ExactlyOneOf: grantPrivilegesToRoleOnTargets,
+	},
+	"on_account_object": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		ForceNew:     true,
+		MaxItems:     1,
+		Description:  "Specifies the account object on which privileges will be granted.",
+		ExactlyOneOf: grantPrivilegesToRoleOnTargets,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Description:  "The object type of the account object, one of: " + strings.Join(validAccountObjectTypes, ", ") + ".",
+					ValidateFunc: validation.StringInSlice(validAccountObjectTypes, true),
+				},
+				"object_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "The name of the account object.",
+				},
+			},
+		},
+	},
+	"on_schema": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		ForceNew:     true,
+		MaxItems:     1,
+		Description:  "Specifies the schema on which privileges will be granted.",
+		ExactlyOneOf: grantPrivilegesToRoleOnTargets,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"schema_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					Description:  "The fully qualified name of the schema, i.e. `database.schema`.",
+					ExactlyOneOf: []string{"on_schema.0.schema_name", "on_schema.0.all_schemas_in_database", "on_schema.0.future_schemas_in_database"},
+				},
+				"all_schemas_in_database": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					Description:  "The name of the database; the privileges are granted on all schemas in it.",
+					ExactlyOneOf: []string{"on_schema.0.schema_name", "on_schema.0.all_schemas_in_database", "on_schema.0.future_schemas_in_database"},
+				},
+				"future_schemas_in_database": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					Description:  "The name of the database; the privileges are granted on all future schemas in it.",
+					ExactlyOneOf: []string{"on_schema.0.schema_name", "on_schema.0.all_schemas_in_database", "on_schema.0.future_schemas_in_database"},
+				},
+			},
+		},
+	},
+	"on_schema_object": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		ForceNew:     true,
+		MaxItems:     1,
+		Description:  "Specifies the schema object on which privileges will be granted.",
+		ExactlyOneOf: grantPrivilegesToRoleOnTargets,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					Description:  "The object type of the schema object, one of: " + strings.Join(validSchemaObjectTypes, ", ") + ".",
+					ValidateFunc: validation.StringInSlice(validSchemaObjectTypes, true),
+					RequiredWith: []string{"on_schema_object.0.object_name"},
+				},
+				"object_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					Description:  "The fully qualified name of the object, i.e. `database.schema.object`.",
+					RequiredWith: []string{"on_schema_object.0.object_type"},
+					ExactlyOneOf: []string{"on_schema_object.0.object_name", "on_schema_object.0.all", "on_schema_object.0.future"},
+				},
+				"all":    grantPrivilegesToRoleSchemaObjectInSchema("all"),
+				"future": grantPrivilegesToRoleSchemaObjectInSchema("future"),
+			},
+		},
+	},
+}
+
+// GrantPrivilegesToRole returns a pointer to the resource representing a grant of privileges to a role.
+func GrantPrivilegesToRole() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateGrantPrivilegesToRole,
+		Read:   ReadGrantPrivilegesToRole,
+		Update: UpdateGrantPrivilegesToRole,
+		Delete: DeleteGrantPrivilegesToRole,
+
+		Schema: grantPrivilegesToRoleSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: importGrantPrivilegesToRole,
+		},
+	}
+}
+
+// grantPrivilegesToRoleID is the resource ID, encoded as
+// role_name|with_grant_option|on_account|on_account_object|on_schema|on_schema_object|all|future|object_type|object_name.
+// It identifies the grant by the role and its target only, the privileges are kept in the state.
+// object_type and object_name describe the target of on_account_object, on_schema and on_schema_object:
+// for all and future grants object_type is the (plural) object type and object_name the database or schema containing the objects.
+type grantPrivilegesToRoleID struct {
+	RoleName        string
+	WithGrantOption bool
+	OnAccount       bool
+	OnAccountObject bool
+	OnSchema        bool
+	OnSchemaObject  bool
+	All             bool
+	Future          bool
+	ObjectType      string
+	ObjectName      string
+}
+
+func (v *grantPrivilegesToRoleID) String() string {
+	return helpers.EncodeSnowflakeID(v.RoleName, v.WithGrantOption, v.OnAccount, v.OnAccountObject, v.OnSchema, v.OnSchemaObject, v.All, v.Future, v.ObjectType, v.ObjectName)
+}
+
+// grantPrivilegesToRoleLegacyIDParts is the number of parts of the IDs of earlier versions, which also held
+// privileges and all_privileges after the role name.
+const grantPrivilegesToRoleLegacyIDParts = 12
+
+func grantPrivilegesToRoleIDFromString(s string) (*grantPrivilegesToRoleID, error) {
+	parts := strings.Split(s, helpers.IDDelimiter)
+	if len(parts) == grantPrivilegesToRoleLegacyIDParts {
+		parts = append(parts[:1], parts[3:]...)
+	}
+	if len(parts) != 10 {
+		return nil, fmt.Errorf("invalid ID specified: %v, expected role_name|with_grant_option|on_account|on_account_object|on_schema|on_schema_object|all|future|object_type|object_name", s)
+	}
+	return &grantPrivilegesToRoleID{
+		RoleName:        parts[0],
+		WithGrantOption: helpers.StringToBool(parts[1]),
+		OnAccount:       helpers.StringToBool(parts[2]),
+		OnAccountObject: helpers.StringToBool(parts[3]),
+		OnSchema:        helpers.StringToBool(parts[4]),
+		OnSchemaObject:  helpers.StringToBool(parts[5]),
+		All:             helpers.StringToBool(parts[6]),
+		Future:          helpers.StringToBool(parts[7]),
+		ObjectType:      parts[8],
+		ObjectName:      parts[9],
+	}, nil
+}
+
+// grantPrivilegesToRoleIDFromData builds the resource ID from the configuration.
+func grantPrivilegesToRoleIDFromData(d *schema.ResourceData) *grantPrivilegesToRoleID {
+	id := &grantPrivilegesToRoleID{
+		RoleName:        d.Get("role_name").(string),
+		WithGrantOption: d.Get("with_grant_option").(bool),
+		OnAccount:       d.Get("on_account").(bool),
+	}
+	if v, ok := d.GetOk("on_account_object"); ok {
+		m := v.([]interface{})[0].(map[string]interface{})
+		id.OnAccountObject = true
+		id.ObjectType = strings.ToUpper(m["object_type"].(string))
+		id.ObjectName = m["object_name"].(string)
+	}
+	if v, ok := d.GetOk("on_schema"); ok {
+		m := v.([]interface{})[0].(map[string]interface{})
+		id.OnSchema = true
+		switch {
+		case m["schema_name"].(string) != "":
+			id.ObjectType = sdk.ObjectTypeSchema.String()
+			id.ObjectName = m["schema_name"].(string)
+		case m["all_schemas_in_database"].(string) != "":
+			id.All = true
+			id.ObjectType = sdk.ObjectTypeDatabase.String()
+			id.ObjectName = m["all_schemas_in_database"].(string)
+		case m["future_schemas_in_database"].(string) != "":
+			id.Future = true
+			id.ObjectType = sdk.ObjectTypeDatabase.String()
+			id.ObjectName = m["future_schemas_in_database"].(string)
+		}
+	}
+	if v, ok := d.GetOk("on_schema_object"); ok {
+		m := v.([]interface{})[0].(map[string]interface{})
+		id.OnSchemaObject = true
+		var in map[string]interface{}
+		switch {
+		case m["object_name"].(string) != "":
+			id.ObjectType = strings.ToUpper(m["object_type"].(string))
+			id.ObjectName = m["object_name"].(string)
+		case len(m["all"].([]interface{})) > 0:
+			id.All = true
+			in = m["all"].([]interface{})[0].(map[string]interface{})
+		case len(m["future"].([]interface{})) > 0:
+			id.Future = true
+			in = m["future"].([]interface{})[0].(map[string]interface{})
+		}
+		if in != nil {
+			id.ObjectType = strings.ToUpper(in["object_type_plural"].(string))
+			id.ObjectName = in["in_database"].(string)
+			if in["in_schema"].(string) != "" {
+				id.ObjectName = in["in_schema"].(string)
+			}
+		}
+	}
+	return id
+}
+
+func importGrantPrivilegesToRole(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id, err := grantPrivilegesToRoleIDFromString(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("role_name", id.RoleName); err != nil {
+		return nil, err
+	}
+	if err := importGrantPrivilegesToRolePrivileges(d, meta.(*provider.Context), id); err != nil {
+		return nil, err
+	}
+	if err := d.Set("with_grant_option", id.WithGrantOption); err != nil {
+		return nil, err
+	}
+	if err := d.Set("on_account", id.OnAccount); err != nil {
+		return nil, err
+	}
+	switch {
+	case id.OnAccountObject:
+		err = d.Set("on_account_object", []interface{}{map[string]interface{}{
+			"object_type": id.ObjectType,
+			"object_name": id.ObjectName,
+		}})
+	case id.OnSchema:
+		onSchema := map[string]interface{}{}
+		switch {
+		case id.All:
+			onSchema["all_schemas_in_database"] = id.ObjectName
+		case id.Future:
+			onSchema["future_schemas_in_database"] = id.ObjectName
+		default:
+			onSchema["schema_name"] = id.ObjectName
+		}
+		err = d.Set("on_schema", []interface{}{onSchema})
+	case id.OnSchemaObject:
+		onSchemaObject := map[string]interface{}{}
+		if id.All || id.Future {
+			in := map[string]interface{}{
+				"object_type_plural": id.ObjectType,
+			}
+			if strings.Contains(id.ObjectName, ".") {
+				in["in_schema"] = id.ObjectName
+			} else {
+				in["in_database"] = id.ObjectName
+			}
+			if id.All {
+				onSchemaObject["all"] = []interface{}{in}
+			} else {
+				onSchemaObject["future"] = []interface{}{in}
+			}
+		} else {
+			onSchemaObject["object_type"] = id.ObjectType
+			onSchemaObject["object_name"] = id.ObjectName
+		}
+		err = d.Set("on_schema_object", []interface{}{onSchemaObject})
+	}
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(id.String())
+	return []*schema.ResourceData{d}, nil
+}
+
+// importGrantPrivilegesToRolePrivileges sets the privileges of an imported grant. IDs of earlier versions hold them,
+// otherwise every privilege the role holds on the target is imported.
+func importGrantPrivilegesToRolePrivileges(d *schema.ResourceData, providerContext *provider.Context, id *grantPrivilegesToRoleID) error {
+	if parts := strings.Split(d.Id(), helpers.IDDelimiter); len(parts) == grantPrivilegesToRoleLegacyIDParts {
+		if err := d.Set("privileges", helpers.StringListToList(parts[1])); err != nil {
+			return err
+		}
+		return d.Set("all_privileges", helpers.StringToBool(parts[2]))
+	}
+	found, _, err := readGrantPrivilegesToRolePrivileges(providerContext, id)
+	if err != nil {
+		return fmt.Errorf("error reading grants for role %v err = %w", id.RoleName, err)
+	}
+	if len(found) == 0 {
+		return fmt.Errorf("role %v holds no privileges on the target of %v", id.RoleName, d.Id())
+	}
+	privileges := found.ToList()
+	sort.Strings(privileges)
+	return d.Set("privileges", privileges)
+}
+
+// accountRoleGrantOn converts the resource ID to the target of the grant.
+func (v *grantPrivilegesToRoleID) accountRoleGrantOn() (*sdk.AccountRoleGrantOn, error) {
+	on := &sdk.AccountRoleGrantOn{}
+	switch {
+	case v.OnAccount:
+		on.Account = sdk.Bool(true)
+	case v.OnAccountObject:
+		id := sdk.NewAccountObjectIdentifier(v.ObjectName)
+		accountObject := &sdk.GrantOnAccountObject{}
+		switch sdk.ObjectType(v.ObjectType) {
+		case sdk.ObjectTypeUser:
+			accountObject.User = &id
+		case sdk.ObjectTypeResourceMonitor:
+			accountObject.ResourceMonitor = &id
+		case sdk.ObjectTypeWarehouse:
+			accountObject.Warehouse = &id
+		case sdk.ObjectTypeDatabase:
+			accountObject.Database = &id
+		case sdk.ObjectTypeIntegration:
+			accountObject.Integration = &id
+		case sdk.ObjectTypeFailoverGroup:
+			accountObject.FailoverGroup = &id
+		case sdk.ObjectTypeReplicationGroup:
+			accountObject.ReplicationGroup = &id
+		default:
+			return nil, fmt.Errorf("invalid account object type %s", v.ObjectType)
+		}
+		on.AccountObject = accountObject
+	case v.OnSchema:
+		onSchema := &sdk.GrantOnSchema{}
+		switch {
+		case v.All:
+			onSchema.AllSchemasInDatabase = sdk.Pointer(sdk.NewAccountObjectIdentifier(v.ObjectName))
+		case v.Future:
+			onSchema.FutureSchemasInDatabase = sdk.Pointer(sdk.NewAccountObjectIdentifier(v.ObjectName))
+		default:
+			onSchema.Schema = sdk.Pointer(sdk.NewSchemaIdentifierFromFullyQualifiedName(v.ObjectName))
+		}
+		on.Schema = onSchema
+	case v.OnSchemaObject:
+		onSchemaObject := &sdk.GrantOnSchemaObject{}
+		if v.All || v.Future {
+			in := &sdk.GrantOnSchemaObjectIn{
+				PluralObjectType: sdk.PluralObjectType(v.ObjectType),
+			}
+			if strings.Contains(v.ObjectName, ".") {
+				in.InSchema = sdk.Pointer(sdk.NewSchemaIdentifierFromFullyQualifiedName(v.ObjectName))
+			} else {
+				in.InDatabase = sdk.Pointer(sdk.NewAccountObjectIdentifier(v.ObjectName))
+			}
+			if v.All {
+				onSchemaObject.All = in
+			} else {
+				onSchemaObject.Future = in
+			}
+		} else {
+			onSchemaObject.SchemaObject = &sdk.Object{
+				ObjectType: sdk.ObjectType(v.ObjectType),
+				Name:       sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(v.ObjectName),
+			}
+		}
+		on.SchemaObject = onSchemaObject
+	default:
+		return nil, fmt.Errorf("one of on_account, on_account_object, on_schema or on_schema_object has to be set")
+	}
+	return on, nil
+}
+
+// accountRoleGrantPrivileges converts the given privileges to the privilege type matching the target of the grant.
+func (v *grantPrivilegesToRoleID) accountRoleGrantPrivileges(privileges []string, allPrivileges bool) *sdk.AccountRoleGrantPrivileges {
+	if allPrivileges {
+		return &sdk.AccountRoleGrantPrivileges{AllPrivileges: sdk.Bool(true)}
+	}
+	grantPrivileges := &sdk.AccountRoleGrantPrivileges{}
+	for _, privilege := range privileges {
+		privilege = strings.ToUpper(privilege)
+		switch {
+		case v.OnAccount:
+			grantPrivileges.GlobalPrivileges = append(grantPrivileges.GlobalPrivileges, sdk.GlobalPrivilege(privilege))
+		case v.OnAccountObject:
+			grantPrivileges.AccountObjectPrivileges = append(grantPrivileges.AccountObjectPrivileges, sdk.AccountObjectPrivilege(privilege))
+		case v.OnSchema:
+			grantPrivileges.SchemaPrivileges = append(grantPrivileges.SchemaPrivileges, sdk.SchemaPrivilege(privilege))
+		case v.OnSchemaObject:
+			grantPrivileges.SchemaObjectPrivileges = append(grantPrivileges.SchemaObjectPrivileges, sdk.SchemaObjectPrivilege(privilege))
+		}
+	}
+	return grantPrivileges
+}
+
+func grantPrivilegesToRole(ctx context.Context, client *sdk.Client, id *grantPrivilegesToRoleID, privileges []string, allPrivileges bool) error {
+	on, err := id.accountRoleGrantOn()
+	if err != nil {
+		return err
+	}
+	return client.Grants.GrantPrivilegesToAccountRole(ctx, id.accountRoleGrantPrivileges(privileges, allPrivileges), on, sdk.NewAccountObjectIdentifier(id.RoleName), &sdk.GrantPrivilegesToAccountRoleOptions{
+		WithGrantOption: sdk.Bool(id.WithGrantOption),
+	})
+}
+
+func revokePrivilegesFromRole(ctx context.Context, client *sdk.Client, id *grantPrivilegesToRoleID, privileges []string, allPrivileges bool) error {
+	on, err := id.accountRoleGrantOn()
+	if err != nil {
+		return err
+	}
+	return client.Grants.RevokePrivilegesFromAccountRole(ctx, id.accountRoleGrantPrivileges(privileges, allPrivileges), on, sdk.NewAccountObjectIdentifier(id.RoleName), nil)
+}
+
+// CreateGrantPrivilegesToRole implements schema.CreateFunc.
+func CreateGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id := grantPrivilegesToRoleIDFromData(d)
+	privileges := expandStringList(d.Get("privileges").(*schema.Set).List())
+	allPrivileges := d.Get("all_privileges").(bool)
+	if !allPrivileges && len(privileges) == 0 {
+		return fmt.Errorf("one of privileges or all_privileges has to be set")
+	}
+	if err := grantPrivilegesToRole(ctx, client, id, privileges, allPrivileges); err != nil {
+		return fmt.Errorf("error granting privileges to role %v err = %w", id.RoleName, err)
+	}
+	d.SetId(id.String())
+
+	return ReadGrantPrivilegesToRole(d, meta)
+}
+
+// ReadGrantPrivilegesToRole implements schema.ReadFunc.
+func ReadGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error {
+	id, err := grantPrivilegesToRoleIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+	// IDs of earlier versions also held the privileges
+	d.SetId(id.String())
+
+	found, verifiable, err := readGrantPrivilegesToRolePrivileges(meta.(*provider.Context), id)
+	if sdk.IsNotFound(err) {
+		log.Printf("[DEBUG] role or grant target of (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading grants for role %v err = %w", id.RoleName, err)
+	}
+	if !verifiable {
+		return nil
+	}
+
+	if d.Get("all_privileges").(bool) {
+		validPrivileges := grantPrivilegesToRoleValidPrivileges[id.targetObjectType()]
+		if len(validPrivileges.expandAllPrivileges()) == 0 {
+			// Without the privileges ALL PRIVILEGES expands to, the best we can do is detect that every one of them is gone
+			if len(found) == 0 {
+				log.Printf("[DEBUG] no privileges of (%s) found, removing from state", d.Id())
+				d.SetId("")
+			}
+			return nil
+		}
+		if !found.hasPrivilege(privilegeAllPrivileges.String(), validPrivileges) {
+			log.Printf("[DEBUG] some of the privileges of (%s) are missing, removing from state", d.Id())
+			d.SetId("")
+		}
+		return nil
+	}
+
+	// only the privileges managed by this resource are reconciled, other grants on the same target are left alone
+	var privileges []string
+	for _, privilege := range expandStringList(d.Get("privileges").(*schema.Set).List()) {
+		if found.hasString(strings.ToUpper(privilege)) {
+			privileges = append(privileges, privilege)
+		}
+	}
+	return d.Set("privileges", privileges)
+}
+
+// readGrantPrivilegesToRolePrivileges returns the privileges the role holds on the target of the grant.
+// The returned bool is false if the grant cannot be verified, e.g. a grant on all objects while there are none.
+func readGrantPrivilegesToRolePrivileges(providerContext *provider.Context, id *grantPrivilegesToRoleID) (PrivilegeSet, bool, error) {
+	if id.All {
+		return readGrantPrivilegesToRoleOnAll(providerContext, id)
+	}
+	opts := &sdk.ShowGrantOptions{
+		To: &sdk.ShowGrantsTo{
+			Role: sdk.NewAccountObjectIdentifier(id.RoleName),
+		},
+	}
+	if id.Future {
+		opts = &sdk.ShowGrantOptions{
+			Future: sdk.Bool(true),
+			In:     &sdk.ShowGrantsIn{},
+		}
+		if id.OnSchema || !strings.Contains(id.ObjectName, ".") {
+			opts.In.Database = sdk.Pointer(sdk.NewAccountObjectIdentifier(id.ObjectName))
+		} else {
+			opts.In.Schema = sdk.Pointer(sdk.NewSchemaIdentifierFromFullyQualifiedName(id.ObjectName))
+		}
+	}
+	grants, err := providerContext.Client.Grants.Show(context.Background(), opts)
+	if err != nil {
+		return nil, false, err
+	}
+	found := PrivilegeSet{}
+	for _, grant := range grants {
+		if id.matches(grant) {
+			found.addString(string(grant.Privilege))
+		}
+	}
+	return found, true, nil
+}
+
+// grantPrivilegesToRoleValidPrivileges maps the object types to the privileges the grant resources consider valid on them,
+// so that ALL PRIVILEGES can be expanded into the individual privileges reported by SHOW GRANTS.
+var grantPrivilegesToRoleValidPrivileges = map[string]PrivilegeSet{
+	sdk.ObjectTypeAccount.String():          validAccountPrivileges,
+	sdk.ObjectTypeDatabase.String():         validDatabasePrivileges,
+	sdk.ObjectTypeExternalTable.String():    validExternalTablePrivileges,
+	sdk.ObjectTypeFailoverGroup.String():    validFailoverGroupPrivileges,
+	sdk.ObjectTypeFileFormat.String():       validFileFormatPrivileges,
+	sdk.ObjectTypeFunction.String():         validFunctionPrivileges,
+	sdk.ObjectTypeIntegration.String():      validIntegrationPrivileges,
+	sdk.ObjectTypeMaskingPolicy.String():    validMaskingPoilcyPrivileges,
+	sdk.ObjectTypeMaterializedView.String(): validMaterializedViewPrivileges,
+	sdk.ObjectTypePipe.String():             validPipePrivileges,
+	sdk.ObjectTypeProcedure.String():        validProcedurePrivileges,
+	sdk.ObjectTypeResourceMonitor.String():  validResourceMonitorPrivileges,
+	sdk.ObjectTypeRowAccessPolicy.String():  validRowAccessPoilcyPrivileges,
+	sdk.ObjectTypeSchema.String():           validSchemaPrivileges,
+	sdk.ObjectTypeSequence.String():         validSequencePrivileges,
+	sdk.ObjectTypeStage.String():            validStagePrivileges,
+	sdk.ObjectTypeStream.String():           validStreamPrivileges,
+	sdk.ObjectTypeTable.String():            validTablePrivileges,
+	sdk.ObjectTypeTag.String():              validTagPrivileges,
+	sdk.ObjectTypeTask.String():             validTaskPrivileges,
+	sdk.ObjectTypeUser.String():             validUserPrivileges,
+	sdk.ObjectTypeView.String():             validViewPrivileges,
+	sdk.ObjectTypeWarehouse.String():        validWarehousePrivileges,
+}
+
+// targetObjectType returns the type of the objects the privileges are granted on.
+func (v *grantPrivilegesToRoleID) targetObjectType() string {
+	switch {
+	case v.OnAccount:
+		return sdk.ObjectTypeAccount.String()
+	case v.OnSchema:
+		return sdk.ObjectTypeSchema.String()
+	case v.OnSchemaObject && (v.All || v.Future):
+		return sdk.PluralObjectType(v.ObjectType).Singular().String()
+	default:
+		return v.ObjectType
+	}
+}
+
+// allGrantBuilder returns the builder listing the objects a grant on all objects applies to, or nil if there is none for the object type.
+func (v *grantPrivilegesToRoleID) allGrantBuilder() snowflake.GrantBuilder {
+	if v.OnSchema {
+		return snowflake.AllSchemaGrant(v.ObjectName)
+	}
+	databaseName, schemaName := v.ObjectName, ""
+	if strings.Contains(v.ObjectName, ".") {
+		schemaID := sdk.NewSchemaIdentifierFromFullyQualifiedName(v.ObjectName)
+		databaseName, schemaName = schemaID.DatabaseName(), schemaID.Name()
+	}
+	allGrantBuilders := map[string]func(db, schema string) snowflake.GrantBuilder{
+		sdk.ObjectTypeExternalTable.String():    snowflake.AllExternalTableGrant,
+		sdk.ObjectTypeFileFormat.String():       snowflake.AllFileFormatGrant,
+		sdk.ObjectTypeFunction.String():         snowflake.AllFunctionGrant,
+		sdk.ObjectTypeMaterializedView.String(): snowflake.AllMaterializedViewGrant,
+		sdk.ObjectTypeProcedure.String():        snowflake.AllProcedureGrant,
+		sdk.ObjectTypeSequence.String():         snowflake.AllSequenceGrant,
+		sdk.ObjectTypeStage.String():            snowflake.AllStageGrant,
+		sdk.ObjectTypeStream.String():           snowflake.AllStreamGrant,
+		sdk.ObjectTypeTable.String():            snowflake.AllTableGrant,
+		sdk.ObjectTypeTask.String():             snowflake.AllTaskGrant,
+		sdk.ObjectTypeView.String():             snowflake.AllViewGrant,
+	}
+	if builder, ok := allGrantBuilders[v.targetObjectType()]; ok {
+		return builder(databaseName, schemaName)
+	}
+	return nil
+}
+
+// readGrantPrivilegesToRoleOnAll returns the privileges the role holds on every one of the objects a grant on all objects applies to.
+// Granting ON ALL creates one grant per object that exists at that time, so a privilege missing on any of them is drift.
+// The returned bool is false if there are no objects to check.
+func readGrantPrivilegesToRoleOnAll(providerContext *provider.Context, id *grantPrivilegesToRoleID) (PrivilegeSet, bool, error) {
+	builder := id.allGrantBuilder()
+	if builder == nil {
+		log.Printf("[DEBUG] grants on all %v cannot be verified, skipping read", id.ObjectType)
+		return nil, false, nil
+	}
+	grants, objectsExist, err := readGenericAllGrants(providerContext.DB, providerContext.Client, builder, []string{id.RoleName})
+	if err != nil || !objectsExist {
+		return nil, objectsExist, err
+	}
+	found := PrivilegeSet{}
+	for _, grant := range grants {
+		found.addString(grant.Privilege)
+	}
+	return found, true, nil
+}
+
+// matches reports whether the grant was made by this resource, i.e. it is on the target with the same grant option.
+func (v *grantPrivilegesToRoleID) matches(grant *sdk.Grant) bool {
+	if grant.Privilege == "OWNERSHIP" || grant.GrantOption != v.WithGrantOption {
+		return false
+	}
+	// SHOW GRANTS reports object types with underscores, e.g. RESOURCE_MONITOR
+	grantedOn := strings.ReplaceAll(grant.GrantedOn.String(), "_", " ")
+	grantName := strings.ReplaceAll(grant.Name.Name(), `"`, "")
+	objectName := strings.ReplaceAll(v.ObjectName, `"`, "")
+	switch {
+	case v.OnAccount:
+		return grantedOn == sdk.ObjectTypeAccount.String()
+	case v.Future:
+		if grant.GrantedTo != sdk.ObjectTypeRole || !strings.EqualFold(grant.GranteeName.Name(), v.RoleName) {
+			return false
+		}
+		if v.OnSchema {
+			return grantedOn == sdk.ObjectTypeSchema.String()
+		}
+		return grantedOn == sdk.PluralObjectType(v.ObjectType).Singular().String()
+	default:
+		return grantedOn == v.ObjectType && strings.EqualFold(grantName, objectName)
+	}
+}
+
+// UpdateGrantPrivilegesToRole implements schema.UpdateFunc.
+func UpdateGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id, err := grantPrivilegesToRoleIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case d.HasChange("all_privileges"):
+		allPrivileges := d.Get("all_privileges").(bool)
+		privileges := expandStringList(d.Get("privileges").(*schema.Set).List())
+		o, _ := d.GetChange("privileges")
+		oldPrivileges := expandStringList(o.(*schema.Set).List())
+		if allPrivileges {
+			if len(oldPrivileges) > 0 {
+				if err := revokePrivilegesFromRole(ctx, client, id, oldPrivileges, false); err != nil {
+					return fmt.Errorf("error revoking privileges from role %v err = %w", id.RoleName, err)
+				}
+			}
+			if err := grantPrivilegesToRole(ctx, client, id, nil, true); err != nil {
+				return fmt.Errorf("error granting all privileges to role %v err = %w", id.RoleName, err)
+			}
+		} else {
+			if len(privileges) == 0 {
+				return fmt.Errorf("one of privileges or all_privileges has to be set")
+			}
+			if err := revokePrivilegesFromRole(ctx, client, id, nil, true); err != nil {
+				return fmt.Errorf("error revoking all privileges from role %v err = %w", id.RoleName, err)
+			}
+			if err := grantPrivilegesToRole(ctx, client, id, privileges, false); err != nil {
+				return fmt.Errorf("error granting privileges to role %v err = %w", id.RoleName, err)
+			}
+		}
+	case d.HasChange("privileges"):
+		if d.Get("privileges").(*schema.Set).Len() == 0 {
+			return fmt.Errorf("one of privileges or all_privileges has to be set")
+		}
+		toAdd, toRemove := changeDiff(d, "privileges")
+		if len(toRemove) > 0 {
+			if err := revokePrivilegesFromRole(ctx, client, id, toRemove, false); err != nil {
+				return fmt.Errorf("error revoking privileges from role %v err = %w", id.RoleName, err)
+			}
+		}
+		if len(toAdd) > 0 {
+			if err := grantPrivilegesToRole(ctx, client, id, toAdd, false); err != nil {
+				return fmt.Errorf("error granting privileges to role %v err = %w", id.RoleName, err)
+			}
+		}
+	}
+
+	return ReadGrantPrivilegesToRole(d, meta)
+}
+
+// DeleteGrantPrivilegesToRole implements schema.DeleteFunc.
+func DeleteGrantPrivilegesToRole(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id, err := grantPrivilegesToRoleIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+	privileges := expandStringList(d.Get("privileges").(*schema.Set).List())
+	if err := revokePrivilegesFromRole(ctx, client, id, privileges, d.Get("all_privileges").(bool)); err != nil {
+		return fmt.Errorf("error revoking privileges from role %v err = %w", id.RoleName, err)
+	}
+
+	d.SetId("")
+	return nil
+}
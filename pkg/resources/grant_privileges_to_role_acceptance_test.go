@@ -0,0 +1,107 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_GrantPrivilegesToRole_onAccountObject(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: grantPrivilegesToRoleOnAccountObjectConfig(name, []string{"CREATE SCHEMA", "MONITOR"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_grant_privileges_to_role.test", "role_name", name),
+					resource.TestCheckResourceAttr("snowflake_grant_privileges_to_role.test", "privileges.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_grant_privileges_to_role.test", "on_account_object.0.object_type", "DATABASE"),
+					resource.TestCheckResourceAttr("snowflake_grant_privileges_to_role.test", "on_account_object.0.object_name", name),
+				),
+			},
+			// CHANGE PRIVILEGES
+			{
+				Config: grantPrivilegesToRoleOnAccountObjectConfig(name, []string{"MONITOR", "USAGE"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_grant_privileges_to_role.test", "privileges.#", "2"),
+					resource.TestCheckTypeSetElemAttr("snowflake_grant_privileges_to_role.test", "privileges.*", "USAGE"),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:      "snowflake_grant_privileges_to_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func grantPrivilegesToRoleOnAccountObjectConfig(name string, privileges []string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name = "%[1]v"
+}
+
+resource "snowflake_role" "test" {
+	name = "%[1]v"
+}
+
+resource "snowflake_grant_privileges_to_role" "test" {
+	role_name  = snowflake_role.test.name
+	privileges = ["%[2]v"]
+	on_account_object {
+		object_type = "DATABASE"
+		object_name = snowflake_database.test.name
+	}
+}
+`, name, strings.Join(privileges, `", "`))
+}
+
+func TestAcc_GrantPrivilegesToRole_onFutureSchemaObjects(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name = "%[1]v"
+}
+
+resource "snowflake_schema" "test" {
+	database = snowflake_database.test.name
+	name     = "%[1]v"
+}
+
+resource "snowflake_role" "test" {
+	name = "%[1]v"
+}
+
+resource "snowflake_grant_privileges_to_role" "test" {
+	role_name  = snowflake_role.test.name
+	privileges = ["SELECT", "INSERT"]
+	on_schema_object {
+		future {
+			object_type_plural = "TABLES"
+			in_schema          = "${snowflake_database.test.name}.${snowflake_schema.test.name}"
+		}
+	}
+}
+`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_grant_privileges_to_role.test", "privileges.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_grant_privileges_to_role.test", "on_schema_object.0.future.0.object_type_plural", "TABLES"),
+				),
+			},
+		},
+	})
+}
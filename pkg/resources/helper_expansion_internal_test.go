@@ -26,3 +26,39 @@ func TestExpandBlankStringList(t *testing.T) {
 
 	r.Equal(0, len(out))
 }
+
+func TestParseIdentifierList(t *testing.T) {
+	r := require.New(t)
+
+	r.Nil(parseIdentifierList(""))
+	r.Nil(parseIdentifierList("[]"))
+	r.Equal([]string{"DB.SCHEMA.RULE"}, parseIdentifierList("[DB.SCHEMA.RULE]"))
+	r.Equal([]string{"DB.SCHEMA.RULE", "DB.SCHEMA.OTHER"}, parseIdentifierList("DB.SCHEMA.RULE, DB.SCHEMA.OTHER"))
+}
+
+func TestIdentifiersWithConfiguredNames(t *testing.T) {
+	r := require.New(t)
+
+	configured := []string{`"db"."schema"."rule"`, "db.schema.secret"}
+	reported := []string{"DB.SCHEMA.RULE", "DB.SCHEMA.SECRET", "DB.SCHEMA.OTHER"}
+	r.Equal([]string{`"db"."schema"."rule"`, "db.schema.secret", "DB.SCHEMA.OTHER"}, identifiersWithConfiguredNames(configured, reported))
+}
+
+func TestParseSecretReferences(t *testing.T) {
+	r := require.New(t)
+
+	secrets, err := parseSecretReferences("", nil)
+	r.NoError(err)
+	r.Nil(secrets)
+
+	configured := []interface{}{map[string]interface{}{"secret_variable_name": "cred", "secret_id": "db.schema.secret"}}
+	secrets, err = parseSecretReferences(`{"other":"\"DB\".\"SCHEMA\".\"OTHER\"","cred":"\"DB\".\"SCHEMA\".\"SECRET\""}`, configured)
+	r.NoError(err)
+	r.Equal([]map[string]interface{}{
+		{"secret_variable_name": "cred", "secret_id": "db.schema.secret"},
+		{"secret_variable_name": "other", "secret_id": `"DB"."SCHEMA"."OTHER"`},
+	}, secrets)
+
+	_, err = parseSecretReferences("not json", nil)
+	r.Error(err)
+}
@@ -1,7 +1,10 @@
 package resources
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
@@ -28,3 +31,80 @@ func dataTypeDiffSuppressFunc(_, old, new string, _ *schema.ResourceData) bool {
 func ignoreTrimSpaceSuppressFunc(_, old, new string, _ *schema.ResourceData) bool {
 	return strings.TrimSpace(old) == strings.TrimSpace(new)
 }
+
+// parseIdentifierList parses a list of object names the way DESCRIBE reports it, e.g. [DB.SCHEMA.RULE, DB.SCHEMA.OTHER].
+func parseIdentifierList(value string) []string {
+	value = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(value), "["), "]")
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	identifiers := []string{}
+	for _, identifier := range strings.Split(value, ",") {
+		identifiers = append(identifiers, strings.Trim(strings.TrimSpace(identifier), "'"))
+	}
+	return identifiers
+}
+
+// identifiersWithConfiguredNames replaces every reported object name with the configured one whenever both refer
+// to the same object, so that a quoted or lowercase name in the configuration does not cause a perpetual diff.
+func identifiersWithConfiguredNames(configured []string, reported []string) []string {
+	identifiers := make([]string, len(reported))
+	for idx, name := range reported {
+		identifiers[idx] = name
+		for _, configuredName := range configured {
+			if strings.EqualFold(strings.ReplaceAll(configuredName, `"`, ""), strings.ReplaceAll(name, `"`, "")) {
+				identifiers[idx] = configuredName
+				break
+			}
+		}
+	}
+	return identifiers
+}
+
+// expandSecretReferences turns the secrets blocks of a function or procedure into a map of secret variable
+// names to secret names.
+func expandSecretReferences(secrets []interface{}) map[string]string {
+	references := make(map[string]string, len(secrets))
+	for _, secret := range secrets {
+		s := secret.(map[string]interface{})
+		references[s["secret_variable_name"].(string)] = s["secret_id"].(string)
+	}
+	return references
+}
+
+// parseSecretReferences parses the secrets of a function or procedure the way DESCRIBE reports them,
+// e.g. {"cred":"\"DB\".\"SCHEMA\".\"SECRET\""}, keeping the configured secret names where they match.
+func parseSecretReferences(value string, configured []interface{}) ([]map[string]interface{}, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	references := map[string]string{}
+	if err := json.Unmarshal([]byte(value), &references); err != nil {
+		return nil, fmt.Errorf("could not parse secrets %v: %w", value, err)
+	}
+	configuredReferences := expandSecretReferences(configured)
+	variables := make([]string, 0, len(references))
+	for variable := range references {
+		variables = append(variables, variable)
+	}
+	sort.Strings(variables)
+	secrets := make([]map[string]interface{}, 0, len(references))
+	for _, variable := range variables {
+		secretID := references[variable]
+		if configuredID, ok := configuredReferences[variable]; ok {
+			secretID = identifiersWithConfiguredNames([]string{configuredID}, []string{secretID})[0]
+		}
+		secrets = append(secrets, map[string]interface{}{
+			"secret_variable_name": variable,
+			"secret_id":            secretID,
+		})
+	}
+	return secrets, nil
+}
+
+// withResourceQueryTag tags the statements run with the returned context with the resource type, so that the
+// warehouse work of a resource can be told apart in QUERY_HISTORY. Every tagged statement costs two additional
+// requests, so only statements running on a warehouse, such as refreshing a dynamic table, are tagged.
+func withResourceQueryTag(ctx context.Context, resourceType string) context.Context {
+	return sdk.WithQueryTag(ctx, "terraform:"+resourceType)
+}
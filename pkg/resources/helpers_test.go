@@ -54,6 +54,24 @@ func stageGrant(t *testing.T, id string, params map[string]interface{}) *schema.
 	return d
 }
 
+func functionGrant(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.FunctionGrant().Resource.Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
+func procedureGrant(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.ProcedureGrant().Resource.Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
 func tableGrant(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
 	t.Helper()
 	r := require.New(t)
@@ -447,3 +465,30 @@ func tagGrant(t *testing.T, id string, params map[string]interface{}) *schema.Re
 	d.SetId(id)
 	return d
 }
+
+func grantPrivilegesToRole(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.GrantPrivilegesToRole().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
+func grantDatabaseRole(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.GrantDatabaseRole().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
+
+func grantAccountRole(t *testing.T, id string, params map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+	r := require.New(t)
+	d := schema.TestResourceDataRaw(t, resources.GrantAccountRole().Schema, params)
+	r.NotNil(d)
+	d.SetId(id)
+	return d
+}
@@ -37,7 +37,7 @@ func TestIntegrationGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT USAGE ON INTEGRATION "test-integration" TO ROLE "test-role-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT USAGE ON INTEGRATION "test-integration" TO ROLE "test-role-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadIntegrationGrant(mock)
-		err := resources.CreateIntegrationGrant(d, db)
+		err := resources.CreateIntegrationGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -56,7 +56,7 @@ func TestIntegrationGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadIntegrationGrant(mock)
-		err := resources.ReadIntegrationGrant(d, db)
+		err := resources.ReadIntegrationGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -35,7 +35,7 @@ func TestManagedAccountCreate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`^CREATE MANAGED ACCOUNT "test-account" ADMIN_NAME='bob' ADMIN_PASSWORD='abc123ABC' COMMENT='great comment' TYPE='READER'$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadManagedAccount(mock)
-		err := resources.CreateManagedAccount(d, db)
+		err := resources.CreateManagedAccount(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -49,7 +49,7 @@ func TestManagedAccountRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.NewManagedAccountBuilder(d.Id()).Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadManagedAccount(d, db)
+		err := resources.ReadManagedAccount(d, ProviderContext(db))
 
 		r.Empty(d.State())
 		r.Nil(err)
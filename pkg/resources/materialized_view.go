@@ -9,6 +9,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -130,7 +131,7 @@ func materializedViewIDFromString(stringID string) (*materializedViewID, error)
 
 // CreateMaterializedView implements schema.CreateFunc.
 func CreateMaterializedView(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	name := d.Get("name").(string)
 	schema := d.Get("schema").(string)
 	database := d.Get("database").(string)
@@ -179,7 +180,7 @@ func CreateMaterializedView(d *schema.ResourceData, meta interface{}) error {
 
 // ReadMaterializedView implements schema.ReadFunc.
 func ReadMaterializedView(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	materializedViewID, err := materializedViewIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -246,7 +247,7 @@ func UpdateMaterializedView(d *schema.ResourceData, meta interface{}) error {
 
 	builder := snowflake.NewMaterializedViewBuilder(view).WithDB(dbName).WithSchema(schema)
 
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	if d.HasChange("name") {
 		name := d.Get("name")
 
@@ -307,7 +308,7 @@ func UpdateMaterializedView(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteMaterializedView implements schema.DeleteFunc.
 func DeleteMaterializedView(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	materializedViewID, err := materializedViewIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -45,7 +45,7 @@ func TestMaterializedViewCreate(t *testing.T) {
 		mock.ExpectCommit()
 
 		expectReadMaterializedView(mock)
-		err := resources.CreateMaterializedView(d, db)
+		err := resources.CreateMaterializedView(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -77,7 +77,7 @@ func TestMaterializedViewCreateOrReplace(t *testing.T) {
 		mock.ExpectCommit()
 
 		expectReadMaterializedView(mock)
-		err := resources.CreateMaterializedView(d, db)
+		err := resources.CreateMaterializedView(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -108,7 +108,7 @@ func TestMaterializedViewCreateAmpersand(t *testing.T) {
 		mock.ExpectCommit()
 
 		expectReadMaterializedView(mock)
-		err := resources.CreateMaterializedView(d, db)
+		err := resources.CreateMaterializedView(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -137,7 +137,7 @@ func TestMaterializedViewRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.NewMaterializedViewBuilder("good_name").WithDB("test_db").WithSchema("test_schema").Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadMaterializedView(d, db)
+		err := resources.ReadMaterializedView(d, ProviderContext(db))
 		r.Empty(d.State())
 		r.Nil(err)
 	})
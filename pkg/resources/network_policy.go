@@ -2,11 +2,13 @@ package resources
 
 import (
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -21,7 +23,7 @@ var networkPolicySchema = map[string]*schema.Schema{
 	"allowed_ip_list": {
 		Type:        schema.TypeSet,
 		Elem:        &schema.Schema{Type: schema.TypeString},
-		Required:    true,
+		Optional:    true,
 		Description: "Specifies one or more IPv4 addresses (CIDR notation) that are allowed access to your Snowflake account",
 	},
 	// TODO: Add a ValidationFunc to ensure 0.0.0.0/0 is not in blocked_ip_list
@@ -32,6 +34,18 @@ var networkPolicySchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies one or more IPv4 addresses (CIDR notation) that are denied access to your Snowflake account<br><br>**Do not** add `0.0.0.0/0` to `blocked_ip_list`",
 	},
+	"allowed_network_rule_list": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies the fully qualified names of the network rules (e.g. `db.schema.rule` or the `qualified_name` of a `snowflake_network_rule`) that contain the network identifiers allowed access to your Snowflake account",
+	},
+	"blocked_network_rule_list": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Specifies the fully qualified names of the network rules (e.g. `db.schema.rule` or the `qualified_name` of a `snowflake_network_rule`) that contain the network identifiers denied access to your Snowflake account",
+	},
 	"comment": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -56,7 +70,7 @@ func NetworkPolicy() *schema.Resource {
 
 // CreateNetworkPolicy implements schema.CreateFunc.
 func CreateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	name := d.Get("name").(string)
 	builder := snowflake.NetworkPolicy(name)
 
@@ -73,6 +87,14 @@ func CreateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 		builder.WithBlockedIPList(expandStringList(v.(*schema.Set).List()))
 	}
 
+	if v, ok := d.GetOk("allowed_network_rule_list"); ok {
+		builder.WithAllowedNetworkRuleList(expandStringList(v.(*schema.Set).List()))
+	}
+
+	if v, ok := d.GetOk("blocked_network_rule_list"); ok {
+		builder.WithBlockedNetworkRuleList(expandStringList(v.(*schema.Set).List()))
+	}
+
 	stmt := builder.Create()
 	err := snowflake.Exec(db, stmt)
 	if err != nil {
@@ -85,7 +107,7 @@ func CreateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 
 // ReadNetworkPolicy implements schema.ReadFunc.
 func ReadNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	policyName := d.Id()
 
 	builder := snowflake.NetworkPolicy(policyName)
@@ -159,6 +181,16 @@ func ReadNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 			if err != nil {
 				return err
 			}
+		} else if name == "ALLOWED_NETWORK_RULE_LIST" {
+			err = d.Set("allowed_network_rule_list", networkRuleListParser(d, "allowed_network_rule_list", value))
+			if err != nil {
+				return err
+			}
+		} else if name == "BLOCKED_NETWORK_RULE_LIST" {
+			err = d.Set("blocked_network_rule_list", networkRuleListParser(d, "blocked_network_rule_list", value))
+			if err != nil {
+				return err
+			}
 		}
 	}
 
@@ -167,7 +199,7 @@ func ReadNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 
 // UpdateNetworkPolicy implements schema.UpdateFunc.
 func UpdateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	name := d.Id()
 	builder := snowflake.NetworkPolicy(name)
 
@@ -207,12 +239,28 @@ func UpdateNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("allowed_network_rule_list") {
+		q := builder.ChangeNetworkRuleList("ALLOWED", expandStringList(d.Get("allowed_network_rule_list").(*schema.Set).List()))
+		err := snowflake.Exec(db, q)
+		if err != nil {
+			return fmt.Errorf("error updating ALLOWED_NETWORK_RULE_LIST for network policy %v err = %w", name, err)
+		}
+	}
+
+	if d.HasChange("blocked_network_rule_list") {
+		q := builder.ChangeNetworkRuleList("BLOCKED", expandStringList(d.Get("blocked_network_rule_list").(*schema.Set).List()))
+		err := snowflake.Exec(db, q)
+		if err != nil {
+			return fmt.Errorf("error updating BLOCKED_NETWORK_RULE_LIST for network policy %v err = %w", name, err)
+		}
+	}
+
 	return ReadNetworkPolicy(d, meta)
 }
 
 // DeleteNetworkPolicy implements schema.DeleteFunc.
 func DeleteNetworkPolicy(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	name := d.Id()
 
 	dropSQL := snowflake.NetworkPolicy(name).Drop()
@@ -235,3 +283,21 @@ func ipChangeParser(data *schema.ResourceData, key string) []string {
 	}
 	return newIps
 }
+
+// networkRuleListParser is a helper function to parse a network rule list reported by DESC NETWORK POLICY, e.g.
+// [{"fullyQualifiedRuleName":"DB.SCHEMA.RULE"}]. Snowflake reports the names unquoted, so the configured name is
+// kept whenever it refers to the same network rule.
+func networkRuleListParser(data *schema.ResourceData, key string, value string) []string {
+	var rules []struct {
+		FullyQualifiedRuleName string `json:"fullyQualifiedRuleName"`
+	}
+	var names []string
+	if err := json.Unmarshal([]byte(value), &rules); err == nil {
+		for _, rule := range rules {
+			names = append(names, rule.FullyQualifiedRuleName)
+		}
+	} else {
+		names = parseIdentifierList(value)
+	}
+	return identifiersWithConfiguredNames(expandStringList(data.Get(key).(*schema.Set).List()), names)
+}
@@ -7,6 +7,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -75,7 +76,7 @@ func CreateNetworkPolicyAttachment(d *schema.ResourceData, meta interface{}) err
 
 // ReadNetworkPolicyAttachment implements schema.ReadFunc.
 func ReadNetworkPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	policyName := strings.Replace(d.Id(), "_attachment", "", 1)
 	builder := snowflake.NetworkPolicy(policyName)
 
@@ -198,7 +199,7 @@ func DeleteNetworkPolicyAttachment(d *schema.ResourceData, meta interface{}) err
 // setOnAccount sets the network policy globally for the Snowflake account
 // Note: the ip address of the session executing this SQL must be allowed by the network policy being set.
 func setOnAccount(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	policyName := d.Get("network_policy_name").(string)
 
 	acctSQL := snowflake.NetworkPolicy(policyName).SetOnAccount()
@@ -212,7 +213,7 @@ func setOnAccount(d *schema.ResourceData, meta interface{}) error {
 
 // setOnAccount unsets the network policy globally for the Snowflake account.
 func unsetOnAccount(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	policyName := d.Get("network_policy_name").(string)
 
 	acctSQL := snowflake.NetworkPolicy(policyName).UnsetOnAccount()
@@ -238,7 +239,7 @@ func setOnUsers(users []string, data *schema.ResourceData, meta interface{}) err
 
 // setOnUser sets the network policy for a given user.
 func setOnUser(user string, data *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	policyName := data.Get("network_policy_name").(string)
 	userSQL := snowflake.NetworkPolicy(policyName).SetOnUser(user)
 	if err := snowflake.Exec(db, userSQL); err != nil {
@@ -262,7 +263,7 @@ func unsetOnUsers(users []string, data *schema.ResourceData, meta interface{}) e
 
 // unsetOnUser sets the network policy for a given user.
 func unsetOnUser(user string, data *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	policyName := data.Get("network_policy_name").(string)
 	userSQL := snowflake.NetworkPolicy(policyName).UnsetOnUser(user)
 	if err := snowflake.Exec(db, userSQL); err != nil {
@@ -274,7 +275,7 @@ func unsetOnUser(user string, data *schema.ResourceData, meta interface{}) error
 
 // ensureUserAlterPrivileges ensures the executing Snowflake user can alter each user in the set of users.
 func ensureUserAlterPrivileges(users []string, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	for _, user := range users {
 		userDescSQL := snowflake.NewUserBuilder(user).Describe()
 		if err := snowflake.Exec(db, userDescSQL); err != nil {
@@ -34,7 +34,7 @@ func TestNetworkPolicyAttachmentCreate(t *testing.T) {
 		mock.ExpectExec(`^DESCRIBE USER "test-user"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^ALTER USER "test-user" SET NETWORK_POLICY = "test-network-policy"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		err := resources.CreateNetworkPolicyAttachment(d, db)
+		err := resources.CreateNetworkPolicyAttachment(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -55,7 +55,7 @@ func TestNetworkPolicyAttachmentSetOnAccountDelete(t *testing.T) {
 		mock.ExpectExec(`^DESCRIBE USER "test-user"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^ALTER USER "test-user" UNSET NETWORK_POLICY$`).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		err := resources.DeleteNetworkPolicyAttachment(d, db)
+		err := resources.DeleteNetworkPolicyAttachment(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -75,7 +75,7 @@ func TestNetworkPolicyAttachmentDelete(t *testing.T) {
 		mock.ExpectExec(`^DESCRIBE USER "test-user"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^ALTER USER "test-user" UNSET NETWORK_POLICY$`).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		err := resources.DeleteNetworkPolicyAttachment(d, db)
+		err := resources.DeleteNetworkPolicyAttachment(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -36,11 +36,45 @@ func TestNetworkPolicyCreate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`^CREATE NETWORK POLICY "test-network-policy" ALLOWED_IP_LIST=\('192\.168\.1\.0/24'\) BLOCKED_IP_LIST=\('155\.548\.2\.98'\) COMMENT="great comment"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadNetworkPolicy(mock)
-		err := resources.CreateNetworkPolicy(d, db)
+		err := resources.CreateNetworkPolicy(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
 
+func TestNetworkPolicyCreateWithNetworkRules(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"name":                      "test-network-policy",
+		"allowed_network_rule_list": []interface{}{`"test_db"."test_schema"."allowed"`},
+		"blocked_network_rule_list": []interface{}{"test_db.test_schema.blocked"},
+	}
+	d := schema.TestResourceDataRaw(t, resources.NetworkPolicy().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE NETWORK POLICY "test-network-policy" ALLOWED_NETWORK_RULE_LIST=\('"test_db"."test_schema"."allowed"'\) BLOCKED_NETWORK_RULE_LIST=\('test_db.test_schema.blocked'\)$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		showRows := sqlmock.NewRows([]string{
+			"created_on", "name", "comment", "entries_in_allowed_ip_list", "entries_in_blocked_ip_list",
+		}).AddRow(
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "test-network-policy", "", 0, 0,
+		)
+		mock.ExpectQuery(`^SHOW NETWORK POLICIES$`).WillReturnRows(showRows)
+		descRows := sqlmock.NewRows([]string{
+			"name", "value",
+		}).AddRow(
+			"ALLOWED_NETWORK_RULE_LIST", `[{"fullyQualifiedRuleName":"test_db.test_schema.allowed"}]`,
+		).AddRow(
+			"BLOCKED_NETWORK_RULE_LIST", `[{"fullyQualifiedRuleName":"TEST_DB.TEST_SCHEMA.BLOCKED"},{"fullyQualifiedRuleName":"TEST_DB.TEST_SCHEMA.OTHER"}]`,
+		)
+		mock.ExpectQuery(`^DESC NETWORK POLICY "test-network-policy"$`).WillReturnRows(descRows)
+		err := resources.CreateNetworkPolicy(d, ProviderContext(db))
+		r.NoError(err)
+		r.ElementsMatch([]interface{}{`"test_db"."test_schema"."allowed"`}, d.Get("allowed_network_rule_list").(*schema.Set).List())
+		r.ElementsMatch([]interface{}{"test_db.test_schema.blocked", "TEST_DB.TEST_SCHEMA.OTHER"}, d.Get("blocked_network_rule_list").(*schema.Set).List())
+	})
+}
+
 func expectReadNetworkPolicy(mock sqlmock.Sqlmock) {
 	showRows := sqlmock.NewRows([]string{
 		"created_on", "name", "comment", "entries_in_allowed_ip_list", "entries_in_blocked_ip_list",
@@ -74,7 +108,7 @@ func TestNetworkPolicyDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`^DROP NETWORK POLICY "test-network-policy"$`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteNetworkPolicy(d, db)
+		err := resources.DeleteNetworkPolicy(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -104,7 +138,7 @@ func TestNetworkPolicyRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.NetworkPolicy(d.Id()).ShowAllNetworkPolicies()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err1 := resources.ReadNetworkPolicy(d, db)
+		err1 := resources.ReadNetworkPolicy(d, ProviderContext(db))
 		r.Empty(d.State())
 
 		rows := sqlmock.NewRows([]string{
@@ -113,7 +147,7 @@ func TestNetworkPolicyRead(t *testing.T) {
 			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC), "bad-network-policy", "this is a comment", 2, 1,
 		)
 		mock.ExpectQuery(q).WillReturnRows(rows)
-		err2 := resources.ReadNetworkPolicy(d, db)
+		err2 := resources.ReadNetworkPolicy(d, ProviderContext(db))
 
 		r.Nil(err1)
 		r.Nil(err2)
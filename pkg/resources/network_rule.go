@@ -0,0 +1,224 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var networkRuleSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the network rule.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the network rule.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the network rule; must be unique for the schema in which the network rule is created.",
+	},
+	"type": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  "Specifies the type of the network identifiers in the value list. Valid values are IPV4, HOST_PORT, AWSVPCEID and AZURELINKID.",
+		ValidateFunc: validation.StringInSlice([]string{string(sdk.NetworkRuleTypeIPv4), string(sdk.NetworkRuleTypeHostPort), string(sdk.NetworkRuleTypeAwsVpcEndpointID), string(sdk.NetworkRuleTypeAzureLinkID)}, false),
+	},
+	"mode": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  "Specifies what is restricted by the network rule. Valid values are INGRESS, EGRESS and INTERNAL_STAGE.",
+		ValidateFunc: validation.StringInSlice([]string{string(sdk.NetworkRuleModeIngress), string(sdk.NetworkRuleModeEgress), string(sdk.NetworkRuleModeInternalStage)}, false),
+	},
+	"value_list": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Required:    true,
+		MinItems:    1,
+		Description: "Specifies the network identifiers (e.g. IPv4 addresses in CIDR notation, or host names with an optional port) of the network rule.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the network rule.",
+	},
+	"owner": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Role that owns the network rule.",
+	},
+	"qualified_name": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Specifies the qualified identifier for the network rule, e.g. to reference it in a network policy.",
+	},
+}
+
+// NetworkRule returns a pointer to the resource representing a network rule.
+func NetworkRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "A network rule groups network identifiers, such as IP addresses or host names, so that they can be allowed or blocked by network policies and external access integrations.",
+		Create:      CreateNetworkRule,
+		Read:        ReadNetworkRule,
+		Update:      UpdateNetworkRule,
+		Delete:      DeleteNetworkRule,
+
+		Schema: networkRuleSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func expandNetworkRuleValueList(values []interface{}) []sdk.NetworkRuleValue {
+	valueList := make([]sdk.NetworkRuleValue, 0, len(values))
+	for _, value := range expandStringList(values) {
+		valueList = append(valueList, sdk.NetworkRuleValue{Value: value})
+	}
+	return valueList
+}
+
+// CreateNetworkRule implements schema.CreateFunc.
+func CreateNetworkRule(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	database := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	name := d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(database, schemaName, name)
+	ruleType := sdk.NetworkRuleType(d.Get("type").(string))
+	mode := sdk.NetworkRuleMode(d.Get("mode").(string))
+
+	createOptions := &sdk.CreateNetworkRuleOptions{
+		ValueList: expandNetworkRuleValueList(d.Get("value_list").(*schema.Set).List()),
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		createOptions.Comment = sdk.String(v.(string))
+	}
+
+	if err := client.NetworkRules.Create(ctx, id, ruleType, mode, createOptions); err != nil {
+		return err
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadNetworkRule(d, meta)
+}
+
+// ReadNetworkRule implements schema.ReadFunc.
+func ReadNetworkRule(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	networkRule, err := client.NetworkRules.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		log.Printf("[DEBUG] network rule (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	details, err := client.NetworkRules.Describe(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("database", networkRule.DatabaseName); err != nil {
+		return err
+	}
+	if err := d.Set("schema", networkRule.SchemaName); err != nil {
+		return err
+	}
+	if err := d.Set("name", networkRule.Name); err != nil {
+		return err
+	}
+	if err := d.Set("type", string(networkRule.Type)); err != nil {
+		return err
+	}
+	if err := d.Set("mode", string(networkRule.Mode)); err != nil {
+		return err
+	}
+	if err := d.Set("value_list", details.ValueList); err != nil {
+		return err
+	}
+	if err := d.Set("comment", networkRule.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("owner", networkRule.Owner); err != nil {
+		return err
+	}
+	if err := d.Set("qualified_name", id.FullyQualifiedName()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateNetworkRule implements schema.UpdateFunc.
+func UpdateNetworkRule(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	runSet := false
+	set := &sdk.NetworkRuleSet{}
+	if d.HasChange("value_list") {
+		// Snowflake only supports replacing the whole value list
+		runSet = true
+		set.ValueList = expandNetworkRuleValueList(d.Get("value_list").(*schema.Set).List())
+	}
+	runUnset := false
+	unset := &sdk.NetworkRuleUnset{}
+	if d.HasChange("comment") {
+		if comment := d.Get("comment").(string); comment != "" {
+			runSet = true
+			set.Comment = sdk.String(comment)
+		} else {
+			runUnset = true
+			unset.Comment = sdk.Bool(true)
+		}
+	}
+	if runSet {
+		if err := client.NetworkRules.Alter(ctx, id, &sdk.AlterNetworkRuleOptions{Set: set}); err != nil {
+			return err
+		}
+	}
+	if runUnset {
+		if err := client.NetworkRules.Alter(ctx, id, &sdk.AlterNetworkRuleOptions{Unset: unset}); err != nil {
+			return err
+		}
+	}
+
+	return ReadNetworkRule(d, meta)
+}
+
+// DeleteNetworkRule implements schema.DeleteFunc.
+func DeleteNetworkRule(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	if err := client.NetworkRules.Drop(ctx, id, nil); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
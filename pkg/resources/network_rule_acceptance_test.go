@@ -0,0 +1,82 @@
+package resources_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_NetworkRule(t *testing.T) {
+	if _, ok := os.LookupEnv("SKIP_NETWORK_POLICY_TESTS"); ok {
+		t.Skip("Skipping TestAcc_NetworkRule")
+	}
+
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: networkRuleConfig(accName, `["192.168.0.100/24", "29.254.123.20"]`, "this is a test resource"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_network_rule.rule", "name", "RULE"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.rule", "database", accName),
+					resource.TestCheckResourceAttr("snowflake_network_rule.rule", "schema", accName),
+					resource.TestCheckResourceAttr("snowflake_network_rule.rule", "type", "IPV4"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.rule", "mode", "INGRESS"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.rule", "value_list.#", "2"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.rule", "comment", "this is a test resource"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.rule", "qualified_name", fmt.Sprintf(`"%[1]v"."%[1]v"."RULE"`, accName)),
+					resource.TestCheckResourceAttr("snowflake_network_policy.policy", "allowed_network_rule_list.#", "1"),
+				),
+			},
+			{
+				Config: networkRuleConfig(accName, `["192.168.0.100/24"]`, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_network_rule.rule", "value_list.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_network_rule.rule", "comment", ""),
+				),
+			},
+			{
+				ResourceName:      "snowflake_network_rule.rule",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func networkRuleConfig(s string, valueList string, comment string) string {
+	return fmt.Sprintf(`
+	resource "snowflake_database" "test" {
+		name    = "%[1]v"
+		comment = "Terraform acceptance test"
+	}
+
+	resource "snowflake_schema" "test" {
+		name     = "%[1]v"
+		database = snowflake_database.test.name
+		comment  = "Terraform acceptance test"
+	}
+
+	resource "snowflake_network_rule" "rule" {
+		database   = snowflake_database.test.name
+		schema     = snowflake_schema.test.name
+		name       = "RULE"
+		type       = "IPV4"
+		mode       = "INGRESS"
+		value_list = %[2]v
+		comment    = "%[3]v"
+	}
+
+	resource "snowflake_network_policy" "policy" {
+		name                      = "%[1]v"
+		allowed_network_rule_list = [snowflake_network_rule.rule.qualified_name]
+	}
+	`, s, valueList, comment)
+}
@@ -0,0 +1,97 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	networkRuleShowColumns     = []string{"created_on", "name", "database_name", "schema_name", "owner", "comment", "type", "mode", "entries_in_valuelist", "owner_role_type"}
+	networkRuleDescribeColumns = []string{"created_on", "name", "database_name", "schema_name", "owner", "comment", "type", "mode", "value_list"}
+)
+
+func TestNetworkRule(t *testing.T) {
+	r := require.New(t)
+	err := resources.NetworkRule().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestNetworkRuleCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"database":   "test_db",
+		"schema":     "test_schema",
+		"name":       "test_rule",
+		"type":       "IPV4",
+		"mode":       "INGRESS",
+		"value_list": []interface{}{"192.168.0.0/24"},
+		"comment":    "great comment",
+	}
+	d := schema.TestResourceDataRaw(t, resources.NetworkRule().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE NETWORK RULE "test_db"."test_schema"."test_rule" TYPE = IPV4 VALUE_LIST = \('192.168.0.0/24'\) MODE = INGRESS COMMENT = 'great comment'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadNetworkRule(mock, "192.168.0.0/24", "great comment")
+		err := resources.CreateNetworkRule(d, ProviderContext(db))
+		r.NoError(err)
+		r.Equal("test_db|test_schema|test_rule", d.Id())
+		r.Equal(`"test_db"."test_schema"."test_rule"`, d.Get("qualified_name"))
+		r.Equal("ACCOUNTADMIN", d.Get("owner"))
+	})
+}
+
+func TestNetworkRuleUpdate(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.NetworkRule().Schema, map[string]interface{}{
+		"database":   "test_db",
+		"schema":     "test_schema",
+		"name":       "test_rule",
+		"type":       "IPV4",
+		"mode":       "INGRESS",
+		"value_list": []interface{}{"10.0.0.0/8"},
+	})
+	d.SetId("test_db|test_schema|test_rule")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER NETWORK RULE "test_db"."test_schema"."test_rule" SET VALUE_LIST = \('10.0.0.0/8'\)$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadNetworkRule(mock, "10.0.0.0/8", "")
+		err := resources.UpdateNetworkRule(d, ProviderContext(db))
+		r.NoError(err)
+		r.Equal([]interface{}{"10.0.0.0/8"}, d.Get("value_list").(*schema.Set).List())
+	})
+}
+
+func TestNetworkRuleReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.NetworkRule().Schema, map[string]interface{}{})
+	d.SetId("test_db|test_schema|test_rule")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows(networkRuleShowColumns)
+		mock.ExpectQuery(`^SHOW NETWORK RULES LIKE 'test_rule' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+		err := resources.ReadNetworkRule(d, ProviderContext(db))
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func expectReadNetworkRule(mock sqlmock.Sqlmock, valueList string, comment string) {
+	showRows := sqlmock.NewRows(networkRuleShowColumns).
+		AddRow(time.Now(), "test_rule", "test_db", "test_schema", "ACCOUNTADMIN", comment, "IPV4", "INGRESS", 1, "ROLE")
+	mock.ExpectQuery(`^SHOW NETWORK RULES LIKE 'test_rule' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(showRows)
+	describeRows := sqlmock.NewRows(networkRuleDescribeColumns).
+		AddRow(time.Now(), "test_rule", "test_db", "test_schema", "ACCOUNTADMIN", comment, "IPV4", "INGRESS", valueList)
+	mock.ExpectQuery(`^DESCRIBE NETWORK RULE "test_db"."test_schema"."test_rule"$`).WillReturnRows(describeRows)
+}
@@ -1,11 +1,11 @@
 package resources
 
 import (
-	"database/sql"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -140,7 +140,7 @@ func NotificationIntegration() *schema.Resource {
 
 // CreateNotificationIntegration implements schema.CreateFunc.
 func CreateNotificationIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	name := d.Get("name").(string)
 
 	stmt := snowflake.NewNotificationIntegrationBuilder(name).Create()
@@ -198,7 +198,7 @@ func CreateNotificationIntegration(d *schema.ResourceData, meta interface{}) err
 
 // ReadNotificationIntegration implements schema.ReadFunc.
 func ReadNotificationIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	id := d.Id()
 
 	stmt := snowflake.NewNotificationIntegrationBuilder(d.Id()).Show()
@@ -323,7 +323,7 @@ func ReadNotificationIntegration(d *schema.ResourceData, meta interface{}) error
 
 // UpdateNotificationIntegration implements schema.UpdateFunc.
 func UpdateNotificationIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	id := d.Id()
 
 	stmt := snowflake.NewNotificationIntegrationBuilder(id).Alter()
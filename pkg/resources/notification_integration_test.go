@@ -90,7 +90,7 @@ func TestNotificationIntegrationCreate(t *testing.T) {
 			mock.ExpectExec(tc.expectSQL).WillReturnResult(sqlmock.NewResult(1, 1))
 			expectReadNotificationIntegration(mock, tc.notificationProvider)
 
-			err := resources.CreateNotificationIntegration(d, db)
+			err := resources.CreateNotificationIntegration(d, ProviderContext(db))
 			r.NoError(err)
 		})
 	}
@@ -122,7 +122,7 @@ func TestNotificationIntegrationRead(t *testing.T) {
 		WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 			expectReadNotificationIntegration(mock, tc.notificationProvider)
 
-			err := resources.ReadNotificationIntegration(d, db)
+			err := resources.ReadNotificationIntegration(d, ProviderContext(db))
 			r.NoError(err)
 		})
 	}
@@ -135,7 +135,7 @@ func TestNotificationIntegrationDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP NOTIFICATION INTEGRATION "drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteNotificationIntegration(d, db)
+		err := resources.DeleteNotificationIntegration(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -34,7 +34,7 @@ func TestOAuthIntegrationCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadOAuthIntegration(mock)
 
-		err := resources.CreateOAuthIntegration(d, db)
+		err := resources.CreateOAuthIntegration(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -47,7 +47,7 @@ func TestOAuthIntegrationRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadOAuthIntegration(mock)
 
-		err := resources.ReadOAuthIntegration(d, db)
+		err := resources.ReadOAuthIntegration(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -59,7 +59,7 @@ func TestOAuthIntegrationDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP SECURITY INTEGRATION "drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteOAuthIntegration(d, db)
+		err := resources.DeleteOAuthIntegration(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -1,11 +1,11 @@
 package resources
 
 import (
-	"database/sql"
 	"fmt"
 	"reflect"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -88,7 +88,7 @@ func ObjectParameter() *schema.Resource {
 
 // CreateObjectParameter implements schema.CreateFunc.
 func CreateObjectParameter(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	key := d.Get("key").(string)
 	value := d.Get("value").(string)
 
@@ -152,7 +152,7 @@ func CreateObjectParameter(d *schema.ResourceData, meta interface{}) error {
 
 // ReadObjectParameter implements schema.ReadFunc.
 func ReadObjectParameter(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	id := d.Id()
 	parts := strings.Split(id, "|")
 	if len(parts) != 3 {
@@ -187,7 +187,7 @@ func UpdateObjectParameter(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteObjectParameter implements schema.DeleteFunc.
 func DeleteObjectParameter(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	key := d.Get("key").(string)
 	parameterDefault := snowflake.GetParameterDefaults(snowflake.ParameterTypeObject)[key]
 	defaultValue := parameterDefault.DefaultValue
@@ -221,7 +221,13 @@ func DeleteObjectParameter(d *schema.ResourceData, meta interface{}) error {
 		builder.WithObjectType(objectType)
 	}
 
-	err := builder.SetParameter()
+	var err error
+	// parameters without a default value (e.g. EVENT_TABLE) can only be unset
+	if defaultValue == nil {
+		err = builder.UnsetParameter()
+	} else {
+		err = builder.SetParameter()
+	}
 	if err != nil {
 		return fmt.Errorf("error deleting object parameter err = %w", err)
 	}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -153,7 +154,7 @@ func pipeIDFromString(stringID string) (*pipeID, error) {
 
 // CreatePipe implements schema.CreateFunc.
 func CreatePipe(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	database := d.Get("database").(string)
 	schema := d.Get("schema").(string)
 	name := d.Get("name").(string)
@@ -207,7 +208,7 @@ func CreatePipe(d *schema.ResourceData, meta interface{}) error {
 
 // ReadPipe implements schema.ReadFunc.
 func ReadPipe(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	pipeID, err := pipeIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -289,7 +290,7 @@ func UpdatePipe(d *schema.ResourceData, meta interface{}) error {
 
 	builder := snowflake.NewPipeBuilder(pipe, dbName, schema)
 
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	if d.HasChange("comment") {
 		comment := d.Get("comment")
 		q := builder.ChangeComment(comment.(string))
@@ -315,7 +316,7 @@ func UpdatePipe(d *schema.ResourceData, meta interface{}) error {
 
 // DeletePipe implements schema.DeleteFunc.
 func DeletePipe(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	pipeID, err := pipeIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -37,7 +37,7 @@ func TestPipeCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectReadPipe(mock)
-		err := resources.CreatePipe(d, db)
+		err := resources.CreatePipe(d, ProviderContext(db))
 		r.NoError(err)
 
 		r.Empty(d.Get("error_integration"), "Null string must be treated as empty")
@@ -60,7 +60,7 @@ func TestPipeRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.NewPipeBuilder("test_pipe", "test_db", "test_schema").Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadPipe(d, db)
+		err := resources.ReadPipe(d, ProviderContext(db))
 		r.Empty(d.State())
 		r.Nil(err)
 	})
@@ -106,3 +106,55 @@ func (ps PrivilegeSet) hasString(s string) bool {
 	_, ok := ps[Privilege(s)]
 	return ok
 }
+
+// privilegesNotInAllPrivileges are valid for some object types, but are not granted by GRANT ALL PRIVILEGES. On the
+// account, this includes the privileges reserved to ORGADMIN and those a role cannot pass on through ALL, such as
+// managing support cases.
+var privilegesNotInAllPrivileges = NewPrivilegeSet(
+	privilegeAllPrivileges,
+	privilegeImportedPrivileges,
+	privilegeOwnership,
+	privilegeRebuild,
+	privilegeReferenceUsage,
+	privilegeAccountSupportCases,
+	privilegeAudit,
+	privilegeCreateAccount,
+	privilegeCreateCredential,
+	privilegeCreateDataExchangeListing,
+	privilegeMonitorSecurity,
+	privilegeOrganizationSupportCases,
+	privilegeOverrideShareRestrictions,
+	privilegeProvisionApplication,
+	privilegePurchaseDataExchangeListing,
+	privilegeUserSupportCases,
+)
+
+// expandAllPrivileges returns the individual privileges that GRANT ALL PRIVILEGES grants
+// on an object type whose valid privileges are ps.
+func (ps PrivilegeSet) expandAllPrivileges() PrivilegeSet {
+	expanded := PrivilegeSet{}
+	for p := range ps {
+		if _, ok := privilegesNotInAllPrivileges[p]; !ok {
+			expanded[p] = struct{}{}
+		}
+	}
+	return expanded
+}
+
+// hasPrivilege is like hasString, but treats ALL PRIVILEGES as present only when every
+// privilege it expands to (out of validPrivileges) is present.
+func (ps PrivilegeSet) hasPrivilege(s string, validPrivileges PrivilegeSet) bool {
+	if Privilege(s) != privilegeAllPrivileges {
+		return ps.hasString(s)
+	}
+	expanded := validPrivileges.expandAllPrivileges()
+	if len(expanded) == 0 {
+		return ps.hasString(s)
+	}
+	for p := range expanded {
+		if _, ok := ps[p]; !ok {
+			return false
+		}
+	}
+	return true
+}
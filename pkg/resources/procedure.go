@@ -7,6 +7,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -162,6 +163,33 @@ var procedureSchema = map[string]*schema.Schema{
 		ForceNew:    true,
 		Description: "The handler method for Java / Python procedures.",
 	},
+	"external_access_integrations": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The names of the external access integrations the Java / Python / Scala procedures can use to reach external network locations.",
+	},
+	"secrets": {
+		Type: schema.TypeSet,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"secret_variable_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The variable name used in the handler code to retrieve the secret.",
+				},
+				"secret_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The fully qualified name of the secret, e.g. `db.schema.secret`. The secret must be allowed by one of the external access integrations.",
+				},
+			},
+		},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "The secrets the Java / Python / Scala procedure can use, each assigned to the variable name the handler code retrieves it with.",
+	},
 }
 
 func DiffTypes(_, o, n string, _ *schema.ResourceData) bool {
@@ -185,14 +213,14 @@ func Procedure() *schema.Resource {
 
 // CreateProcedure implements schema.CreateFunc.
 func CreateProcedure(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	name := d.Get("name").(string)
-	schema := d.Get("schema").(string)
+	schemaName := d.Get("schema").(string)
 	database := d.Get("database").(string)
 	s := d.Get("statement").(string)
 	ret := d.Get("return_type").(string)
 
-	builder := snowflake.NewProcedureBuilder(database, schema, name, []string{}).WithStatement(s).WithReturnType(ret)
+	builder := snowflake.NewProcedureBuilder(database, schemaName, name, []string{}).WithStatement(s).WithReturnType(ret)
 
 	// Set optionals, args
 	if _, ok := d.GetOk("arguments"); ok {
@@ -259,6 +287,16 @@ func CreateProcedure(d *schema.ResourceData, meta interface{}) error {
 		builder.WithHandler(v.(string))
 	}
 
+	// external access integrations for Java / Python / Scala
+	if v, ok := d.GetOk("external_access_integrations"); ok {
+		builder.WithExternalAccessIntegrations(expandStringList(v.(*schema.Set).List()))
+	}
+
+	// secrets for Java / Python / Scala
+	if v, ok := d.GetOk("secrets"); ok {
+		builder.WithSecrets(expandSecretReferences(v.(*schema.Set).List()))
+	}
+
 	q, err := builder.Create()
 	if err != nil {
 		return err
@@ -270,7 +308,7 @@ func CreateProcedure(d *schema.ResourceData, meta interface{}) error {
 
 	procedureID := &procedureID{
 		DatabaseName:  database,
-		SchemaName:    schema,
+		SchemaName:    schemaName,
 		ProcedureName: name,
 		ArgTypes:      builder.ArgTypes(),
 	}
@@ -282,7 +320,7 @@ func CreateProcedure(d *schema.ResourceData, meta interface{}) error {
 
 // ReadProcedure implements schema.ReadFunc.
 func ReadProcedure(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	procedureID, err := splitProcedureID(d.Id())
 	if err != nil {
 		return err
@@ -379,6 +417,19 @@ func ReadProcedure(d *schema.ResourceData, meta interface{}) error {
 			if err := d.Set("handler", desc.Value.String); err != nil {
 				return err
 			}
+		case "external_access_integrations":
+			configured := expandStringList(d.Get("external_access_integrations").(*schema.Set).List())
+			if err := d.Set("external_access_integrations", identifiersWithConfiguredNames(configured, parseIdentifierList(desc.Value.String))); err != nil {
+				return err
+			}
+		case "secrets":
+			secrets, err := parseSecretReferences(desc.Value.String, d.Get("secrets").(*schema.Set).List())
+			if err != nil {
+				return err
+			}
+			if err := d.Set("secrets", secrets); err != nil {
+				return err
+			}
 
 		default:
 			log.Printf("[WARN] unexpected procedure property %v returned from Snowflake", desc.Property.String)
@@ -440,7 +491,7 @@ func UpdateProcedure(d *schema.ResourceData, meta interface{}) error {
 		pID.ArgTypes,
 	)
 
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	if d.HasChange("name") {
 		name := d.Get("name")
 		q, err := builder.Rename(name.(string))
@@ -497,7 +548,7 @@ func UpdateProcedure(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteProcedure implements schema.DeleteFunc.
 func DeleteProcedure(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	pID, err := splitProcedureID(d.Id())
 	if err != nil {
 		return err
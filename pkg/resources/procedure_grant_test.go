@@ -0,0 +1,74 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+)
+
+func TestProcedureGrant(t *testing.T) {
+	r := require.New(t)
+	err := resources.ProcedureGrant().Resource.InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestAllProcedureGrantRead(t *testing.T) {
+	r := require.New(t)
+
+	d := procedureGrant(t, "test-db|PUBLIC|||USAGE|false|false|true|test-role-1,test-role-2|", map[string]interface{}{
+		"on_all":            true,
+		"schema_name":       "PUBLIC",
+		"database_name":     "test-db",
+		"privilege":         "USAGE",
+		"roles":             []interface{}{"test-role-1", "test-role-2"},
+		"with_grant_option": false,
+	})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		// SHOW PROCEDURES reports the database as catalog_name and lists the built-in procedures as well
+		mock.ExpectQuery(`^SHOW PROCEDURES IN SCHEMA "test-db"."PUBLIC"$`).WillReturnRows(
+			sqlmock.NewRows([]string{
+				"created_on", "name", "schema_name", "is_builtin", "arguments", "catalog_name",
+			}).AddRow(
+				time.Now(), "SYSTEM$WAIT", "", "Y", "SYSTEM$WAIT(NUMBER) RETURN VARCHAR", "",
+			).AddRow(
+				time.Now(), "PROC_1", "PUBLIC", "N", "PROC_1(VARCHAR) RETURN VARCHAR", "test-db",
+			).AddRow(
+				time.Now(), "PROC_2", "PUBLIC", "N", "PROC_2() RETURN NUMBER", "test-db",
+			),
+		)
+		mock.ExpectQuery(`^SHOW GRANTS TO ROLE "test-role-1"$`).WillReturnRows(
+			sqlmock.NewRows([]string{
+				"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+			}).AddRow(
+				time.Now(), "USAGE", "PROCEDURE", `"test-db"."PUBLIC"."PROC_1(A VARCHAR):VARCHAR(16777216)"`, "ROLE", "test-role-1", false, "bob",
+			).AddRow(
+				time.Now(), "USAGE", "PROCEDURE", `"test-db"."PUBLIC"."PROC_2():NUMBER(38,0)"`, "ROLE", "test-role-1", false, "bob",
+			),
+		)
+		// test-role-2 is missing the privilege on PROC_2, e.g. because the procedure was created after the grant
+		mock.ExpectQuery(`^SHOW GRANTS TO ROLE "test-role-2"$`).WillReturnRows(
+			sqlmock.NewRows([]string{
+				"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+			}).AddRow(
+				time.Now(), "USAGE", "PROCEDURE", `"test-db"."PUBLIC"."PROC_1(A VARCHAR):VARCHAR(16777216)"`, "ROLE", "test-role-2", false, "bob",
+			),
+		)
+		err := resources.ReadProcedureGrant(d, ProviderContext(db))
+		r.NoError(err)
+	})
+
+	roles := d.Get("roles").(*schema.Set)
+	r.True(roles.Contains("test-role-1"))
+	r.Equal(1, roles.Len())
+}
@@ -49,7 +49,7 @@ func TestProcedureCreate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`CREATE OR REPLACE PROCEDURE "my_db"."my_schema"."my_proc"\(data VARCHAR, event_dt DATE\) RETURNS VARCHAR LANGUAGE SCALA CALLED ON NULL INPUT IMMUTABLE COMMENT = 'mock comment' EXECUTE AS OWNER AS \$\$hi\$\$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectProcedureRead(mock, "VARCHAR(123456789)")
-		err := resources.CreateProcedure(d, db)
+		err := resources.CreateProcedure(d, ProviderContext(db))
 		r.NoError(err)
 		r.Equal("MY_PROC", d.Get("name").(string))
 		r.Equal("mock comment", d.Get("comment").(string))
@@ -82,7 +82,7 @@ func TestProcedureRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectProcedureRead(mock, "VARCHAR(123456789)")
 
-		err := resources.ReadProcedure(d, db)
+		err := resources.ReadProcedure(d, ProviderContext(db))
 		r.NoError(err)
 		r.Equal("MY_PROC", d.Get("name").(string))
 		r.Equal("MY_DB", d.Get("database").(string))
@@ -107,7 +107,7 @@ func TestProcedureRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectProcedureRead(mock, "TABLE ()")
 
-		err := resources.ReadProcedure(d, db)
+		err := resources.ReadProcedure(d, ProviderContext(db))
 		r.NoError(err)
 		r.Equal("MY_PROC", d.Get("name").(string))
 	})
@@ -121,7 +121,7 @@ func TestProcedureDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP PROCEDURE "my_db"."my_schema"."my_proc"\(VARCHAR, DATE\)`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteProcedure(d, db)
+		err := resources.DeleteProcedure(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
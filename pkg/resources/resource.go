@@ -1,10 +1,10 @@
 package resources
 
 import (
-	"database/sql"
 	"fmt"
 	"log"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -17,7 +17,7 @@ func CreateResource(
 	read func(*schema.ResourceData, interface{}) error,
 ) func(*schema.ResourceData, interface{}) error {
 	return func(d *schema.ResourceData, meta interface{}) error {
-		db := meta.(*sql.DB)
+		db := meta.(*provider.Context).DB
 		name := d.Get("name").(string)
 
 		qb := builder(name).Create()
@@ -63,7 +63,7 @@ func UpdateResource(
 	read func(*schema.ResourceData, interface{}) error,
 ) func(*schema.ResourceData, interface{}) error {
 	return func(d *schema.ResourceData, meta interface{}) error {
-		db := meta.(*sql.DB)
+		db := meta.(*provider.Context).DB
 		if d.HasChange("name") {
 			// I wish this could be done on one line.
 			oldNameI, newNameI := d.GetChange("name")
@@ -124,7 +124,7 @@ func UpdateResource(
 
 func DeleteResource(t string, builder func(string) *snowflake.Builder) func(*schema.ResourceData, interface{}) error {
 	return func(d *schema.ResourceData, meta interface{}) error {
-		db := meta.(*sql.DB)
+		db := meta.(*provider.Context).DB
 		name := d.Get("name").(string)
 
 		stmt := builder(name).Drop()
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -129,7 +130,7 @@ func checkAccountAgainstWarehouses(d *schema.ResourceData, name string) error {
 
 // CreateResourceMonitor implements schema.CreateFunc.
 func CreateResourceMonitor(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	name := d.Get("name").(string)
 
 	check := checkAccountAgainstWarehouses(d, name)
@@ -209,7 +210,7 @@ func CreateResourceMonitor(d *schema.ResourceData, meta interface{}) error {
 
 // ReadResourceMonitor implements schema.ReadFunc.
 func ReadResourceMonitor(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	stmt := snowflake.NewResourceMonitorBuilder(d.Id()).Show()
 
 	row := snowflake.QueryRow(db, stmt)
@@ -381,7 +382,7 @@ func extractTriggerInts(s sql.NullString) ([]int, error) {
 
 // UpdateResourceMonitor implements schema.UpdateFunc.
 func UpdateResourceMonitor(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	id := d.Id()
 
 	check := checkAccountAgainstWarehouses(d, id)
@@ -498,7 +499,7 @@ func UpdateResourceMonitor(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteResourceMonitor implements schema.DeleteFunc.
 func DeleteResourceMonitor(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 
 	stmt := snowflake.NewResourceMonitorBuilder(d.Id()).Drop()
 	if err := snowflake.Exec(db, stmt); err != nil {
@@ -37,7 +37,7 @@ func TestResourceMonitorGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT MONITOR ON RESOURCE MONITOR "test-monitor" TO ROLE "test-role-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT MONITOR ON RESOURCE MONITOR "test-monitor" TO ROLE "test-role-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadResourceMonitorGrant(mock)
-		err := resources.CreateResourceMonitorGrant(d, db)
+		err := resources.CreateResourceMonitorGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -56,7 +56,7 @@ func TestResourceMonitorGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadResourceMonitorGrant(mock)
-		err := resources.ReadResourceMonitorGrant(d, db)
+		err := resources.ReadResourceMonitorGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
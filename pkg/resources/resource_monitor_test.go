@@ -43,7 +43,7 @@ func TestResourceMonitorCreate(t *testing.T) {
 		mock.ExpectExec(`^ALTER ACCOUNT SET RESOURCE_MONITOR = "good_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectReadResourceMonitor(mock)
-		err := resources.CreateResourceMonitor(d, db)
+		err := resources.CreateResourceMonitor(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -72,7 +72,7 @@ func TestResourceMonitorDelete(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`^DROP RESOURCE MONITOR "good_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		err := resources.DeleteResourceMonitor(d, db)
+		err := resources.DeleteResourceMonitor(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -91,7 +91,7 @@ func TestResourceMonitorRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.NewResourceMonitorBuilder(d.Id()).Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadResourceMonitor(d, db)
+		err := resources.ReadResourceMonitor(d, ProviderContext(db))
 		r.Empty(d.State())
 		r.Nil(err)
 	})
@@ -1,10 +1,12 @@
 package resources
 
 import (
-	"database/sql"
+	"context"
 	"errors"
 	"log"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -41,112 +43,120 @@ func Role() *schema.Resource {
 }
 
 func CreateRole(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
 	name := d.Get("name").(string)
-	db := meta.(*sql.DB)
-	builder := snowflake.NewRoleBuilder(db, name)
+	objectIdentifier := sdk.NewAccountObjectIdentifier(name)
+
+	createOptions := &sdk.RoleCreateOptions{}
 	if v, ok := d.GetOk("comment"); ok {
-		builder.WithComment(v.(string))
+		createOptions.Comment = sdk.String(v.(string))
 	}
 	if v, ok := d.GetOk("tag"); ok {
-		tags := getTags(v)
-		builder.WithTags(tags.toSnowflakeTagValues())
+		createOptions.Tag = getTags(v).toSDKTagAssociations()
 	}
-	err := builder.Create()
+
+	err := client.Roles.Create(ctx, objectIdentifier, createOptions)
 	if err != nil {
 		return err
 	}
 	d.SetId(name)
+
 	return ReadRole(d, meta)
 }
 
 func ReadRole(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	id := d.Id()
-	// If the name is not set (such as during import) then use the id
-	name := d.Get("name").(string)
-	if name == "" {
-		name = id
-	}
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id := sdk.NewAccountObjectIdentifier(d.Id())
 
-	builder := snowflake.NewRoleBuilder(db, name)
-	role, err := builder.Show()
-	if errors.Is(err, sql.ErrNoRows) {
-		log.Printf("[WARN] role (%s) not found", name)
+	role, err := client.Roles.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		log.Printf("[WARN] role (%s) not found", d.Id())
 		d.SetId("")
 		return nil
-	} else if err != nil {
+	}
+	if err != nil {
 		return err
 	}
-	if err := d.Set("name", role.Name.String); err != nil {
+
+	if err := d.Set("name", role.Name); err != nil {
 		return err
 	}
-	if err := d.Set("comment", role.Comment.String); err != nil {
+	if err := d.Set("comment", role.Comment); err != nil {
 		return err
 	}
 	return nil
 }
 
 func UpdateRole(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	name := d.Get("name").(string)
-	builder := snowflake.NewRoleBuilder(db, name)
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id := sdk.NewAccountObjectIdentifier(d.Id())
 
 	if d.HasChange("name") {
-		o, n := d.GetChange("name")
-		builder.WithName(o.(string))
-		err := builder.Rename(n.(string))
+		newID := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+		err := client.Roles.Alter(ctx, id, &sdk.RoleAlterOptions{
+			NewName: newID,
+		})
 		if err != nil {
 			return err
 		}
-		builder.WithName(n.(string))
+		id = newID
+		d.SetId(newID.Name())
 	}
 
 	if d.HasChange("comment") {
-		o, n := d.GetChange("comment")
-		if n == nil || n.(string) == "" {
-			builder.WithComment(o.(string))
-			err := builder.UnsetComment()
-			if err != nil {
-				return err
-			}
+		alterOptions := &sdk.RoleAlterOptions{}
+		if v, ok := d.GetOk("comment"); ok {
+			alterOptions.SetComment = sdk.String(v.(string))
 		} else {
-			err := builder.SetComment(n.(string))
-			if err != nil {
-				return err
-			}
+			alterOptions.UnsetComment = sdk.Bool(true)
+		}
+		err := client.Roles.Alter(ctx, id, alterOptions)
+		if err != nil {
+			return err
 		}
 	}
 
 	if d.HasChange("tag") {
 		o, n := d.GetChange("tag")
 		removed, added, changed := getTags(o).diffs(getTags(n))
-		for _, tA := range removed {
-			err := builder.UnsetTag(tA.toSnowflakeTagValue())
+		if len(removed) > 0 {
+			err := client.Roles.Alter(ctx, id, &sdk.RoleAlterOptions{
+				UnsetTag: removed.toSDKObjectIdentifiers(),
+			})
 			if err != nil {
 				return err
 			}
 		}
-		for _, tA := range added {
-			err := builder.SetTag(tA.toSnowflakeTagValue())
-			if err != nil {
-				return err
-			}
-		}
-		for _, tA := range changed {
-			err := builder.ChangeTag(tA.toSnowflakeTagValue())
+		if len(added)+len(changed) > 0 {
+			err := client.Roles.Alter(ctx, id, &sdk.RoleAlterOptions{
+				SetTag: append(added, changed...).toSDKTagAssociations(),
+			})
 			if err != nil {
 				return err
 			}
 		}
 	}
 
-	return nil
+	return ReadRole(d, meta)
 }
 
 func DeleteRole(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	name := d.Get("name").(string)
-	builder := snowflake.NewRoleBuilder(db, name)
-	err := builder.Drop()
-	return err
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id := sdk.NewAccountObjectIdentifier(d.Id())
+
+	err := client.Roles.Drop(ctx, id, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
 }
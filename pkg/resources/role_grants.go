@@ -2,18 +2,17 @@ package resources
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/jmoiron/sqlx"
-	"github.com/snowflakedb/gosnowflake"
-	"golang.org/x/exp/slices"
 )
 
 func RoleGrants() *schema.Resource {
@@ -76,7 +75,7 @@ func RoleGrants() *schema.Resource {
 }
 
 func CreateRoleGrants(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	roleName := d.Get("role_name").(string)
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 	users := expandStringList(d.Get("users").(*schema.Set).List())
@@ -86,88 +85,102 @@ func CreateRoleGrants(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	grantID := helpers.EncodeSnowflakeID(roleName, roles, users)
-	d.SetId(grantID)
 
+	// the grants are sent in a single request; should one fail, the grants applied before it are revoked again
+	sort.Strings(roles)
+	sort.Strings(users)
+	id := sdk.NewAccountObjectIdentifier(roleName)
+	statements := make([]sdk.BatchStatement, 0, len(roles)+len(users))
 	for _, role := range roles {
-		if err := grantRoleToRole(db, roleName, role); err != nil {
+		statement, err := client.Roles.GrantStatement(id, &sdk.RoleGrantOptions{Grant: sdk.GrantRole{Role: sdk.NewAccountObjectIdentifier(role)}})
+		if err != nil {
 			return err
 		}
+		statements = append(statements, statement)
 	}
-
 	for _, user := range users {
-		if err := grantRoleToUser(db, roleName, user); err != nil {
+		statement, err := client.Roles.GrantStatement(id, &sdk.RoleGrantOptions{Grant: sdk.GrantRole{User: sdk.NewAccountObjectIdentifier(user)}})
+		if err != nil {
 			return err
 		}
+		statements = append(statements, statement)
+	}
+	if _, err := client.ExecBatch(context.Background(), statements); err != nil {
+		return fmt.Errorf("error granting role %v err = %w", roleName, err)
 	}
 
-	return ReadRoleGrants(d, meta)
-}
+	d.SetId(grantID)
 
-func grantRoleToRole(db *sql.DB, role1, role2 string) error {
-	g := snowflake.RoleGrant(role1)
-	err := snowflake.Exec(db, g.Role(role2).Grant())
-	return err
+	return ReadRoleGrants(d, meta)
 }
 
-func grantRoleToUser(db *sql.DB, role1, user string) error {
-	g := snowflake.RoleGrant(role1)
-	err := snowflake.Exec(db, g.User(user).Grant())
-	return err
+func grantRoleToRole(client *sdk.Client, role1, role2 string) error {
+	ctx := context.Background()
+	return client.Roles.Grant(ctx, sdk.NewAccountObjectIdentifier(role1), &sdk.RoleGrantOptions{
+		Grant: sdk.GrantRole{
+			Role: sdk.NewAccountObjectIdentifier(role2),
+		},
+	})
 }
 
-type roleGrant struct {
-	CreatedOn   sql.RawBytes   `db:"created_on"`
-	Role        sql.NullString `db:"role"`
-	GrantedTo   sql.NullString `db:"granted_to"`
-	GranteeName sql.NullString `db:"grantee_name"`
-	Grantedby   sql.NullString `db:"granted_by"`
+func grantRoleToUser(client *sdk.Client, role1, user string) error {
+	ctx := context.Background()
+	return client.Roles.Grant(ctx, sdk.NewAccountObjectIdentifier(role1), &sdk.RoleGrantOptions{
+		Grant: sdk.GrantRole{
+			User: sdk.NewAccountObjectIdentifier(user),
+		},
+	})
 }
 
 func ReadRoleGrants(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
 	roleName := d.Get("role_name").(string)
 
 	roles := make([]string, 0)
 	users := make([]string, 0)
 
-	builder := snowflake.NewRoleBuilder(db, roleName)
-	_, err := builder.Show()
-	if errors.Is(err, sql.ErrNoRows) {
+	_, err := client.Roles.ShowByID(ctx, sdk.NewAccountObjectIdentifier(roleName))
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
 		// If not found, mark resource to be removed from state file during apply or refresh
 		log.Printf("[DEBUG] role (%s) not found", roleName)
 		d.SetId("")
 		return nil
 	}
+	if err != nil {
+		return err
+	}
 
-	grants, err := readGrants(db, roleName)
+	grants, err := readGrants(client, roleName)
 	if err != nil {
 		return err
 	}
 
 	for _, grant := range grants {
-		switch grant.GrantedTo.String {
-		case "ROLE":
+		granteeName := grant.GranteeName.Name()
+		switch grant.GrantedTo {
+		case sdk.ObjectTypeRole:
 			if d.Get("enable_multiple_grants").(bool) {
 				for _, tfRole := range d.Get("roles").(*schema.Set).List() {
-					if tfRole == grant.GranteeName.String {
-						roles = append(roles, grant.GranteeName.String)
+					if tfRole == granteeName {
+						roles = append(roles, granteeName)
 					}
 				}
 			} else {
-				roles = append(roles, grant.GranteeName.String)
+				roles = append(roles, granteeName)
 			}
-		case "USER":
+		case sdk.ObjectTypeUser:
 			if d.Get("enable_multiple_grants").(bool) {
 				for _, tfUser := range d.Get("users").(*schema.Set).List() {
-					if tfUser == grant.GranteeName.String {
-						users = append(users, grant.GranteeName.String)
+					if tfUser == granteeName {
+						users = append(users, granteeName)
 					}
 				}
 			} else {
-				users = append(users, grant.GranteeName.String)
+				users = append(users, granteeName)
 			}
 		default:
-			log.Printf("[WARN] Ignoring unknown grant type %s", grant.GrantedTo.String)
+			log.Printf("[WARN] Ignoring unknown grant type %s", grant.GrantedTo)
 		}
 	}
 
@@ -185,52 +198,39 @@ func ReadRoleGrants(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func readGrants(db *sql.DB, roleName string) ([]*roleGrant, error) {
-	sdb := sqlx.NewDb(db, "snowflake")
-
-	stmt := fmt.Sprintf(`SHOW GRANTS OF ROLE "%s"`, roleName)
-	rows, err := sdb.Queryx(stmt)
+func readGrants(client *sdk.Client, roleName string) ([]*sdk.Grant, error) {
+	ctx := context.Background()
+	grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{
+		Of: &sdk.ShowGrantsOf{
+			Role: sdk.NewAccountObjectIdentifier(roleName),
+		},
+	})
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
-
-	grants := make([]*roleGrant, 0)
-	for rows.Next() {
-		g := &roleGrant{}
-		if err := rows.StructScan(g); err != nil {
-			return nil, err
-		}
-		grants = append(grants, g)
-	}
 
 	for _, g := range grants {
-		if g.GranteeName.Valid {
-			s := g.GranteeName.String
-			s = strings.TrimPrefix(s, `"`)
-			s = strings.TrimSuffix(s, `"`)
-			g.GranteeName = sql.NullString{String: s}
-		}
+		g.GranteeName = sdk.NewAccountObjectIdentifier(strings.Trim(g.GranteeName.Name(), `"`))
 	}
 
 	return grants, nil
 }
 
 func DeleteRoleGrants(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	roleName := d.Get("role_name").(string)
 
 	roles := expandStringList(d.Get("roles").(*schema.Set).List())
 	users := expandStringList(d.Get("users").(*schema.Set).List())
 
 	for _, role := range roles {
-		if err := revokeRoleFromRole(db, roleName, role); err != nil {
+		if err := revokeRoleFromRole(client, roleName, role); err != nil {
 			return err
 		}
 	}
 
 	for _, user := range users {
-		if err := revokeRoleFromUser(db, roleName, user); err != nil {
+		if err := revokeRoleFromUser(client, roleName, user); err != nil {
 			return err
 		}
 	}
@@ -239,56 +239,51 @@ func DeleteRoleGrants(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func revokeRoleFromRole(db *sql.DB, role1, role2 string) error {
-	rg := snowflake.RoleGrant(role1).Role(role2)
-	err := snowflake.Exec(db, rg.Revoke())
-	log.Printf("revokeRoleFromRole %v", err)
-	if driverErr, ok := err.(*gosnowflake.SnowflakeError); ok { //nolint:errorlint // todo: should be fixed
-		if driverErr.Number == 2003 {
-			// handling error if a role has been deleted prior to revoking a role
-			// 002003 (02000): SQL compilation error:
-			// User 'XXX' does not exist or not authorized.
-			roles, _ := snowflake.ListRoles(db, role2)
-			roleNames := make([]string, len(roles))
-			for i, r := range roles {
-				roleNames[i] = r.Name.String
-			}
-			if !slices.Contains(roleNames, role2) {
-				log.Printf("[WARN] Role %s does not exist. No need to revoke role %s", role2, role1)
-				return nil
-			}
+func revokeRoleFromRole(client *sdk.Client, role1, role2 string) error {
+	ctx := context.Background()
+	err := client.Roles.Revoke(ctx, sdk.NewAccountObjectIdentifier(role1), &sdk.RoleRevokeOptions{
+		Revoke: sdk.RevokeRole{
+			Role: sdk.NewAccountObjectIdentifier(role2),
+		},
+	})
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		// handling error if a role has been deleted prior to revoking a role
+		// 002003 (02000): SQL compilation error:
+		// Role 'XXX' does not exist or not authorized.
+		_, showErr := client.Roles.ShowByID(ctx, sdk.NewAccountObjectIdentifier(role2))
+		if errors.Is(showErr, sdk.ErrObjectNotExistOrAuthorized) {
+			log.Printf("[WARN] Role %s does not exist. No need to revoke role %s", role2, role1)
+			return nil
 		}
 	}
 	return err
 }
 
-func revokeRoleFromUser(db *sql.DB, role1, user string) error {
-	rg := snowflake.RoleGrant(role1).User(user)
-	err := snowflake.Exec(db, rg.Revoke())
-	if driverErr, ok := err.(*gosnowflake.SnowflakeError); ok { //nolint:errorlint // todo: should be fixed
+func revokeRoleFromUser(client *sdk.Client, role1, user string) error {
+	ctx := context.Background()
+	err := client.Roles.Revoke(ctx, sdk.NewAccountObjectIdentifier(role1), &sdk.RoleRevokeOptions{
+		Revoke: sdk.RevokeRole{
+			User: sdk.NewAccountObjectIdentifier(user),
+		},
+	})
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
 		// handling error if a user has been deleted prior to revoking a role
 		// 002003 (02000): SQL compilation error:
 		// User 'XXX' does not exist or not authorized.
-		if driverErr.Number == 2003 {
-			users, _ := snowflake.ListUsers(user, db)
-			logins := make([]string, len(users))
-			for i, u := range users {
-				logins[i] = u.LoginName.String
-			}
-			if !snowflake.Contains(logins, user) {
-				log.Printf("[WARN] User %s does not exist. No need to revoke role %s", user, role1)
-				return nil
-			}
+		_, showErr := client.Users.ShowByID(ctx, sdk.NewAccountObjectIdentifier(user))
+		if errors.Is(showErr, sdk.ErrObjectNotExistOrAuthorized) {
+			log.Printf("[WARN] User %s does not exist. No need to revoke role %s", user, role1)
+			return nil
 		}
 	}
 	return err
 }
 
 func UpdateRoleGrants(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
 	roleName := d.Get("role_name").(string)
 
-	x := func(resource string, grant func(db *sql.DB, role string, target string) error, revoke func(db *sql.DB, role string, target string) error) error {
+	x := func(resource string, grant func(client *sdk.Client, role string, target string) error, revoke func(client *sdk.Client, role string, target string) error) error {
 		o, n := d.GetChange(resource)
 
 		if o == nil {
@@ -304,12 +299,12 @@ func UpdateRoleGrants(d *schema.ResourceData, meta interface{}) error {
 		add := expandStringList(ns.Difference(os).List())
 
 		for _, user := range remove {
-			if err := revoke(db, roleName, user); err != nil {
+			if err := revoke(client, roleName, user); err != nil {
 				return err
 			}
 		}
 		for _, user := range add {
-			if err := grant(db, roleName, user); err != nil {
+			if err := grant(client, roleName, user); err != nil {
 				return err
 			}
 		}
@@ -3,8 +3,10 @@ package resources
 import (
 	"database/sql"
 	"testing"
+	"time"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
 	"github.com/stretchr/testify/require"
 )
@@ -14,7 +16,7 @@ func Test_grantToRole(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`GRANT ROLE "foo" TO ROLE "bar"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := grantRoleToRole(db, "foo", "bar")
+		err := grantRoleToRole(sdk.NewClientFromDB(db), "foo", "bar")
 		r.NoError(err)
 	})
 }
@@ -24,7 +26,7 @@ func Test_grantToUser(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`GRANT ROLE "foo" TO USER "bar"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := grantRoleToUser(db, "foo", "bar")
+		err := grantRoleToUser(sdk.NewClientFromDB(db), "foo", "bar")
 		r.NoError(err)
 	})
 }
@@ -33,14 +35,14 @@ func Test_readGrants(t *testing.T) {
 	r := require.New(t)
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
-		rows := sqlmock.NewRows([]string{"created_on", "role", "granted_to", "grantee_name", "granted_by"}).AddRow("_", "foo", "ROLE", "bam", "")
+		rows := sqlmock.NewRows([]string{"created_on", "role", "granted_to", "grantee_name", "granted_by"}).AddRow(time.Now(), "foo", "ROLE", "bam", "")
 		mock.ExpectQuery(`SHOW GRANTS OF ROLE "foo"`).WillReturnRows(rows)
-		read, err := readGrants(db, "foo")
+		read, err := readGrants(sdk.NewClientFromDB(db), "foo")
 		r.NoError(err)
 		r.Len(read, 1)
 		g := read[0]
-		r.Equal("ROLE", g.GrantedTo.String)
-		r.Equal("bam", g.GranteeName.String)
+		r.Equal(sdk.ObjectTypeRole, g.GrantedTo)
+		r.Equal("bam", g.GranteeName.Name())
 	})
 }
 
@@ -48,7 +50,7 @@ func Test_revokeRoleFromRole(t *testing.T) {
 	r := require.New(t)
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`REVOKE ROLE "foo" FROM ROLE "bar"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := revokeRoleFromRole(db, "foo", "bar")
+		err := revokeRoleFromRole(sdk.NewClientFromDB(db), "foo", "bar")
 		r.NoError(err)
 	})
 }
@@ -57,7 +59,7 @@ func Test_revokeRoleFromUser(t *testing.T) {
 	r := require.New(t)
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`REVOKE ROLE "foo" FROM USER "bar"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := revokeRoleFromUser(db, "foo", "bar")
+		err := revokeRoleFromUser(sdk.NewClientFromDB(db), "foo", "bar")
 		r.NoError(err)
 	})
 }
@@ -2,7 +2,9 @@ package resources_test
 
 import (
 	"database/sql"
+	"errors"
 	"testing"
+	"time"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
@@ -28,16 +30,43 @@ func TestRoleGrantsCreate(t *testing.T) {
 	})
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
-		mock.ExpectExec(`GRANT ROLE "good_name" TO ROLE "role2"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		mock.ExpectExec(`GRANT ROLE "good_name" TO ROLE "role1"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		mock.ExpectExec(`GRANT ROLE "good_name" TO USER "user1"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		mock.ExpectExec(`GRANT ROLE "good_name" TO USER "user2"`).WillReturnResult(sqlmock.NewResult(1, 1))
+		status := func() *sqlmock.Rows {
+			return sqlmock.NewRows([]string{"status"}).AddRow("Statement executed successfully.")
+		}
+		mock.ExpectQuery(roleGrantsBatchSQL).WillReturnRows(status(), status(), status(), status(), status(), status(), status(), status(), status(), status())
 		expectReadRoleGrants(mock)
-		err := resources.CreateRoleGrants(d, db)
+		err := resources.CreateRoleGrants(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
 
+const roleGrantsBatchSQL = "^" + `SET TERRAFORM_BATCH_POSITION = 0;\s+` +
+	`GRANT ROLE "good_name" TO ROLE "role1";\s+SET TERRAFORM_BATCH_POSITION = 1;\s+` +
+	`GRANT ROLE "good_name" TO ROLE "role2";\s+SET TERRAFORM_BATCH_POSITION = 2;\s+` +
+	`GRANT ROLE "good_name" TO USER "user1";\s+SET TERRAFORM_BATCH_POSITION = 3;\s+` +
+	`GRANT ROLE "good_name" TO USER "user2";\s+SET TERRAFORM_BATCH_POSITION = 4;\s+` +
+	`UNSET TERRAFORM_BATCH_POSITION` + "$"
+
+func TestRoleGrantsCreateFailureRevokesAppliedGrants(t *testing.T) {
+	r := require.New(t)
+
+	d := roleGrants(t, "good_name", map[string]interface{}{
+		"role_name": "good_name",
+		"roles":     []interface{}{"role1", "role2"},
+		"users":     []interface{}{"user1", "user2"},
+	})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(roleGrantsBatchSQL).WillReturnError(errors.New("002003 (02000): SQL compilation error:\nUser 'USER1' does not exist or not authorized."))
+		mock.ExpectQuery(`^SELECT \$TERRAFORM_BATCH_POSITION AS POSITION$`).WillReturnRows(sqlmock.NewRows([]string{"POSITION"}).AddRow(2))
+		mock.ExpectExec(`^REVOKE ROLE "good_name" FROM ROLE "role2"$`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`^REVOKE ROLE "good_name" FROM ROLE "role1"$`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`^UNSET TERRAFORM_BATCH_POSITION$`).WillReturnResult(sqlmock.NewResult(0, 0))
+		err := resources.CreateRoleGrants(d, ProviderContext(db))
+		r.ErrorContains(err, "User 'USER1' does not exist or not authorized")
+	})
+}
+
 func expectReadRoleGrants(mock sqlmock.Sqlmock) {
 	rows := sqlmock.NewRows([]string{
 		"created_on",
@@ -46,10 +75,11 @@ func expectReadRoleGrants(mock sqlmock.Sqlmock) {
 		"grantee_name",
 		"granted_by",
 	}).
-		AddRow("_", "good_name", "ROLE", "role1", "").
-		AddRow("_", "good_name", "ROLE", "role2", "").
-		AddRow("_", "good_name", "USER", "user1", "").
-		AddRow("_", "good_name", "USER", "user2", "")
+		AddRow(time.Now(), "good_name", "ROLE", "role1", "").
+		AddRow(time.Now(), "good_name", "ROLE", "role2", "").
+		AddRow(time.Now(), "good_name", "USER", "user1", "").
+		AddRow(time.Now(), "good_name", "USER", "user2", "")
+	mock.ExpectQuery(`SHOW ROLES LIKE 'good_name'`).WillReturnRows(sqlmock.NewRows([]string{"created_on", "name"}).AddRow(time.Now(), "good_name"))
 	mock.ExpectQuery(`SHOW GRANTS OF ROLE "good_name"`).WillReturnRows(rows)
 }
 
@@ -65,7 +95,7 @@ func TestRoleGrantsRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		r.NotEmpty(d.State())
 		expectReadRoleGrants(mock)
-		err := resources.ReadRoleGrants(d, db)
+		err := resources.ReadRoleGrants(d, ProviderContext(db))
 		r.NotEmpty(d.State())
 		r.NoError(err)
 		r.Len(d.Get("users").(*schema.Set).List(), 2)
@@ -87,7 +117,7 @@ func TestRoleGrantsDelete(t *testing.T) {
 		mock.ExpectExec(`REVOKE ROLE "drop_it" FROM ROLE "role2"`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`REVOKE ROLE "drop_it" FROM USER "user1"`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`REVOKE ROLE "drop_it" FROM USER "user2"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteRoleGrants(d, db)
+		err := resources.DeleteRoleGrants(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -100,12 +130,13 @@ func expectReadUnhandledRoleGrants(mock sqlmock.Sqlmock) {
 		"grantee_name",
 		"granted_by",
 	}).
-		AddRow("_", "good_name", "ROLE", "role1", "").
-		AddRow("_", "good_name", "ROLE", "role2", "").
-		AddRow("_", "good_name", "OTHER", "other1", "").
-		AddRow("_", "good_name", "OTHER", "other2", "").
-		AddRow("_", "good_name", "USER", "user1", "").
-		AddRow("_", "good_name", "USER", "user2", "")
+		AddRow(time.Now(), "good_name", "ROLE", "role1", "").
+		AddRow(time.Now(), "good_name", "ROLE", "role2", "").
+		AddRow(time.Now(), "good_name", "OTHER", "other1", "").
+		AddRow(time.Now(), "good_name", "OTHER", "other2", "").
+		AddRow(time.Now(), "good_name", "USER", "user1", "").
+		AddRow(time.Now(), "good_name", "USER", "user2", "")
+	mock.ExpectQuery(`SHOW ROLES LIKE 'good_name'`).WillReturnRows(sqlmock.NewRows([]string{"created_on", "name"}).AddRow(time.Now(), "good_name"))
 	mock.ExpectQuery(`SHOW GRANTS OF ROLE "good_name"`).WillReturnRows(rows)
 }
 
@@ -121,7 +152,7 @@ func TestIgnoreUnknownRoleGrants(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		// Make sure that extraneous grants are ignored.
 		expectReadUnhandledRoleGrants(mock)
-		err := resources.ReadRoleGrants(d, db)
+		err := resources.ReadRoleGrants(d, ProviderContext(db))
 		r.NoError(err)
 		r.Len(d.Get("users").(*schema.Set).List(), 2)
 		r.Len(d.Get("roles").(*schema.Set).List(), 2)
@@ -136,14 +167,15 @@ func expectReadUnmanagedGrants(mock sqlmock.Sqlmock) {
 		"grantee_name",
 		"granted_by",
 	}).
-		AddRow("_", "good_name", "ROLE", "role1", "").
-		AddRow("_", "good_name", "ROLE", "role2", "").
-		AddRow("_", "good_name", "ROLE", "unmanaged_role1", "").
-		AddRow("_", "good_name", "ROLE", "unmanaged_role2", "").
-		AddRow("_", "good_name", "USER", "user1", "").
-		AddRow("_", "good_name", "USER", "user2", "").
-		AddRow("_", "good_name", "USER", "unmanaged_user1", "").
-		AddRow("_", "good_name", "USER", "unmanaged_user2", "")
+		AddRow(time.Now(), "good_name", "ROLE", "role1", "").
+		AddRow(time.Now(), "good_name", "ROLE", "role2", "").
+		AddRow(time.Now(), "good_name", "ROLE", "unmanaged_role1", "").
+		AddRow(time.Now(), "good_name", "ROLE", "unmanaged_role2", "").
+		AddRow(time.Now(), "good_name", "USER", "user1", "").
+		AddRow(time.Now(), "good_name", "USER", "user2", "").
+		AddRow(time.Now(), "good_name", "USER", "unmanaged_user1", "").
+		AddRow(time.Now(), "good_name", "USER", "unmanaged_user2", "")
+	mock.ExpectQuery(`SHOW ROLES LIKE 'good_name'`).WillReturnRows(sqlmock.NewRows([]string{"created_on", "name"}).AddRow(time.Now(), "good_name"))
 	mock.ExpectQuery(`SHOW GRANTS OF ROLE "good_name"`).WillReturnRows(rows)
 }
 
@@ -160,7 +192,7 @@ func TestIgnoreUnmanagedGrants(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		// Make sure that extraneous grants are ignored.
 		expectReadUnmanagedGrants(mock)
-		err := resources.ReadRoleGrants(d, db)
+		err := resources.ReadRoleGrants(d, ProviderContext(db))
 		r.NoError(err)
 		r.Len(d.Get("users").(*schema.Set).List(), 2)
 		r.Len(d.Get("roles").(*schema.Set).List(), 2)
@@ -180,7 +212,7 @@ func TestIncludeUnmanagedGrants(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		// Make sure that extraneous grants are ignored.
 		expectReadUnmanagedGrants(mock)
-		err := resources.ReadRoleGrants(d, db)
+		err := resources.ReadRoleGrants(d, ProviderContext(db))
 		r.NoError(err)
 		r.Len(d.Get("users").(*schema.Set).List(), 4)
 		r.Len(d.Get("roles").(*schema.Set).List(), 4)
@@ -7,6 +7,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -67,7 +68,7 @@ func RoleOwnershipGrant() *schema.Resource {
 }
 
 func CreateRoleOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	onRoleName := d.Get("on_role_name").(string)
 	toRoleName := d.Get("to_role_name").(string)
 	currentGrants := d.Get("current_grants").(string)
@@ -83,7 +84,7 @@ func CreateRoleOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
 }
 
 func ReadRoleOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	onRoleName := strings.Split(d.Id(), "|")[0]
 	currentGrants := strings.Split(d.Id(), "|")[2]
 
@@ -125,7 +126,7 @@ func ReadRoleOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
 }
 
 func UpdateRoleOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	onRoleName := d.Get("on_role_name").(string)
 	toRoleName := d.Get("to_role_name").(string)
 	currentGrants := d.Get("current_grants").(string)
@@ -141,7 +142,7 @@ func UpdateRoleOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
 }
 
 func DeleteRoleOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	onRoleName := d.Get("on_role_name").(string)
 	currentGrants := d.Get("current_grants").(string)
 	reversionRole := d.Get("revert_ownership_to_role_name").(string)
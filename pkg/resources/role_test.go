@@ -0,0 +1,57 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRole(t *testing.T) {
+	r := require.New(t)
+	err := resources.Role().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func expectReadRole(mock sqlmock.Sqlmock, name string, pattern string) {
+	rows := sqlmock.NewRows([]string{"created_on", "name", "is_default", "is_current", "is_inherited", "assigned_to_users", "granted_to_roles", "granted_roles", "owner", "comment"}).
+		AddRow(time.Now(), name, "N", "N", "N", 0, 0, 0, "ACCOUNTADMIN", "mock comment")
+	mock.ExpectQuery(`^SHOW ROLES LIKE '` + pattern + `'$`).WillReturnRows(rows)
+}
+
+func TestRoleWithDottedName(t *testing.T) {
+	r := require.New(t)
+	name := "team.analysts"
+
+	d := schema.TestResourceDataRaw(t, resources.Role().Schema, map[string]interface{}{"name": name})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE ROLE "team\.analysts"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadRole(mock, name, `team\.analysts`)
+		err := resources.CreateRole(d, ProviderContext(db))
+		r.NoError(err)
+		r.Equal(name, d.Id())
+	})
+
+	// state written by earlier versions of the provider holds the raw role name as well
+	d = schema.TestResourceDataRaw(t, resources.Role().Schema, map[string]interface{}{"name": name})
+	d.SetId(name)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadRole(mock, name, `team\.analysts`)
+		err := resources.ReadRole(d, ProviderContext(db))
+		r.NoError(err)
+		r.Equal("mock comment", d.Get("comment").(string))
+
+		mock.ExpectExec(`^DROP ROLE "team\.analysts"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		err = resources.DeleteRole(d, ProviderContext(db))
+		r.NoError(err)
+	})
+}
@@ -2,11 +2,11 @@ package resources
 
 import (
 	"bytes"
-	"database/sql"
 	"encoding/csv"
 	"fmt"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -116,7 +116,7 @@ func RowAccessPolicy() *schema.Resource {
 
 // CreateRowAccessPolicy implements schema.CreateFunc.
 func CreateRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	name := d.Get("name").(string)
 	database := d.Get("database").(string)
 	schema := d.Get("schema").(string)
@@ -154,7 +154,7 @@ func CreateRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
 
 // ReadRowAccessPolicy implements schema.ReadFunc.
 func ReadRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	rowAccessPolicyID, err := rowAccessPolicyIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -222,7 +222,7 @@ func ReadRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
 
 // UpdateRowAccessPolicy implements schema.UpdateFunc.
 func UpdateRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 
 	rowAccessPolicyID, err := rowAccessPolicyIDFromString(d.Id())
 	if err != nil {
@@ -263,7 +263,7 @@ func UpdateRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteRowAccessPolicy implements schema.DeleteFunc.
 func DeleteRowAccessPolicy(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	rowAccessPolicyID, err := rowAccessPolicyIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -37,7 +37,7 @@ func TestRowAccessPolicyCreate(t *testing.T) {
 			`^CREATE ROW ACCESS POLICY "database_name"."schema_name"."policy_name" AS \(n VARCHAR, v VARCHAR\) RETURNS BOOLEAN -> case when current_role\(\) in \('ANALYST'\) then true else false end COMMENT = \'great comment\'$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadRowAccessPolicy(mock)
-		err := resources.CreateRowAccessPolicy(d, db)
+		err := resources.CreateRowAccessPolicy(d, ProviderContext(db))
 		r.NoError(err)
 		r.Equal("policy_name", d.Get("name").(string))
 	})
@@ -76,7 +76,7 @@ func TestRowAccessPolicyDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`^DROP ROW ACCESS POLICY "database_name"."schema_name"."policy_name"$`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteRowAccessPolicy(d, db)
+		err := resources.DeleteRowAccessPolicy(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
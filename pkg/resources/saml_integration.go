@@ -1,12 +1,12 @@
 package resources
 
 import (
-	"database/sql"
 	"fmt"
 	"log"
 	"strconv"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -154,7 +154,7 @@ func SAMLIntegration() *schema.Resource {
 
 // CreateSAMLIntegration implements schema.CreateFunc.
 func CreateSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	name := d.Get("name").(string)
 
 	stmt := snowflake.NewSamlIntegrationBuilder(name).Create()
@@ -219,7 +219,7 @@ func CreateSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
 
 // ReadSAMLIntegration implements schema.ReadFunc.
 func ReadSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	id := d.Id()
 
 	stmt := snowflake.NewSamlIntegrationBuilder(id).Show()
@@ -383,7 +383,7 @@ func ReadSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
 
 // UpdateSAMLIntegration implements schema.UpdateFunc.
 func UpdateSAMLIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	id := d.Id()
 
 	stmt := snowflake.NewSamlIntegrationBuilder(id).Alter()
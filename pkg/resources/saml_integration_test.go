@@ -38,7 +38,7 @@ func TestSAMLIntegrationCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadSAMLIntegration(mock)
 
-		err := resources.CreateSAMLIntegration(d, db)
+		err := resources.CreateSAMLIntegration(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -51,7 +51,7 @@ func TestSAMLIntegrationRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadSAMLIntegration(mock)
 
-		err := resources.ReadSAMLIntegration(d, db)
+		err := resources.ReadSAMLIntegration(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -63,7 +63,7 @@ func TestSAMLIntegrationDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP SECURITY INTEGRATION "drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteSAMLIntegration(d, db)
+		err := resources.DeleteSAMLIntegration(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -3,19 +3,17 @@ package resources
 import (
 	"bytes"
 	"context"
-	"database/sql"
 	"encoding/csv"
 	"errors"
 	"fmt"
 	"log"
-	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 )
 
 const (
@@ -122,36 +120,30 @@ func Schema() *schema.Resource {
 
 // CreateSchema implements schema.CreateFunc.
 func CreateSchema(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
 	name := d.Get("name").(string)
 	database := d.Get("database").(string)
+	id := sdk.NewSchemaIdentifier(database, name)
 
-	builder := snowflake.NewSchemaBuilder(name).WithDB(database)
-
-	// Set optionals
+	createOptions := &sdk.CreateSchemaOptions{}
 	if v, ok := d.GetOk("comment"); ok {
-		builder.WithComment(v.(string))
+		createOptions.Comment = sdk.String(v.(string))
 	}
-
 	if v, ok := d.GetOk("is_transient"); ok && v.(bool) {
-		builder.Transient()
+		createOptions.Transient = sdk.Bool(true)
 	}
-
 	if v, ok := d.GetOk("is_managed"); ok && v.(bool) {
-		builder.Managed()
-	}
-
-	if v, ok := d.GetOk("data_retention_days"); ok {
-		builder.WithDataRetentionDays(v.(int))
+		createOptions.WithManagedAccess = sdk.Bool(true)
 	}
-
+	// data_retention_days has a default, so zero has to be passed explicitly as well
+	createOptions.DataRetentionTimeInDays = sdk.Int(d.Get("data_retention_days").(int))
 	if v, ok := d.GetOk("tag"); ok {
-		tags := getTags(v)
-		builder.WithTags(tags.toSnowflakeTagValues())
+		createOptions.Tag = getTags(v).toSDKTagAssociations()
 	}
 
-	q := builder.Create()
-	if err := snowflake.Exec(db, q); err != nil {
+	if err := client.Schemas.Create(ctx, id, createOptions); err != nil {
 		return fmt.Errorf("error creating schema %v err = %w", name, err)
 	}
 
@@ -170,28 +162,18 @@ func CreateSchema(d *schema.ResourceData, meta interface{}) error {
 
 // ReadSchema implements schema.ReadFunc.
 func ReadSchema(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
 	schemaID, err := schemaIDFromString(d.Id())
 	if err != nil {
 		return err
 	}
+	id := sdk.NewSchemaIdentifier(schemaID.DatabaseName, schemaID.SchemaName)
 
-	dbName := schemaID.DatabaseName
-	schema := schemaID.SchemaName
-
-	// Checks if the corresponding database still exists; if not, than the schema also cannot exist
-	client := sdk.NewClientFromDB(db)
-	ctx := context.Background()
-	_, err = client.Databases.ShowByID(ctx, sdk.NewAccountObjectIdentifier(dbName))
-	if err != nil {
-		d.SetId("")
-	}
-
-	q := snowflake.NewSchemaBuilder(schema).WithDB(dbName).Show()
-	row := snowflake.QueryRow(db, q)
-
-	s, err := snowflake.ScanSchema(row)
-	if errors.Is(err, sql.ErrNoRows) {
+	// a missing database is reported as not found as well, since the schema cannot exist without it
+	s, err := client.Schemas.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
 		// If not found, mark resource to be removed from state file during apply or refresh
 		log.Printf("[DEBUG] schema (%s) not found", d.Id())
 		d.SetId("")
@@ -201,127 +183,115 @@ func ReadSchema(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	if err := d.Set("name", s.Name.String); err != nil {
+	if err := d.Set("name", s.Name); err != nil {
 		return err
 	}
 
-	if err := d.Set("database", s.DatabaseName.String); err != nil {
+	if err := d.Set("database", s.DatabaseName); err != nil {
 		return err
 	}
 
-	if err := d.Set("comment", s.Comment.String); err != nil {
+	if err := d.Set("comment", s.Comment); err != nil {
 		return err
 	}
 
-	// "retention_time" may sometimes be empty string instead of an integer
-	{
-		retentionTime := s.RetentionTime.String
-		if retentionTime == "" {
-			retentionTime = "0"
-		}
-
-		i, err := strconv.ParseInt(retentionTime, 10, 64)
-		if err != nil {
-			return err
-		}
-
-		if err := d.Set("data_retention_days", i); err != nil {
-			return err
-		}
-	}
-
-	// reset the options before reading back from the DB
-	if err := d.Set("is_transient", false); err != nil {
+	if err := d.Set("data_retention_days", s.RetentionTime); err != nil {
 		return err
 	}
 
-	if err := d.Set("is_managed", false); err != nil {
+	if err := d.Set("is_transient", s.IsTransient()); err != nil {
 		return err
 	}
 
-	if opts := s.Options.String; opts != "" {
-		for _, opt := range strings.Split(opts, ", ") {
-			switch opt {
-			case "TRANSIENT":
-				if err := d.Set("is_transient", true); err != nil {
-					return err
-				}
-			case "MANAGED ACCESS":
-				if err := d.Set("is_managed", true); err != nil {
-					return err
-				}
-			}
-		}
-	}
-
-	return nil
+	return d.Set("is_managed", s.IsManagedAccess())
 }
 
 // UpdateSchema implements schema.UpdateFunc.
 func UpdateSchema(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
 	sid, err := schemaIDFromString(d.Id())
 	if err != nil {
 		return err
 	}
+	id := sdk.NewSchemaIdentifier(sid.DatabaseName, sid.SchemaName)
 
-	dbName := sid.DatabaseName
-	schema := sid.SchemaName
-
-	builder := snowflake.NewSchemaBuilder(schema).WithDB(dbName)
-
-	db := meta.(*sql.DB)
 	if d.HasChange("name") {
-		name := d.Get("name")
-		q := builder.Rename(name.(string))
-		if err := snowflake.Exec(db, q); err != nil {
+		newID := sdk.NewSchemaIdentifier(sid.DatabaseName, d.Get("name").(string))
+		err := client.Schemas.Alter(ctx, id, &sdk.AlterSchemaOptions{
+			NewName: newID,
+		})
+		if err != nil {
 			return fmt.Errorf("error updating schema name on %v err = %w", d.Id(), err)
 		}
 
 		schemaID := &schemaID{
-			DatabaseName: dbName,
-			SchemaName:   name.(string),
+			DatabaseName: newID.DatabaseName(),
+			SchemaName:   newID.Name(),
 		}
 		dataIDInput, err := schemaID.String()
 		if err != nil {
 			return err
 		}
 		d.SetId(dataIDInput)
+		id = newID
 	}
 
 	if d.HasChange("comment") {
-		comment := d.Get("comment")
-		q := builder.ChangeComment(comment.(string))
-		if err := snowflake.Exec(db, q); err != nil {
+		alterOptions := &sdk.AlterSchemaOptions{}
+		if v, ok := d.GetOk("comment"); ok {
+			alterOptions.Set = &sdk.SchemaSet{Comment: sdk.String(v.(string))}
+		} else {
+			alterOptions.Unset = &sdk.SchemaUnset{Comment: sdk.Bool(true)}
+		}
+		if err := client.Schemas.Alter(ctx, id, alterOptions); err != nil {
 			return fmt.Errorf("error updating schema comment on %v err = %w", d.Id(), err)
 		}
 	}
 
 	if d.HasChange("is_managed") {
-		managed := d.Get("is_managed")
-		var q string
-		if managed.(bool) {
-			q = builder.Manage()
+		alterOptions := &sdk.AlterSchemaOptions{}
+		if d.Get("is_managed").(bool) {
+			alterOptions.EnableManagedAccess = sdk.Bool(true)
 		} else {
-			q = builder.Unmanage()
+			alterOptions.DisableManagedAccess = sdk.Bool(true)
 		}
-
-		if err := snowflake.Exec(db, q); err != nil {
+		if err := client.Schemas.Alter(ctx, id, alterOptions); err != nil {
 			return fmt.Errorf("error changing management state on %v err = %w", d.Id(), err)
 		}
 	}
 
 	if d.HasChange("data_retention_days") {
-		days := d.Get("data_retention_days")
-
-		q := builder.ChangeDataRetentionDays(days.(int))
-		if err := snowflake.Exec(db, q); err != nil {
+		err := client.Schemas.Alter(ctx, id, &sdk.AlterSchemaOptions{
+			Set: &sdk.SchemaSet{
+				DataRetentionTimeInDays: sdk.Int(d.Get("data_retention_days").(int)),
+			},
+		})
+		if err != nil {
 			return fmt.Errorf("error updating data retention days on %v err = %w", d.Id(), err)
 		}
 	}
 
-	tagChangeErr := handleTagChanges(db, d, builder)
-	if tagChangeErr != nil {
-		return tagChangeErr
+	if d.HasChange("tag") {
+		o, n := d.GetChange("tag")
+		removed, added, changed := getTags(o).diffs(getTags(n))
+		if len(removed) > 0 {
+			err := client.Schemas.Alter(ctx, id, &sdk.AlterSchemaOptions{
+				UnsetTag: removed.toSDKObjectIdentifiers(),
+			})
+			if err != nil {
+				return fmt.Errorf("error dropping tags on %v err = %w", d.Id(), err)
+			}
+		}
+		if len(added)+len(changed) > 0 {
+			err := client.Schemas.Alter(ctx, id, &sdk.AlterSchemaOptions{
+				SetTag: append(added, changed...).toSDKTagAssociations(),
+			})
+			if err != nil {
+				return fmt.Errorf("error setting tags on %v err = %w", d.Id(), err)
+			}
+		}
 	}
 
 	return ReadSchema(d, meta)
@@ -329,17 +299,16 @@ func UpdateSchema(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteSchema implements schema.DeleteFunc.
 func DeleteSchema(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
 	schemaID, err := schemaIDFromString(d.Id())
 	if err != nil {
 		return err
 	}
+	id := sdk.NewSchemaIdentifier(schemaID.DatabaseName, schemaID.SchemaName)
 
-	dbName := schemaID.DatabaseName
-	schema := schemaID.SchemaName
-
-	q := snowflake.NewSchemaBuilder(schema).WithDB(dbName).Drop()
-	if err := snowflake.Exec(db, q); err != nil {
+	if err := client.Schemas.Drop(ctx, id, nil); err != nil {
 		return fmt.Errorf("error deleting schema %v err = %w", d.Id(), err)
 	}
 
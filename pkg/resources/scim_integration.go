@@ -1,11 +1,11 @@
 package resources
 
 import (
-	"database/sql"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -75,7 +75,7 @@ func SCIMIntegration() *schema.Resource {
 
 // CreateSCIMIntegration implements schema.CreateFunc.
 func CreateSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	name := d.Get("name").(string)
 
 	stmt := snowflake.NewSCIMIntegrationBuilder(name).Create()
@@ -101,7 +101,7 @@ func CreateSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
 
 // ReadSCIMIntegration implements schema.ReadFunc.
 func ReadSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	id := d.Id()
 
 	stmt := snowflake.NewSCIMIntegrationBuilder(id).Show()
@@ -164,7 +164,7 @@ func ReadSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
 
 // UpdateSCIMIntegration implements schema.UpdateFunc.
 func UpdateSCIMIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	id := d.Id()
 
 	stmt := snowflake.NewSCIMIntegrationBuilder(id).Alter()
@@ -36,7 +36,7 @@ func TestSCIMIntegrationCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadSCIMIntegration(mock)
 
-		err := resources.CreateSCIMIntegration(d, db)
+		err := resources.CreateSCIMIntegration(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -49,7 +49,7 @@ func TestSCIMIntegrationRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadSCIMIntegration(mock)
 
-		err := resources.ReadSCIMIntegration(d, db)
+		err := resources.ReadSCIMIntegration(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -61,7 +61,7 @@ func TestSCIMIntegrationDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP SECURITY INTEGRATION "drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteSCIMIntegration(d, db)
+		err := resources.DeleteSCIMIntegration(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
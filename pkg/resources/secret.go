@@ -0,0 +1,288 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var secretSchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database in which to create the secret.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema in which to create the secret.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Specifies the identifier for the secret; must be unique for the schema in which the secret is created.",
+	},
+	"type": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  "Specifies the type of the secret. Valid values are PASSWORD, GENERIC_STRING and OAUTH2.",
+		ValidateFunc: validation.StringInSlice([]string{string(sdk.SecretTypePassword), string(sdk.SecretTypeGenericString), string(sdk.SecretTypeOAuth2)}, false),
+	},
+	"username": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the username stored in a PASSWORD secret.",
+	},
+	"password": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		Description: "Specifies the password stored in a PASSWORD secret.",
+	},
+	"secret_string": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+		Description: "Specifies the string stored in a GENERIC_STRING secret.",
+	},
+	"api_authentication": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the name of the API_AUTHENTICATION security integration used by an OAUTH2 secret.",
+	},
+	"oauth_scopes": {
+		Type:          schema.TypeSet,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		Optional:      true,
+		Description:   "Specifies the scopes requested by an OAUTH2 secret using the client credentials flow.",
+		ConflictsWith: []string{"oauth_refresh_token"},
+	},
+	"oauth_refresh_token": {
+		Type:          schema.TypeString,
+		Optional:      true,
+		Sensitive:     true,
+		Description:   "Specifies the refresh token of an OAUTH2 secret using the authorization code flow.",
+		RequiredWith:  []string{"oauth_refresh_token_expiry_time"},
+		ConflictsWith: []string{"oauth_scopes"},
+	},
+	"oauth_refresh_token_expiry_time": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Specifies the timestamp at which the refresh token of an OAUTH2 secret expires.",
+		RequiredWith: []string{"oauth_refresh_token"},
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the secret.",
+	},
+	"owner": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Role that owns the secret.",
+	},
+}
+
+// Secret returns a pointer to the resource representing a secret.
+func Secret() *schema.Resource {
+	return &schema.Resource{
+		Description: "A secret stores sensitive values, such as credentials or OAuth tokens, that can be used by external access integrations, functions and procedures. The values of `password`, `secret_string` and `oauth_refresh_token` are write-only: they are never read back from Snowflake, so changes made outside of Terraform are detected only on the metadata returned by DESCRIBE SECRET.",
+		Create:      CreateSecret,
+		Read:        ReadSecret,
+		Update:      UpdateSecret,
+		Delete:      DeleteSecret,
+
+		Schema: secretSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func expandSecretOAuthScopes(scopes []interface{}) []sdk.SecretOAuthScope {
+	oauthScopes := make([]sdk.SecretOAuthScope, 0, len(scopes))
+	for _, scope := range expandStringList(scopes) {
+		oauthScopes = append(oauthScopes, sdk.SecretOAuthScope{Scope: scope})
+	}
+	return oauthScopes
+}
+
+// CreateSecret implements schema.CreateFunc.
+func CreateSecret(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	database := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	name := d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(database, schemaName, name)
+	secretType := sdk.SecretType(d.Get("type").(string))
+
+	createOptions := &sdk.CreateSecretOptions{}
+	if v, ok := d.GetOk("api_authentication"); ok {
+		createOptions.ApiAuthentication = sdk.NewAccountObjectIdentifier(v.(string))
+	}
+	if v, ok := d.GetOk("oauth_scopes"); ok {
+		createOptions.OAuthScopes = expandSecretOAuthScopes(v.(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("oauth_refresh_token"); ok {
+		createOptions.OAuthRefreshToken = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("oauth_refresh_token_expiry_time"); ok {
+		createOptions.OAuthRefreshTokenExpiryTime = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("username"); ok {
+		createOptions.Username = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("password"); ok {
+		createOptions.Password = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("secret_string"); ok {
+		createOptions.SecretString = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		createOptions.Comment = sdk.String(v.(string))
+	}
+
+	if err := client.Secrets.Create(ctx, id, secretType, createOptions); err != nil {
+		return err
+	}
+	d.SetId(helpers.EncodeSnowflakeID(id))
+
+	return ReadSecret(d, meta)
+}
+
+// ReadSecret implements schema.ReadFunc. Secret values (password, secret_string and oauth_refresh_token) are
+// never returned by Snowflake, so they are kept as configured.
+func ReadSecret(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	secret, err := client.Secrets.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		log.Printf("[DEBUG] secret (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	details, err := client.Secrets.Describe(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("database", secret.DatabaseName); err != nil {
+		return err
+	}
+	if err := d.Set("schema", secret.SchemaName); err != nil {
+		return err
+	}
+	if err := d.Set("name", secret.Name); err != nil {
+		return err
+	}
+	if err := d.Set("type", string(details.SecretType)); err != nil {
+		return err
+	}
+	if err := d.Set("username", details.Username); err != nil {
+		return err
+	}
+	if err := d.Set("api_authentication", details.IntegrationName); err != nil {
+		return err
+	}
+	if err := d.Set("oauth_scopes", details.OAuthScopes); err != nil {
+		return err
+	}
+	if err := d.Set("comment", details.Comment); err != nil {
+		return err
+	}
+	if err := d.Set("owner", secret.Owner); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateSecret implements schema.UpdateFunc.
+func UpdateSecret(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	runSet := false
+	set := &sdk.SecretSet{}
+	if d.HasChange("oauth_scopes") {
+		if scopes := d.Get("oauth_scopes").(*schema.Set).List(); len(scopes) > 0 {
+			runSet = true
+			set.OAuthScopes = expandSecretOAuthScopes(scopes)
+		}
+	}
+	if d.HasChanges("oauth_refresh_token", "oauth_refresh_token_expiry_time") {
+		if v, ok := d.GetOk("oauth_refresh_token"); ok {
+			runSet = true
+			set.OAuthRefreshToken = sdk.String(v.(string))
+			set.OAuthRefreshTokenExpiryTime = sdk.String(d.Get("oauth_refresh_token_expiry_time").(string))
+		}
+	}
+	if d.HasChange("username") {
+		runSet = true
+		set.Username = sdk.String(d.Get("username").(string))
+	}
+	if d.HasChange("password") {
+		runSet = true
+		set.Password = sdk.String(d.Get("password").(string))
+	}
+	if d.HasChange("secret_string") {
+		runSet = true
+		set.SecretString = sdk.String(d.Get("secret_string").(string))
+	}
+	runUnset := false
+	unset := &sdk.SecretUnset{}
+	if d.HasChange("comment") {
+		if comment := d.Get("comment").(string); comment != "" {
+			runSet = true
+			set.Comment = sdk.String(comment)
+		} else {
+			runUnset = true
+			unset.Comment = sdk.Bool(true)
+		}
+	}
+	if runSet {
+		if err := client.Secrets.Alter(ctx, id, &sdk.AlterSecretOptions{Set: set}); err != nil {
+			return err
+		}
+	}
+	if runUnset {
+		if err := client.Secrets.Alter(ctx, id, &sdk.AlterSecretOptions{Unset: unset}); err != nil {
+			return err
+		}
+	}
+
+	return ReadSecret(d, meta)
+}
+
+// DeleteSecret implements schema.DeleteFunc.
+func DeleteSecret(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	if err := client.Secrets.Drop(ctx, id, nil); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
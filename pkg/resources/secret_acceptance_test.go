@@ -0,0 +1,81 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_Secret(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: secretConfig(accName, "user", "pass", "this is a test resource"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_secret.password", "name", "CREDENTIALS"),
+					resource.TestCheckResourceAttr("snowflake_secret.password", "database", accName),
+					resource.TestCheckResourceAttr("snowflake_secret.password", "schema", accName),
+					resource.TestCheckResourceAttr("snowflake_secret.password", "type", "PASSWORD"),
+					resource.TestCheckResourceAttr("snowflake_secret.password", "username", "user"),
+					resource.TestCheckResourceAttr("snowflake_secret.password", "comment", "this is a test resource"),
+					resource.TestCheckResourceAttrSet("snowflake_secret.password", "owner"),
+					resource.TestCheckResourceAttr("snowflake_secret.generic", "type", "GENERIC_STRING"),
+				),
+			},
+			{
+				Config: secretConfig(accName, "other_user", "other_pass", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_secret.password", "username", "other_user"),
+					resource.TestCheckResourceAttr("snowflake_secret.password", "password", "other_pass"),
+					resource.TestCheckResourceAttr("snowflake_secret.password", "comment", ""),
+				),
+			},
+			{
+				ResourceName:            "snowflake_secret.password",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func secretConfig(s string, username string, password string, comment string) string {
+	return fmt.Sprintf(`
+	resource "snowflake_database" "test" {
+		name    = "%[1]v"
+		comment = "Terraform acceptance test"
+	}
+
+	resource "snowflake_schema" "test" {
+		name     = "%[1]v"
+		database = snowflake_database.test.name
+		comment  = "Terraform acceptance test"
+	}
+
+	resource "snowflake_secret" "password" {
+		database = snowflake_database.test.name
+		schema   = snowflake_schema.test.name
+		name     = "CREDENTIALS"
+		type     = "PASSWORD"
+		username = "%[2]v"
+		password = "%[3]v"
+		comment  = "%[4]v"
+	}
+
+	resource "snowflake_secret" "generic" {
+		database      = snowflake_database.test.name
+		schema        = snowflake_schema.test.name
+		name          = "API_KEY"
+		type          = "GENERIC_STRING"
+		secret_string = "s3cr3t"
+	}
+	`, s, username, password, comment)
+}
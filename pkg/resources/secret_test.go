@@ -0,0 +1,98 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	secretShowColumns     = []string{"created_on", "name", "schema_name", "database_name", "owner", "comment", "secret_type", "oauth_scopes", "owner_role_type"}
+	secretDescribeColumns = []string{"created_on", "name", "schema_name", "database_name", "owner", "comment", "secret_type", "username", "oauth_access_token_expiry_time", "oauth_refresh_token_expiry_time", "oauth_scopes", "integration_name"}
+)
+
+func TestSecret(t *testing.T) {
+	r := require.New(t)
+	err := resources.Secret().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestSecretCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"database": "test_db",
+		"schema":   "test_schema",
+		"name":     "test_secret",
+		"type":     "PASSWORD",
+		"username": "user",
+		"password": "pass",
+		"comment":  "great comment",
+	}
+	d := schema.TestResourceDataRaw(t, resources.Secret().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE SECRET "test_db"."test_schema"."test_secret" TYPE = PASSWORD USERNAME = 'user' PASSWORD = 'pass' COMMENT = 'great comment'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadSecret(mock, "user", "great comment")
+		err := resources.CreateSecret(d, ProviderContext(db))
+		r.NoError(err)
+		r.Equal("test_db|test_schema|test_secret", d.Id())
+		r.Equal("user", d.Get("username"))
+		r.Equal("pass", d.Get("password"))
+		r.Equal("ACCOUNTADMIN", d.Get("owner"))
+	})
+}
+
+func TestSecretUpdate(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.Secret().Schema, map[string]interface{}{
+		"database": "test_db",
+		"schema":   "test_schema",
+		"name":     "test_secret",
+		"type":     "PASSWORD",
+		"username": "other_user",
+		"password": "other_pass",
+	})
+	d.SetId("test_db|test_schema|test_secret")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER SECRET "test_db"."test_schema"."test_secret" SET USERNAME = 'other_user' PASSWORD = 'other_pass'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadSecret(mock, "other_user", "")
+		err := resources.UpdateSecret(d, ProviderContext(db))
+		r.NoError(err)
+		r.Equal("other_user", d.Get("username"))
+	})
+}
+
+func TestSecretReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.Secret().Schema, map[string]interface{}{})
+	d.SetId("test_db|test_schema|test_secret")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows(secretShowColumns)
+		mock.ExpectQuery(`^SHOW SECRETS LIKE 'test_secret' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+		err := resources.ReadSecret(d, ProviderContext(db))
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func expectReadSecret(mock sqlmock.Sqlmock, username string, comment string) {
+	showRows := sqlmock.NewRows(secretShowColumns).
+		AddRow(time.Now(), "test_secret", "test_schema", "test_db", "ACCOUNTADMIN", comment, "PASSWORD", "", "ROLE")
+	mock.ExpectQuery(`^SHOW SECRETS LIKE 'test_secret' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(showRows)
+	describeRows := sqlmock.NewRows(secretDescribeColumns).
+		AddRow(time.Now(), "test_secret", "test_schema", "test_db", "ACCOUNTADMIN", comment, "PASSWORD", username, nil, nil, nil, nil)
+	mock.ExpectQuery(`^DESCRIBE SECRET "test_db"."test_schema"."test_secret"$`).WillReturnRows(describeRows)
+}
@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -98,7 +99,7 @@ func Sequence() *schema.Resource {
 
 // CreateSequence implements schema.CreateFunc.
 func CreateSequence(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	database := d.Get("database").(string)
 	schema := d.Get("schema").(string)
 	name := d.Get("name").(string)
@@ -134,7 +135,7 @@ func CreateSequence(d *schema.ResourceData, meta interface{}) error {
 
 // ReadSequence implements schema.ReadFunc.
 func ReadSequence(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	sequenceID, err := sequenceIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -200,7 +201,7 @@ func ReadSequence(d *schema.ResourceData, meta interface{}) error {
 }
 
 func UpdateSequence(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	sequenceID, err := sequenceIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -250,7 +251,7 @@ func UpdateSequence(d *schema.ResourceData, meta interface{}) error {
 }
 
 func DeleteSequence(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	sequenceID, err := sequenceIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -53,7 +53,7 @@ func TestSequenceCreate(t *testing.T) {
 			"mock comment",
 		)
 		mock.ExpectQuery(`SHOW SEQUENCES LIKE 'good_name' IN SCHEMA "database"."schema"`).WillReturnRows(rows)
-		err := resources.CreateSequence(d, db)
+		err := resources.CreateSequence(d, ProviderContext(db))
 		r.NoError(err)
 		r.Equal("database|schema|good_name", d.Id())
 	})
@@ -90,7 +90,7 @@ func TestSequenceRead(t *testing.T) {
 			"mock comment",
 		)
 		mock.ExpectQuery(`SHOW SEQUENCES LIKE 'good_name' IN SCHEMA "database"."schema"`).WillReturnRows(rows)
-		err := resources.ReadSequence(d, db)
+		err := resources.ReadSequence(d, ProviderContext(db))
 		r.NoError(err)
 		r.Equal("good_name", d.Get("name").(string))
 		r.Equal("schema", d.Get("schema").(string))
@@ -114,7 +114,7 @@ func TestSequenceDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP SEQUENCE "database"."schema"."drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteSequence(d, db)
+		err := resources.DeleteSequence(d, ProviderContext(db))
 		r.NoError(err)
 		r.Equal("", d.Id())
 	})
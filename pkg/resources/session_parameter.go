@@ -1,10 +1,10 @@
 package resources
 
 import (
-	"database/sql"
 	"fmt"
 	"reflect"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -53,7 +53,7 @@ func SessionParameter() *schema.Resource {
 
 // CreateSessionParameter implements schema.CreateFunc.
 func CreateSessionParameter(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	key := d.Get("key").(string)
 	value := d.Get("value").(string)
 
@@ -109,7 +109,7 @@ func CreateSessionParameter(d *schema.ResourceData, meta interface{}) error {
 
 // ReadSessionParameter implements schema.ReadFunc.
 func ReadSessionParameter(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	key := d.Id()
 
 	onAccount := d.Get("on_account").(bool)
@@ -141,7 +141,7 @@ func UpdateSessionParameter(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteSessionParameter implements schema.DeleteFunc.
 func DeleteSessionParameter(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	key := d.Get("key").(string)
 
 	parameterDefault := snowflake.GetParameterDefaults(snowflake.ParameterTypeSession)[key]
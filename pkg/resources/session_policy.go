@@ -0,0 +1,212 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var sessionPolicySchema = map[string]*schema.Schema{
+	"database": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The database this session policy belongs to.",
+	},
+	"schema": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The schema this session policy belongs to.",
+	},
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Identifier for the session policy; must be unique for the schema in which the session policy is created.",
+	},
+	"session_idle_timeout_mins": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      240,
+		Description:  "Specifies the number of minutes in which a session can be idle before users must authenticate to Snowflake again. Supported range: 5 to 240, inclusive. Default: 240",
+		ValidateFunc: validation.IntBetween(5, 240),
+	},
+	"session_ui_idle_timeout_mins": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      240,
+		Description:  "Specifies the number of minutes in which a Snowsight or Classic Console session can be idle before users must authenticate to Snowflake again. Supported range: 5 to 240, inclusive. Default: 240",
+		ValidateFunc: validation.IntBetween(5, 240),
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Adds a comment or overwrites an existing comment for the session policy.",
+	},
+}
+
+func SessionPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "A session policy defines the idle session timeout period in minutes for Snowflake and Snowsight sessions.",
+		Create:      CreateSessionPolicy,
+		Read:        ReadSessionPolicy,
+		Update:      UpdateSessionPolicy,
+		Delete:      DeleteSessionPolicy,
+
+		Schema: sessionPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// CreateSessionPolicy implements schema.CreateFunc.
+func CreateSessionPolicy(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	database := d.Get("database").(string)
+	schema := d.Get("schema").(string)
+	name := d.Get("name").(string)
+	objectIdentifier := sdk.NewSchemaObjectIdentifier(database, schema, name)
+
+	createOptions := &sdk.CreateSessionPolicyOptions{
+		SessionIdleTimeoutMins:   sdk.Int(d.Get("session_idle_timeout_mins").(int)),
+		SessionUIIdleTimeoutMins: sdk.Int(d.Get("session_ui_idle_timeout_mins").(int)),
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		createOptions.Comment = sdk.String(v.(string))
+	}
+
+	err := client.SessionPolicies.Create(ctx, objectIdentifier, createOptions)
+	if err != nil {
+		return err
+	}
+	d.SetId(helpers.EncodeSnowflakeID(objectIdentifier))
+	return ReadSessionPolicy(d, meta)
+}
+
+// ReadSessionPolicy implements schema.ReadFunc.
+func ReadSessionPolicy(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	sessionPolicy, err := client.SessionPolicies.ShowByID(ctx, objectIdentifier)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		log.Printf("[DEBUG] session policy (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("database", sessionPolicy.DatabaseName); err != nil {
+		return err
+	}
+	if err := d.Set("schema", sessionPolicy.SchemaName); err != nil {
+		return err
+	}
+	if err := d.Set("name", sessionPolicy.Name); err != nil {
+		return err
+	}
+	if err := d.Set("comment", sessionPolicy.Comment); err != nil {
+		return err
+	}
+
+	sessionPolicyDetails, err := client.SessionPolicies.Describe(ctx, objectIdentifier)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("session_idle_timeout_mins", sessionPolicyDetails.SessionIdleTimeoutMins); err != nil {
+		return err
+	}
+	if err := d.Set("session_ui_idle_timeout_mins", sessionPolicyDetails.SessionUIIdleTimeoutMins); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateSessionPolicy implements schema.UpdateFunc.
+func UpdateSessionPolicy(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+
+	if d.HasChange("name") {
+		newID := sdk.NewSchemaObjectIdentifier(objectIdentifier.DatabaseName(), objectIdentifier.SchemaName(), d.Get("name").(string))
+		err := client.SessionPolicies.Alter(ctx, objectIdentifier, &sdk.AlterSessionPolicyOptions{
+			NewName: newID,
+		})
+		if err != nil {
+			return err
+		}
+		objectIdentifier = newID
+		d.SetId(helpers.EncodeSnowflakeID(newID))
+	}
+
+	runSet := false
+	set := &sdk.SessionPolicySet{}
+	runUnset := false
+	unset := &sdk.SessionPolicyUnset{}
+
+	if d.HasChange("session_idle_timeout_mins") {
+		runSet = true
+		set.SessionIdleTimeoutMins = sdk.Int(d.Get("session_idle_timeout_mins").(int))
+	}
+	if d.HasChange("session_ui_idle_timeout_mins") {
+		runSet = true
+		set.SessionUIIdleTimeoutMins = sdk.Int(d.Get("session_ui_idle_timeout_mins").(int))
+	}
+	if d.HasChange("comment") {
+		if v, ok := d.GetOk("comment"); ok {
+			runSet = true
+			set.Comment = sdk.String(v.(string))
+		} else {
+			runUnset = true
+			unset.Comment = sdk.Bool(true)
+		}
+	}
+
+	if runSet {
+		err := client.SessionPolicies.Alter(ctx, objectIdentifier, &sdk.AlterSessionPolicyOptions{
+			Set: set,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if runUnset {
+		err := client.SessionPolicies.Alter(ctx, objectIdentifier, &sdk.AlterSessionPolicyOptions{
+			Unset: unset,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return ReadSessionPolicy(d, meta)
+}
+
+// DeleteSessionPolicy implements schema.DeleteFunc.
+func DeleteSessionPolicy(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	objectIdentifier := helpers.DecodeSnowflakeID(d.Id()).(sdk.SchemaObjectIdentifier)
+	err := client.SessionPolicies.Drop(ctx, objectIdentifier, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
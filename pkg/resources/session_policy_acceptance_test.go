@@ -0,0 +1,67 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_SessionPolicy(t *testing.T) {
+	accName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: sessionPolicyConfig(accName, 30, 60, "this is a test resource"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_session_policy.sp", "name", accName),
+					resource.TestCheckResourceAttr("snowflake_session_policy.sp", "session_idle_timeout_mins", "30"),
+					resource.TestCheckResourceAttr("snowflake_session_policy.sp", "session_ui_idle_timeout_mins", "60"),
+					resource.TestCheckResourceAttr("snowflake_session_policy.sp", "comment", "this is a test resource"),
+				),
+			},
+			{
+				Config: sessionPolicyConfig(accName, 15, 20, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_session_policy.sp", "session_idle_timeout_mins", "15"),
+					resource.TestCheckResourceAttr("snowflake_session_policy.sp", "session_ui_idle_timeout_mins", "20"),
+					resource.TestCheckResourceAttr("snowflake_session_policy.sp", "comment", ""),
+				),
+			},
+			{
+				ResourceName:      "snowflake_session_policy.sp",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func sessionPolicyConfig(s string, idleTimeout int, uiIdleTimeout int, comment string) string {
+	return fmt.Sprintf(`
+	resource "snowflake_database" "test" {
+		name    = "%v"
+		comment = "Terraform acceptance test"
+	}
+
+	resource "snowflake_schema" "test" {
+		name     = "%v"
+		database = snowflake_database.test.name
+		comment  = "Terraform acceptance test"
+	}
+
+	resource "snowflake_session_policy" "sp" {
+		database                     = snowflake_database.test.name
+		schema                       = snowflake_schema.test.name
+		name                         = "%v"
+		session_idle_timeout_mins    = %d
+		session_ui_idle_timeout_mins = %d
+		comment                      = "%s"
+	}
+	`, s, s, s, idleTimeout, uiIdleTimeout, comment)
+}
@@ -0,0 +1,197 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var sessionPolicyAttachmentSchema = map[string]*schema.Schema{
+	"session_policy_id": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The resource id of the session policy (snowflake_session_policy.policy.id).",
+	},
+	"set_for_account": {
+		Type:         schema.TypeBool,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "Specifies whether the session policy should be set on the current Snowflake account. An account can only have one session policy set at any given time.",
+		ExactlyOneOf: []string{"set_for_account", "user"},
+	},
+	"user": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "Name of the user the session policy should be set on. A user can only have one session policy set at any given time.",
+		ExactlyOneOf: []string{"set_for_account", "user"},
+	},
+}
+
+// SessionPolicyAttachment returns a pointer to the resource representing a session policy attachment.
+func SessionPolicyAttachment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Sets a session policy on the current account or on a user.",
+		Create:      CreateSessionPolicyAttachment,
+		Read:        ReadSessionPolicyAttachment,
+		Delete:      DeleteSessionPolicyAttachment,
+
+		Schema: sessionPolicyAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+// sessionPolicyAttachmentID is the id of a session policy attachment, in the format
+// policyDatabase|policySchema|policyName|ACCOUNT or policyDatabase|policySchema|policyName|USER|userName.
+type sessionPolicyAttachmentID struct {
+	PolicyID sdk.SchemaObjectIdentifier
+	Domain   sdk.PolicyEntityDomain
+	User     string
+}
+
+func (v sessionPolicyAttachmentID) String() string {
+	if v.Domain == sdk.PolicyEntityDomainUser {
+		return helpers.EncodeSnowflakeID(v.PolicyID.DatabaseName(), v.PolicyID.SchemaName(), v.PolicyID.Name(), string(v.Domain), v.User)
+	}
+	return helpers.EncodeSnowflakeID(v.PolicyID.DatabaseName(), v.PolicyID.SchemaName(), v.PolicyID.Name(), string(v.Domain))
+}
+
+func sessionPolicyAttachmentIDFromString(s string) (*sessionPolicyAttachmentID, error) {
+	parts := strings.Split(s, helpers.IDDelimiter)
+	if len(parts) < 4 {
+		return nil, fmt.Errorf("invalid session policy attachment id %v", s)
+	}
+	id := &sessionPolicyAttachmentID{
+		PolicyID: sdk.NewSchemaObjectIdentifier(parts[0], parts[1], parts[2]),
+		Domain:   sdk.PolicyEntityDomain(parts[3]),
+	}
+	switch {
+	case id.Domain == sdk.PolicyEntityDomainAccount && len(parts) == 4:
+		return id, nil
+	case id.Domain == sdk.PolicyEntityDomainUser && len(parts) == 5:
+		id.User = parts[4]
+		return id, nil
+	}
+	return nil, fmt.Errorf("invalid session policy attachment id %v", s)
+}
+
+// CreateSessionPolicyAttachment implements schema.CreateFunc.
+func CreateSessionPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	policyID := helpers.DecodeSnowflakeID(d.Get("session_policy_id").(string)).(sdk.SchemaObjectIdentifier)
+	attachmentID := sessionPolicyAttachmentID{PolicyID: policyID}
+
+	if d.Get("set_for_account").(bool) {
+		attachmentID.Domain = sdk.PolicyEntityDomainAccount
+		err := client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+			Set: &sdk.AccountSet{
+				SessionPolicy: policyID,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error setting session policy %v on account err = %w", policyID.FullyQualifiedName(), err)
+		}
+	} else {
+		attachmentID.Domain = sdk.PolicyEntityDomainUser
+		attachmentID.User = d.Get("user").(string)
+		err := client.Users.Alter(ctx, sdk.NewAccountObjectIdentifier(attachmentID.User), &sdk.AlterUserOptions{
+			Set: &sdk.UserSet{
+				SessionPolicy: policyID,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error setting session policy %v on user %v err = %w", policyID.FullyQualifiedName(), attachmentID.User, err)
+		}
+	}
+
+	d.SetId(attachmentID.String())
+	return ReadSessionPolicyAttachment(d, meta)
+}
+
+// ReadSessionPolicyAttachment implements schema.ReadFunc.
+func ReadSessionPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	attachmentID, err := sessionPolicyAttachmentIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	references, err := client.SessionPolicies.ShowReferences(ctx, attachmentID.PolicyID)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, reference := range references {
+		if reference.RefEntityDomain != attachmentID.Domain {
+			continue
+		}
+		if attachmentID.Domain == sdk.PolicyEntityDomainUser && reference.RefEntityName != attachmentID.User {
+			continue
+		}
+		found = true
+		break
+	}
+	if !found {
+		log.Printf("[DEBUG] session policy attachment (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("session_policy_id", helpers.EncodeSnowflakeID(attachmentID.PolicyID)); err != nil {
+		return err
+	}
+	if attachmentID.Domain == sdk.PolicyEntityDomainAccount {
+		if err := d.Set("set_for_account", true); err != nil {
+			return err
+		}
+	} else {
+		if err := d.Set("user", attachmentID.User); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteSessionPolicyAttachment implements schema.DeleteFunc.
+func DeleteSessionPolicyAttachment(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	attachmentID, err := sessionPolicyAttachmentIDFromString(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if attachmentID.Domain == sdk.PolicyEntityDomainAccount {
+		err = client.Accounts.Alter(ctx, &sdk.AlterAccountOptions{
+			Unset: &sdk.AccountUnset{
+				SessionPolicy: sdk.Bool(true),
+			},
+		})
+	} else {
+		err = client.Users.Alter(ctx, sdk.NewAccountObjectIdentifier(attachmentID.User), &sdk.AlterUserOptions{
+			Unset: &sdk.UserUnset{
+				SessionPolicy: sdk.Bool(true),
+			},
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
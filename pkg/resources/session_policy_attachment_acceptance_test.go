@@ -0,0 +1,62 @@
+package resources_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_SessionPolicyAttachment(t *testing.T) {
+	name := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.ParallelTest(t, resource.TestCase{
+		Providers:    providers(),
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: sessionPolicyAttachmentConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_session_policy_attachment.test", "user", name),
+					resource.TestCheckResourceAttrPair("snowflake_session_policy_attachment.test", "session_policy_id", "snowflake_session_policy.test", "id"),
+				),
+			},
+			{
+				ResourceName:      "snowflake_session_policy_attachment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func sessionPolicyAttachmentConfig(name string) string {
+	return fmt.Sprintf(`
+resource "snowflake_database" "test" {
+	name = "%[1]v"
+}
+
+resource "snowflake_schema" "test" {
+	name     = "%[1]v"
+	database = snowflake_database.test.name
+}
+
+resource "snowflake_session_policy" "test" {
+	database                  = snowflake_database.test.name
+	schema                    = snowflake_schema.test.name
+	name                      = "%[1]v"
+	session_idle_timeout_mins = 30
+}
+
+resource "snowflake_user" "test" {
+	name = "%[1]v"
+}
+
+resource "snowflake_session_policy_attachment" "test" {
+	session_policy_id = snowflake_session_policy.test.id
+	user              = snowflake_user.test.name
+}
+`, name)
+}
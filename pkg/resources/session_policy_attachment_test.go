@@ -0,0 +1,77 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionPolicyAttachment(t *testing.T) {
+	r := require.New(t)
+	err := resources.SessionPolicyAttachment().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestSessionPolicyAttachmentCreateOnUser(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"session_policy_id": "test_db|test_schema|test_policy",
+		"user":              "test_user",
+	}
+	d := schema.TestResourceDataRaw(t, resources.SessionPolicyAttachment().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER USER "test_user" SET SESSION POLICY "test_db"."test_schema"."test_policy"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectSessionPolicyReferences(mock, "USER", "test_user")
+		err := resources.CreateSessionPolicyAttachment(d, ProviderContext(db))
+		r.NoError(err)
+		r.Equal("test_db|test_schema|test_policy|USER|test_user", d.Id())
+	})
+}
+
+func TestSessionPolicyAttachmentCreateOnAccount(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"session_policy_id": "test_db|test_schema|test_policy",
+		"set_for_account":   true,
+	}
+	d := schema.TestResourceDataRaw(t, resources.SessionPolicyAttachment().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER ACCOUNT SET SESSION POLICY "test_db"."test_schema"."test_policy"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectSessionPolicyReferences(mock, "ACCOUNT", "TEST_ACCOUNT")
+		err := resources.CreateSessionPolicyAttachment(d, ProviderContext(db))
+		r.NoError(err)
+		r.Equal("test_db|test_schema|test_policy|ACCOUNT", d.Id())
+	})
+}
+
+func TestSessionPolicyAttachmentDeleteOnUser(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.SessionPolicyAttachment().Schema, map[string]interface{}{})
+	d.SetId("test_db|test_schema|test_policy|USER|test_user")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^ALTER USER "test_user" UNSET SESSION POLICY$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		err := resources.DeleteSessionPolicyAttachment(d, ProviderContext(db))
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func expectSessionPolicyReferences(mock sqlmock.Sqlmock, domain string, entity string) {
+	rows := sqlmock.NewRows([]string{"POLICY_DB", "POLICY_SCHEMA", "POLICY_NAME", "POLICY_KIND", "REF_DATABASE_NAME", "REF_SCHEMA_NAME", "REF_ENTITY_NAME", "REF_ENTITY_DOMAIN", "REF_COLUMN_NAME", "REF_ARG_COLUMN_NAMES", "POLICY_STATUS"}).
+		AddRow("test_db", "test_schema", "test_policy", "SESSION_POLICY", nil, nil, entity, domain, nil, nil, "ACTIVE")
+	mock.ExpectQuery(`^SELECT (.+) FROM TABLE\("test_db".INFORMATION_SCHEMA.POLICY_REFERENCES\(POLICY_NAME => '"test_db"."test_schema"."test_policy"'\)\)$`).WillReturnRows(rows)
+}
@@ -0,0 +1,70 @@
+package resources_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionPolicy(t *testing.T) {
+	r := require.New(t)
+	err := resources.SessionPolicy().InternalValidate(provider.Provider().Schema, true)
+	r.NoError(err)
+}
+
+func TestSessionPolicyCreate(t *testing.T) {
+	r := require.New(t)
+
+	in := map[string]interface{}{
+		"database":                     "test_db",
+		"schema":                       "test_schema",
+		"name":                         "test_policy",
+		"session_idle_timeout_mins":    30,
+		"session_ui_idle_timeout_mins": 60,
+		"comment":                      "great comment",
+	}
+	d := schema.TestResourceDataRaw(t, resources.SessionPolicy().Schema, in)
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE SESSION POLICY "test_db"."test_schema"."test_policy" SESSION_IDLE_TIMEOUT_MINS = 30 SESSION_UI_IDLE_TIMEOUT_MINS = 60 COMMENT = 'great comment'$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadSessionPolicy(mock)
+		err := resources.CreateSessionPolicy(d, ProviderContext(db))
+		r.NoError(err)
+		r.Equal("test_db|test_schema|test_policy", d.Id())
+		r.Equal(30, d.Get("session_idle_timeout_mins"))
+		r.Equal(60, d.Get("session_ui_idle_timeout_mins"))
+	})
+}
+
+func TestSessionPolicyReadNotFound(t *testing.T) {
+	r := require.New(t)
+
+	d := schema.TestResourceDataRaw(t, resources.SessionPolicy().Schema, map[string]interface{}{})
+	d.SetId("test_db|test_schema|test_policy")
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{"created_on", "name", "database_name", "schema_name", "kind", "owner", "comment", "owner_role_type", "options"})
+		mock.ExpectQuery(`^SHOW SESSION POLICIES LIKE 'test_policy' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+		err := resources.ReadSessionPolicy(d, ProviderContext(db))
+		r.NoError(err)
+		r.Empty(d.Id())
+	})
+}
+
+func expectReadSessionPolicy(mock sqlmock.Sqlmock) {
+	rows := sqlmock.NewRows([]string{"created_on", "name", "database_name", "schema_name", "kind", "owner", "comment", "owner_role_type", "options"}).
+		AddRow(time.Now(), "test_policy", "test_db", "test_schema", "SESSION_POLICY", "ACCOUNTADMIN", "great comment", "ROLE", "")
+	mock.ExpectQuery(`^SHOW SESSION POLICIES LIKE 'test_policy' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+
+	describeRows := sqlmock.NewRows([]string{"created_on", "name", "session_idle_timeout_mins", "session_ui_idle_timeout_mins", "comment"}).
+		AddRow("2023-09-01 00:00:00.000 -0700", "test_policy", 30, 60, "great comment")
+	mock.ExpectQuery(`^DESCRIBE SESSION POLICY "test_db"."test_schema"."test_policy"$`).WillReturnRows(describeRows)
+}
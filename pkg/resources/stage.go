@@ -9,6 +9,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/snowflakedb/gosnowflake"
@@ -154,7 +155,7 @@ func Stage() *schema.Resource {
 
 // CreateStage implements schema.CreateFunc.
 func CreateStage(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	name := d.Get("name").(string)
 	database := d.Get("database").(string)
 	schema := d.Get("schema").(string)
@@ -222,7 +223,7 @@ func CreateStage(d *schema.ResourceData, meta interface{}) error {
 // ReadStage implements schema.ReadFunc
 // credentials and encryption are omitted, they cannot be read via SHOW or DESCRIBE.
 func ReadStage(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	stageID, err := stageIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -318,7 +319,7 @@ func UpdateStage(d *schema.ResourceData, meta interface{}) error {
 
 	builder := snowflake.NewStageBuilder(stage, dbName, schema)
 
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	if d.HasChange("url") {
 		url := d.Get("url")
 		q := builder.ChangeURL(url.(string))
@@ -382,7 +383,7 @@ func UpdateStage(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteStage implements schema.DeleteFunc.
 func DeleteStage(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	stageID, err := stageIDFromString(d.Id())
 	if err != nil {
 		return err
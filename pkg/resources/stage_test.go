@@ -38,7 +38,7 @@ func TestInternalStageCreate(t *testing.T) {
 
 		expectReadStage(mock)
 		expectReadStageShow(mock)
-		err := resources.CreateStage(d, db)
+		err := resources.CreateStage(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -63,7 +63,7 @@ func TestExternalStageCreate(t *testing.T) {
 
 		expectReadStage(mock)
 		expectReadStageShow(mock)
-		err := resources.CreateStage(d, db)
+		err := resources.CreateStage(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -102,7 +102,7 @@ func TestStageRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.NewStageBuilder("test_stage", "test_db", "test_schema").Describe()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadStage(d, db)
+		err := resources.ReadStage(d, ProviderContext(db))
 		r.Empty(d.State())
 		r.Nil(err)
 	})
@@ -7,6 +7,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -120,7 +121,7 @@ func StorageIntegration() *schema.Resource {
 
 // CreateStorageIntegration implements schema.CreateFunc.
 func CreateStorageIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	name := d.Get("name").(string)
 
 	stmt := snowflake.NewStorageIntegrationBuilder(name).Create()
@@ -159,7 +160,7 @@ func CreateStorageIntegration(d *schema.ResourceData, meta interface{}) error {
 
 // ReadStorageIntegration implements schema.ReadFunc.
 func ReadStorageIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	id := d.Id()
 
 	stmt := snowflake.NewStorageIntegrationBuilder(d.Id()).Show()
@@ -276,7 +277,7 @@ func ReadStorageIntegration(d *schema.ResourceData, meta interface{}) error {
 
 // UpdateStorageIntegration implements schema.UpdateFunc.
 func UpdateStorageIntegration(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	id := d.Id()
 
 	stmt := snowflake.NewStorageIntegrationBuilder(id).Alter()
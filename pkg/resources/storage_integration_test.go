@@ -51,7 +51,7 @@ func TestStorageIntegrationCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadStorageIntegration(mock)
 
-		err := resources.CreateStorageIntegration(d, db)
+		err := resources.CreateStorageIntegration(d, ProviderContext(db))
 		r.NoError(err)
 	})
 
@@ -61,7 +61,7 @@ func TestStorageIntegrationCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadStorageIntegrationWithS3GOV(mock)
 
-		err := resources.CreateStorageIntegration(d2, db)
+		err := resources.CreateStorageIntegration(d2, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -74,7 +74,7 @@ func TestStorageIntegrationRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadStorageIntegration(mock)
 
-		err := resources.ReadStorageIntegration(d, db)
+		err := resources.ReadStorageIntegration(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -87,7 +87,7 @@ func TestStorageIntegrationReadEmpty(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadStorageIntegrationEmpty(mock)
 
-		err := resources.ReadStorageIntegration(d, db)
+		err := resources.ReadStorageIntegration(d, ProviderContext(db))
 		r.Nil(err)
 	})
 }
@@ -118,7 +118,7 @@ func TestStorageIntegrationUpdate(t *testing.T) {
 		mock.ExpectQuery(`^SHOW STORAGE INTEGRATIONS LIKE 'test_storage_integration_acl'$`).WillReturnRows(showRows)
 		mock.ExpectQuery(`DESCRIBE STORAGE INTEGRATION "test_storage_integration_acl"$`).WillReturnRows(descRows)
 
-		err := resources.UpdateStorageIntegration(d, db)
+		err := resources.UpdateStorageIntegration(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -130,7 +130,7 @@ func TestStorageIntegrationDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP STORAGE INTEGRATION "drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteStorageIntegration(d, db)
+		err := resources.DeleteStorageIntegration(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -9,6 +9,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -188,7 +189,7 @@ func streamOnObjectIDFromString(stringID string) (*streamOnObjectID, error) {
 
 // CreateStream implements schema.CreateFunc.
 func CreateStream(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	database := d.Get("database").(string)
 	schema := d.Get("schema").(string)
 	name := d.Get("name").(string)
@@ -282,7 +283,7 @@ func CreateStream(d *schema.ResourceData, meta interface{}) error {
 
 // ReadStream implements schema.ReadFunc.
 func ReadStream(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	streamID, err := streamIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -356,7 +357,7 @@ func ReadStream(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteStream implements schema.DeleteFunc.
 func DeleteStream(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	streamID, err := streamIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -390,7 +391,7 @@ func UpdateStream(d *schema.ResourceData, meta interface{}) error {
 
 	builder := snowflake.Stream(streamName, dbName, schema)
 
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	if d.HasChange("comment") {
 		comment := d.Get("comment")
 		q := builder.ChangeComment(comment.(string))
@@ -37,7 +37,7 @@ func TestStreamGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT SELECT ON STREAM "test-db"."PUBLIC"."test-stream" TO ROLE "test-role-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT SELECT ON STREAM "test-db"."PUBLIC"."test-stream" TO ROLE "test-role-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadStreamGrant(mock)
-		err := resources.CreateStreamGrant(d, db)
+		err := resources.CreateStreamGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -58,7 +58,7 @@ func TestStreamGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadStreamGrant(mock)
-		err := resources.ReadStreamGrant(d, db)
+		err := resources.ReadStreamGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 
@@ -101,7 +101,7 @@ func TestFutureStreamGrantCreate(t *testing.T) {
 			`^GRANT SELECT ON FUTURE STREAMS IN SCHEMA "test-db"."PUBLIC" TO ROLE "test-role-2" WITH GRANT OPTION$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureStreamGrant(mock)
-		err := resources.CreateStreamGrant(d, db)
+		err := resources.CreateStreamGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 
@@ -125,7 +125,7 @@ func TestFutureStreamGrantCreate(t *testing.T) {
 			`^GRANT SELECT ON FUTURE STREAMS IN DATABASE "test-db" TO ROLE "test-role-2"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureStreamDatabaseGrant(mock)
-		err := resources.CreateStreamGrant(d, db)
+		err := resources.CreateStreamGrant(d, ProviderContext(db))
 		b.NoError(err)
 	})
 }
@@ -37,7 +37,7 @@ func TestStreamCreate(t *testing.T) {
 		mock.ExpectExec(`CREATE STREAM "database_name"."schema_name"."stream_name" ON TABLE "target_db"."target_schema"."target_table" COMMENT = 'great comment' APPEND_ONLY = true INSERT_ONLY = false SHOW_INITIAL_ROWS = true`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectStreamRead(mock)
 		expectOnTableRead(mock)
-		err := resources.CreateStream(d, db)
+		err := resources.CreateStream(d, ProviderContext(db))
 		r.NoError(err)
 		r.Equal("stream_name", d.Get("name").(string))
 	})
@@ -62,7 +62,7 @@ func TestStreamCreateOnExternalTable(t *testing.T) {
 		mock.ExpectExec(`CREATE STREAM "database_name"."schema_name"."stream_name" ON EXTERNAL TABLE "target_db"."target_schema"."target_table" COMMENT = 'great comment' APPEND_ONLY = true INSERT_ONLY = false SHOW_INITIAL_ROWS = true`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectStreamRead(mock)
 		expectOnExternalTableRead(mock)
-		err := resources.CreateStream(d, db)
+		err := resources.CreateStream(d, ProviderContext(db))
 		r.NoError(err)
 		r.Equal("stream_name", d.Get("name").(string))
 	})
@@ -87,7 +87,7 @@ func TestStreamCreateOnView(t *testing.T) {
 		mock.ExpectExec(`CREATE STREAM "database_name"."schema_name"."stream_name" ON VIEW "target_db"."target_schema"."target_view" COMMENT = 'great comment' APPEND_ONLY = true INSERT_ONLY = false SHOW_INITIAL_ROWS = true`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectStreamRead(mock)
 		expectOnViewRead(mock)
-		err := resources.CreateStream(d, db)
+		err := resources.CreateStream(d, ProviderContext(db))
 		r.NoError(err)
 		r.Equal("stream_name", d.Get("name").(string))
 	})
@@ -110,7 +110,7 @@ func TestStreamOnMultipleSource(t *testing.T) {
 	d := stream(t, "database_name|schema_name|stream_name", in)
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
-		err := resources.CreateStream(d, db)
+		err := resources.CreateStream(d, ProviderContext(db))
 		r.ErrorContains(err, "all expectations were already fulfilled,")
 	})
 }
@@ -142,7 +142,7 @@ func TestStreamRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectStreamRead(mock)
-		err := resources.ReadStream(d, db)
+		err := resources.ReadStream(d, ProviderContext(db))
 		r.NoError(err)
 		r.Equal("stream_name", d.Get("name").(string))
 		r.Equal("database_name", d.Get("database").(string))
@@ -153,7 +153,7 @@ func TestStreamRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.Stream("stream_name", "database_name", "schema_name").Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err2 := resources.ReadStream(d, db)
+		err2 := resources.ReadStream(d, ProviderContext(db))
 		r.Empty(d.State())
 		r.Nil(err2)
 	})
@@ -167,7 +167,7 @@ func TestStreamReadAppendOnlyMode(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		rows := sqlmock.NewRows([]string{"name", "database_name", "schema_name", "owner", "comment", "table_name", "type", "stale", "mode"}).AddRow("stream_name", "database_name", "schema_name", "owner_name", "grand comment", "target_table", "DELTA", false, "APPEND_ONLY")
 		mock.ExpectQuery(`SHOW STREAMS LIKE 'stream_name' IN SCHEMA "database_name"."schema_name"`).WillReturnRows(rows)
-		err := resources.ReadStream(d, db)
+		err := resources.ReadStream(d, ProviderContext(db))
 		r.NoError(err)
 		r.Equal(true, d.Get("append_only").(bool))
 	})
@@ -181,7 +181,7 @@ func TestStreamReadInsertOnlyMode(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		rows := sqlmock.NewRows([]string{"name", "database_name", "schema_name", "owner", "comment", "table_name", "type", "stale", "mode"}).AddRow("stream_name", "database_name", "schema_name", "owner_name", "grand comment", "target_table", "DELTA", false, "INSERT_ONLY")
 		mock.ExpectQuery(`SHOW STREAMS LIKE 'stream_name' IN SCHEMA "database_name"."schema_name"`).WillReturnRows(rows)
-		err := resources.ReadStream(d, db)
+		err := resources.ReadStream(d, ProviderContext(db))
 		r.NoError(err)
 		r.Equal(true, d.Get("insert_only").(bool))
 	})
@@ -195,7 +195,7 @@ func TestStreamReadDefaultMode(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		rows := sqlmock.NewRows([]string{"name", "database_name", "schema_name", "owner", "comment", "table_name", "type", "stale", "mode"}).AddRow("stream_name", "database_name", "schema_name", "owner_name", "grand comment", "target_table", "DELTA", false, "DEFAULT")
 		mock.ExpectQuery(`SHOW STREAMS LIKE 'stream_name' IN SCHEMA "database_name"."schema_name"`).WillReturnRows(rows)
-		err := resources.ReadStream(d, db)
+		err := resources.ReadStream(d, ProviderContext(db))
 		r.NoError(err)
 		r.Equal(false, d.Get("append_only").(bool))
 	})
@@ -208,7 +208,7 @@ func TestStreamDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP STREAM "database_name"."schema_name"."drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteStream(d, db)
+		err := resources.DeleteStream(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -231,7 +231,7 @@ func TestStreamUpdate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`ALTER STREAM "database_name"."schema_name"."stream_name" SET COMMENT = 'new stream comment'`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectStreamRead(mock)
-		err := resources.UpdateStream(d, db)
+		err := resources.UpdateStream(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -9,6 +9,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -485,7 +486,7 @@ func (pk primarykey) toSnowflakePrimaryKey() snowflake.PrimaryKey {
 
 // CreateTable implements schema.CreateFunc.
 func CreateTable(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	database := d.Get("database").(string)
 	schema := d.Get("schema").(string)
 	name := d.Get("name").(string)
@@ -542,7 +543,7 @@ func CreateTable(d *schema.ResourceData, meta interface{}) error {
 
 // ReadTable implements schema.ReadFunc.
 func ReadTable(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	tableID, err := tableIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -620,7 +621,7 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 
 	builder := snowflake.NewTableBuilder(tableName, dbName, schema)
 
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	if d.HasChange("name") {
 		name := d.Get("name")
 		q := builder.Rename(name.(string))
@@ -769,7 +770,7 @@ func UpdateTable(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteTable implements schema.DeleteFunc.
 func DeleteTable(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	tableID, err := tableIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -1,11 +1,12 @@
 package resources
 
 import (
-	"database/sql"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -204,7 +205,7 @@ func (v *tableConstraintID) parse(s string) {
 
 // CreateTableConstraint implements schema.CreateFunc.
 func CreateTableConstraint(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	name := d.Get("name").(string)
 	constraintType := d.Get("type").(string)
 	tableID := d.Get("table_id").(string)
@@ -291,7 +292,7 @@ func ReadTableConstraint(_ *schema.ResourceData, _ interface{}) error {
 	// also it takes a while for the database to reflect changes. Would likely need to add a validation
 	// step like in tag association. People don't like waiting 40 minutes for Terraform to run.
 
-	/*db := meta.(*sql.DB)
+	/*db := meta.(*provider.Context).DB
 	tc := tableConstraintID{}
 	tc.parse(d.Id())
 	databaseName, schemaName, tableName := snowflakeValidation.ParseFullyQualifiedObjectID(tc.tableID)
@@ -307,7 +308,7 @@ func ReadTableConstraint(_ *schema.ResourceData, _ interface{}) error {
 
 // UpdateTableConstraint implements schema.UpdateFunc.
 func UpdateTableConstraint(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	tc := tableConstraintID{}
 	tc.parse(d.Id())
 	formattedTableID := snowflakeValidation.ParseAndFormatFullyQualifiedObectID(tc.tableID)
@@ -336,7 +337,7 @@ func UpdateTableConstraint(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteTableConstraint implements schema.DeleteFunc.
 func DeleteTableConstraint(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	tc := tableConstraintID{}
 	tc.parse(d.Id())
 	formattedTableID := snowflakeValidation.ParseAndFormatFullyQualifiedObectID(tc.tableID)
@@ -352,7 +353,7 @@ func DeleteTableConstraint(d *schema.ResourceData, meta interface{}) error {
 	_, err := db.Exec(stmt)
 	if err != nil {
 		// if the table constraint does not exist, then remove from state file
-		if strings.Contains(err.Error(), "does not exist") {
+		if sdk.IsNotFound(err) {
 			d.SetId("")
 			return nil
 		}
@@ -42,7 +42,7 @@ func TestTableGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT SELECT ON TABLE "test-db"."PUBLIC"."test-table" TO SHARE "test-share-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT SELECT ON TABLE "test-db"."PUBLIC"."test-table" TO SHARE "test-share-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadTableGrant(mock)
-		err := resources.CreateTableGrant(d, db)
+		err := resources.CreateTableGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -68,7 +68,7 @@ func TestTableGrantUpdate(t *testing.T) {
 		mock.ExpectExec(`^GRANT SELECT ON TABLE "test-db"."PUBLIC"."test-table" TO SHARE "test-share-2"`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadTableGrant(mock)
 
-		err := resources.UpdateTableGrant(d, db)
+		err := resources.UpdateTableGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -90,7 +90,7 @@ func TestTableGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadTableGrant(mock)
-		err := resources.ReadTableGrant(d, db)
+		err := resources.ReadTableGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 
@@ -105,6 +105,118 @@ func TestTableGrantRead(t *testing.T) {
 	r.Equal(2, shares.Len())
 }
 
+func TestAllTableGrantRead(t *testing.T) {
+	r := require.New(t)
+
+	d := tableGrant(t, "test-db|PUBLIC||SELECT|false|false|true|test-role-1,test-role-2|", map[string]interface{}{
+		"on_all":            true,
+		"schema_name":       "PUBLIC",
+		"database_name":     "test-db",
+		"privilege":         "SELECT",
+		"roles":             []interface{}{"test-role-1", "test-role-2"},
+		"with_grant_option": false,
+	})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectShowAllTables(mock)
+		mock.ExpectQuery(`^SHOW GRANTS TO ROLE "test-role-1"$`).WillReturnRows(
+			sqlmock.NewRows([]string{
+				"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+			}).AddRow(
+				time.Now(), "SELECT", "TABLE", "test-db.PUBLIC.table-1", "ROLE", "test-role-1", false, "bob",
+			).AddRow(
+				time.Now(), "SELECT", "TABLE", `"test-db"."PUBLIC"."table-2"`, "ROLE", "test-role-1", false, "bob",
+			),
+		)
+		// test-role-2 is missing the privilege on table-2, e.g. because the table was created after the grant
+		mock.ExpectQuery(`^SHOW GRANTS TO ROLE "test-role-2"$`).WillReturnRows(
+			sqlmock.NewRows([]string{
+				"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+			}).AddRow(
+				time.Now(), "SELECT", "TABLE", "test-db.PUBLIC.table-1", "ROLE", "test-role-2", false, "bob",
+			),
+		)
+		err := resources.ReadTableGrant(d, ProviderContext(db))
+		r.NoError(err)
+	})
+
+	roles := d.Get("roles").(*schema.Set)
+	r.True(roles.Contains("test-role-1"))
+	r.Equal(1, roles.Len())
+}
+
+func TestAllTableGrantReadNoObjects(t *testing.T) {
+	r := require.New(t)
+
+	d := tableGrant(t, "test-db|PUBLIC||SELECT|false|false|true|test-role-1|", map[string]interface{}{
+		"on_all":            true,
+		"schema_name":       "PUBLIC",
+		"database_name":     "test-db",
+		"privilege":         "SELECT",
+		"roles":             []interface{}{"test-role-1"},
+		"with_grant_option": false,
+	})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`^SHOW TABLES IN SCHEMA "test-db"."PUBLIC"$`).WillReturnRows(
+			sqlmock.NewRows([]string{"created_on", "name", "database_name", "schema_name"}),
+		)
+		err := resources.ReadTableGrant(d, ProviderContext(db))
+		r.NoError(err)
+	})
+
+	roles := d.Get("roles").(*schema.Set)
+	r.True(roles.Contains("test-role-1"))
+	r.Equal(1, roles.Len())
+}
+
+func TestTableGrantReadAllPrivileges(t *testing.T) {
+	r := require.New(t)
+
+	d := tableGrant(t, "test-db|PUBLIC|test-table|ALL PRIVILEGES|false|false|false|test-role-1,test-role-2|", map[string]interface{}{
+		"table_name":        "test-table",
+		"schema_name":       "PUBLIC",
+		"database_name":     "test-db",
+		"privilege":         "ALL PRIVILEGES",
+		"roles":             []interface{}{"test-role-1", "test-role-2"},
+		"with_grant_option": false,
+	})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		rows := sqlmock.NewRows([]string{
+			"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
+		})
+		for _, privilege := range []string{"SELECT", "INSERT", "UPDATE", "DELETE", "TRUNCATE", "REFERENCES"} {
+			rows.AddRow(time.Now(), privilege, "TABLE", "test-table", "ROLE", "test-role-1", false, "bob")
+		}
+		// test-role-2 had DELETE revoked outside of terraform
+		for _, privilege := range []string{"SELECT", "INSERT", "UPDATE", "TRUNCATE", "REFERENCES"} {
+			rows.AddRow(time.Now(), privilege, "TABLE", "test-table", "ROLE", "test-role-2", false, "bob")
+		}
+		mock.ExpectQuery(`^SHOW GRANTS ON TABLE "test-db"."PUBLIC"."test-table"$`).WillReturnRows(rows)
+		err := resources.ReadTableGrant(d, ProviderContext(db))
+		r.NoError(err)
+	})
+
+	roles := d.Get("roles").(*schema.Set)
+	r.True(roles.Contains("test-role-1"))
+	r.Equal(1, roles.Len())
+}
+
+func expectShowAllTables(mock sqlmock.Sqlmock) {
+	rows := sqlmock.NewRows([]string{
+		"created_on", "name", "database_name", "schema_name",
+	}).AddRow(
+		time.Now(), "table-1", "test-db", "PUBLIC",
+	).AddRow(
+		time.Now(), "table-2", "test-db", "PUBLIC",
+	)
+	mock.ExpectQuery(`^SHOW TABLES IN SCHEMA "test-db"."PUBLIC"$`).WillReturnRows(rows)
+}
+
 func expectReadTableGrant(mock sqlmock.Sqlmock) {
 	rows := sqlmock.NewRows([]string{
 		"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by",
@@ -142,7 +254,7 @@ func TestFutureTableGrantCreate(t *testing.T) {
 			`^GRANT SELECT ON FUTURE TABLES IN SCHEMA "test-db"."PUBLIC" TO ROLE "test-role-2" WITH GRANT OPTION$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureTableGrant(mock)
-		err := resources.CreateTableGrant(d, db)
+		err := resources.CreateTableGrant(d, ProviderContext(db))
 		roles := d.Get("roles").(*schema.Set)
 		// After the CreateTableGrant has been created a ReadTableGrant reads the current grants
 		// and this read should ignore test-role-3 what is returned by SHOW FUTURE GRANTS ON SCHEMA PUBLIC because
@@ -173,7 +285,7 @@ func TestFutureTableGrantCreate(t *testing.T) {
 			`^GRANT SELECT ON FUTURE TABLES IN DATABASE "test-db" TO ROLE "test-role-2"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureTableDatabaseGrant(mock)
-		err := resources.CreateTableGrant(d, db)
+		err := resources.CreateTableGrant(d, ProviderContext(db))
 		b.NoError(err)
 	})
 }
@@ -65,7 +65,7 @@ func TestTableCreate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`CREATE TABLE "database_name"."schema_name"."good_name" \("column1" OBJECT COMMENT '', "column2" VARCHAR NOT NULL COMMENT '', "column3" NUMBER\(38,0\) COMMENT 'some comment', "column4" VARCHAR WITH MASKING POLICY TEST_MP COMMENT '', "column5" VARCHAR NOT NULL DEFAULT 'hello' COMMENT '' ,CONSTRAINT "MY_KEY" PRIMARY KEY\("column1"\)\) COMMENT = 'great comment' DATA_RETENTION_TIME_IN_DAYS = 1 CHANGE_TRACKING = false`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectTableRead(mock)
-		err := resources.CreateTable(d, db)
+		err := resources.CreateTable(d, ProviderContext(db))
 		r.NoError(err)
 		r.Equal("good_name", d.Get("name").(string))
 		columns := d.Get("column").([]interface{})
@@ -121,7 +121,7 @@ func TestTableRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectTableRead(mock)
 
-		err := resources.ReadTable(d, db)
+		err := resources.ReadTable(d, ProviderContext(db))
 		r.NoError(err)
 		r.Equal("good_name", d.Get("name").(string))
 		r.Equal("mock comment", d.Get("comment").(string))
@@ -130,7 +130,7 @@ func TestTableRead(t *testing.T) {
 		r.NotEmpty(d.State())
 		q := snowflake.NewTableBuilder("good_name", "database_name", "schema_name").Show()
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err2 := resources.ReadTable(d, db)
+		err2 := resources.ReadTable(d, ProviderContext(db))
 		r.Empty(d.State())
 		r.Nil(err2)
 	})
@@ -143,7 +143,7 @@ func TestTableDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`DROP TABLE "database_name"."schema_name"."drop_it"`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteTable(d, db)
+		err := resources.DeleteTable(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"encoding/csv"
 	"errors"
@@ -10,8 +11,10 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/exp/slices"
 
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 )
 
@@ -177,28 +180,26 @@ func Tag() *schema.Resource {
 	}
 }
 
-// CreateSchema implements schema.CreateFunc.
+// CreateTag implements schema.CreateFunc.
 func CreateTag(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
 	name := d.Get("name").(string)
 	database := d.Get("database").(string)
 	schema := d.Get("schema").(string)
+	id := sdk.NewSchemaObjectIdentifier(database, schema, name)
 
-	builder := snowflake.NewTagBuilder(name).WithDB(database).WithSchema(schema)
-
-	// Set optionals
+	opts := &sdk.TagCreateOptions{}
 	if v, ok := d.GetOk("comment"); ok {
-		builder.WithComment(v.(string))
+		opts.Comment = sdk.String(v.(string))
 	}
-
 	if v, ok := d.GetOk("allowed_values"); ok {
-		builder.WithAllowedValues(expandStringList(v.([]interface{})))
+		opts.AllowedValues = toSDKAllowedValues(expandAllowedValues(v))
 	}
 
-	q := builder.Create()
-
-	if err := snowflake.Exec(db, q); err != nil {
-		return fmt.Errorf("error creating tag %v", name)
+	if err := client.Tags.Create(ctx, id, opts); err != nil {
+		return fmt.Errorf("error creating tag %v err = %w", name, err)
 	}
 
 	tagID := &TagID{
@@ -215,23 +216,19 @@ func CreateTag(d *schema.ResourceData, meta interface{}) error {
 	return ReadTag(d, meta)
 }
 
-// ReadSchema implements schema.ReadFunc.
+// ReadTag implements schema.ReadFunc.
 func ReadTag(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
 	tagID, err := tagIDFromString(d.Id())
 	if err != nil {
 		return err
 	}
+	id := sdk.NewSchemaObjectIdentifier(tagID.DatabaseName, tagID.SchemaName, tagID.TagName)
 
-	dbName := tagID.DatabaseName
-	schemaName := tagID.SchemaName
-	tag := tagID.TagName
-
-	q := snowflake.NewTagBuilder(tag).WithDB(dbName).WithSchema(schemaName).Show()
-	row := snowflake.QueryRow(db, q)
-
-	t, err := snowflake.ScanTag(row)
-	if errors.Is(err, sql.ErrNoRows) {
+	t, err := client.Tags.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
 		// If not found, mark resource to be removed from state file during apply or refresh
 		log.Printf("[DEBUG] tag (%s) not found", d.Id())
 		d.SetId("")
@@ -241,77 +238,75 @@ func ReadTag(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	if err := d.Set("name", t.Name.String); err != nil {
+	if err := d.Set("name", t.Name); err != nil {
 		return err
 	}
 
-	if err := d.Set("database", t.DatabaseName.String); err != nil {
+	if err := d.Set("database", t.DatabaseName); err != nil {
 		return err
 	}
 
-	if err := d.Set("schema", t.SchemaName.String); err != nil {
+	if err := d.Set("schema", t.SchemaName); err != nil {
 		return err
 	}
 
-	if err := d.Set("comment", t.Comment.String); err != nil {
+	if err := d.Set("comment", t.Comment); err != nil {
 		return err
 	}
 
-	av := strings.ReplaceAll(t.AllowedValues.String, "\"", "")
-	av = strings.TrimPrefix(av, "[")
-	av = strings.TrimSuffix(av, "]")
-	err = d.Set("allowed_values", helpers.StringListToList(av))
-	return err
+	return d.Set("allowed_values", t.AllowedValues)
 }
 
 // UpdateTag implements schema.UpdateFunc.
 func UpdateTag(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
 	tagID, err := tagIDFromString(d.Id())
 	if err != nil {
 		return err
 	}
+	id := sdk.NewSchemaObjectIdentifier(tagID.DatabaseName, tagID.SchemaName, tagID.TagName)
 
-	dbName := tagID.DatabaseName
-	schemaName := tagID.SchemaName
-	tag := tagID.TagName
-
-	builder := snowflake.NewTagBuilder(tag).WithDB(dbName).WithSchema(schemaName)
-
-	db := meta.(*sql.DB)
 	if d.HasChange("comment") {
-		comment, ok := d.GetOk("comment")
-		var q string
-		if ok {
-			q = builder.ChangeComment(comment.(string))
+		opts := &sdk.TagAlterOptions{}
+		if comment, ok := d.GetOk("comment"); ok {
+			opts.Set = &sdk.TagSet{Comment: sdk.String(comment.(string))}
 		} else {
-			q = builder.RemoveComment()
+			opts.Unset = &sdk.TagUnset{Comment: sdk.Bool(true)}
 		}
-		if err := snowflake.Exec(db, q); err != nil {
-			return fmt.Errorf("error updating tag comment on %v", d.Id())
+		if err := client.Tags.Alter(ctx, id, opts); err != nil {
+			return fmt.Errorf("error updating tag comment on %v err = %w", d.Id(), err)
 		}
 	}
 
-	// If there is change in allowed_values field
 	if d.HasChange("allowed_values") {
-		if _, ok := d.GetOk("allowed_values"); ok {
-			v := d.Get("allowed_values")
-
-			ns := expandAllowedValues(v)
-
-			q := builder.RemoveAllowedValues()
-
-			if err := snowflake.Exec(db, q); err != nil {
-				return fmt.Errorf("error removing ALLOWED_VALUES for tag %v", tag)
-			}
-
-			addQuery := builder.AddAllowedValues(ns)
-			if err := snowflake.Exec(db, addQuery); err != nil {
-				return fmt.Errorf("error adding ALLOWED_VALUES for tag %v", tag)
+		o, n := d.GetChange("allowed_values")
+		oldValues, newValues := expandAllowedValues(o), expandAllowedValues(n)
+		if len(newValues) == 0 {
+			err := client.Tags.Alter(ctx, id, &sdk.TagAlterOptions{
+				Unset: &sdk.TagUnset{AllowedValues: sdk.Bool(true)},
+			})
+			if err != nil {
+				return fmt.Errorf("error removing ALLOWED_VALUES for tag %v err = %w", d.Id(), err)
 			}
 		} else {
-			q := builder.RemoveAllowedValues()
-			if err := snowflake.Exec(db, q); err != nil {
-				return fmt.Errorf("error removing ALLOWED_VALUES for tag %v", tag)
+			removed, added := diffAllowedValues(oldValues, newValues)
+			if len(removed) > 0 {
+				err := client.Tags.Alter(ctx, id, &sdk.TagAlterOptions{
+					Drop: &sdk.TagDrop{AllowedValues: toSDKAllowedValues(removed)},
+				})
+				if err != nil {
+					return fmt.Errorf("error dropping ALLOWED_VALUES for tag %v err = %w", d.Id(), err)
+				}
+			}
+			if len(added) > 0 {
+				err := client.Tags.Alter(ctx, id, &sdk.TagAlterOptions{
+					Add: &sdk.TagAdd{AllowedValues: toSDKAllowedValues(added)},
+				})
+				if err != nil {
+					return fmt.Errorf("error adding ALLOWED_VALUES for tag %v err = %w", d.Id(), err)
+				}
 			}
 		}
 	}
@@ -330,21 +325,41 @@ func expandAllowedValues(avChangeSet interface{}) []string {
 	return newAvs
 }
 
+// diffAllowedValues returns the values present only in old (removed) and only in new (added).
+func diffAllowedValues(oldValues, newValues []string) (removed []string, added []string) {
+	for _, v := range oldValues {
+		if !slices.Contains(newValues, v) {
+			removed = append(removed, v)
+		}
+	}
+	for _, v := range newValues {
+		if !slices.Contains(oldValues, v) {
+			added = append(added, v)
+		}
+	}
+	return removed, added
+}
+
+func toSDKAllowedValues(values []string) []sdk.AllowedValue {
+	allowedValues := make([]sdk.AllowedValue, len(values))
+	for i, v := range values {
+		allowedValues[i] = sdk.AllowedValue{Value: v}
+	}
+	return allowedValues
+}
+
 // DeleteTag implements schema.DeleteFunc.
 func DeleteTag(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
 	tagID, err := tagIDFromString(d.Id())
 	if err != nil {
 		return err
 	}
+	id := sdk.NewSchemaObjectIdentifier(tagID.DatabaseName, tagID.SchemaName, tagID.TagName)
 
-	dbName := tagID.DatabaseName
-	schemaName := tagID.SchemaName
-	tag := tagID.TagName
-
-	q := snowflake.NewTagBuilder(tag).WithDB(dbName).WithSchema(schemaName).Drop()
-
-	if err := snowflake.Exec(db, q); err != nil {
+	if err := client.Tags.Drop(ctx, id, nil); err != nil {
 		return fmt.Errorf("error deleting tag %v err = %w", d.Id(), err)
 	}
 
@@ -443,3 +458,29 @@ func getTags(from interface{}) (to tags) {
 	}
 	return to
 }
+
+// toSDKTagAssociations converts the tag references of an object to the tags set with the SDK, e.g. in CREATE or
+// ALTER ... SET TAG.
+func (t tags) toSDKTagAssociations() []sdk.TagAssociation {
+	associations := make([]sdk.TagAssociation, len(t))
+	for i, tag := range t {
+		associations[i] = sdk.TagAssociation{
+			Name:  tag.toSDKObjectIdentifier(),
+			Value: tag.value,
+		}
+	}
+	return associations
+}
+
+// toSDKObjectIdentifiers converts the tag references of an object to the tags unset with the SDK.
+func (t tags) toSDKObjectIdentifiers() []sdk.ObjectIdentifier {
+	ids := make([]sdk.ObjectIdentifier, len(t))
+	for i, tag := range t {
+		ids[i] = tag.toSDKObjectIdentifier()
+	}
+	return ids
+}
+
+func (t tag) toSDKObjectIdentifier() sdk.ObjectIdentifier {
+	return sdk.NewSchemaObjectIdentifier(t.database, t.schema, t.name)
+}
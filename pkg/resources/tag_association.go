@@ -2,17 +2,18 @@ package resources
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
 )
 
@@ -102,36 +103,76 @@ func TagAssociation() *schema.Resource {
 	}
 }
 
+// tagAssociationObjectIdentifier builds the identifier of the tagged object from the
+// object_identifier block. Columns are given as name = "table.column".
+func tagAssociationObjectIdentifier(objectType sdk.ObjectType, objectDatabase, objectSchema, objectName string) (sdk.ObjectIdentifier, error) {
+	if objectType == sdk.ObjectTypeColumn {
+		parts := strings.Split(objectName, ".")
+		if len(parts) != 2 || objectDatabase == "" || objectSchema == "" {
+			return nil, fmt.Errorf("column tag associations require database, schema and a name in the format table.column, got %v", objectName)
+		}
+		return sdk.NewTableColumnIdentifier(objectDatabase, objectSchema, parts[0], parts[1]), nil
+	}
+	switch {
+	case objectDatabase == "" && objectSchema == "":
+		return sdk.NewAccountObjectIdentifier(objectName), nil
+	case objectSchema == "":
+		return sdk.NewSchemaIdentifier(objectDatabase, objectName), nil
+	default:
+		return sdk.NewSchemaObjectIdentifier(objectDatabase, objectSchema, objectName), nil
+	}
+}
+
+func tagAssociationIdentifiers(d *schema.ResourceData) (sdk.SchemaObjectIdentifier, sdk.ObjectType, sdk.ObjectIdentifier, error) {
+	tagDatabase, tagSchema, tagName := snowflakeValidation.ParseFullyQualifiedObjectID(d.Get("tag_id").(string))
+	tagID := sdk.NewSchemaObjectIdentifier(tagDatabase, tagSchema, tagName)
+	objectType := sdk.ObjectType(strings.ToUpper(d.Get("object_type").(string)))
+	objectDatabase, objectSchema, objectName := expandObjectIdentifier(d.Get("object_identifier"))
+	objectID, err := tagAssociationObjectIdentifier(objectType, objectDatabase, objectSchema, objectName)
+	if err != nil {
+		return sdk.SchemaObjectIdentifier{}, "", nil, err
+	}
+	return tagID, objectType, objectID, nil
+}
+
 // CreateTagAssociation implements schema.CreateFunc.
 func CreateTagAssociation(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	tagID := d.Get("tag_id").(string)
-	objectType := d.Get("object_type").(string)
-	tagValue := d.Get("tag_value").(string)
-	objectDatabase, objectSchema, objectName := expandObjectIdentifier(d.Get("object_identifier"))
-	fullyQualifierObjectIdentifier := snowflakeValidation.FormatFullyQualifiedObjectID(objectDatabase, objectSchema, objectName)
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
 
-	builder := snowflake.NewTagAssociationBuilder(tagID).WithObjectIdentifier(fullyQualifierObjectIdentifier).WithObjectType(objectType).WithTagValue(tagValue)
+	tagValue := d.Get("tag_value").(string)
+	tagID, objectType, objectID, err := tagAssociationIdentifiers(d)
+	if err != nil {
+		return err
+	}
 
-	q := builder.Create()
-	if err := snowflake.Exec(db, q); err != nil {
-		return fmt.Errorf("error associating tag to object: [%v] with command: [%v], tag_id [%v]", fullyQualifierObjectIdentifier, q, tagID)
+	err = client.Tags.Set(ctx, &sdk.SetTagOptions{
+		ObjectType: objectType,
+		ObjectName: objectID,
+		SetTags: []sdk.TagAssociation{
+			{Name: tagID, Value: tagValue},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error associating tag to object: [%v], tag_id [%v] err = %w", objectID.FullyQualifiedName(), tagID.FullyQualifiedName(), err)
 	}
 
 	skipValidate := d.Get("skip_validation").(bool)
 	if !skipValidate {
 		log.Println("[DEBUG] validating tag creation")
 
-		if err := retry.RetryContext(context.Background(), d.Timeout(schema.TimeoutCreate)-time.Minute, func() *retry.RetryError {
-			resp, err := snowflake.ListTagAssociations(builder, db)
+		if err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate)-time.Minute, func() *retry.RetryError {
+			_, err := client.SystemFunctions.GetTag(ctx, tagID, objectID, objectType)
+			// if the tag is not set yet, retry for up to 70 minutes
+			if errors.Is(err, sdk.ErrTagNotSet) {
+				return retry.RetryableError(fmt.Errorf("expected tag association to be created but not yet created"))
+			}
+			if sdk.IsRetryable(err) {
+				return retry.RetryableError(err)
+			}
 			if err != nil {
 				return retry.NonRetryableError(fmt.Errorf("error: %w", err))
 			}
-
-			// if length of response is zero, tag association was not found. retry for up to 70 minutes
-			if len(resp) == 0 {
-				return retry.RetryableError(fmt.Errorf("expected tag association to be created but not yet created"))
-			}
 			return nil
 		}); err != nil {
 			return fmt.Errorf("error validating tag association")
@@ -139,9 +180,9 @@ func CreateTagAssociation(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	t := &TagID{
-		DatabaseName: builder.GetTagDatabase(),
-		SchemaName:   builder.GetTagSchema(),
-		TagName:      builder.GetTagName(),
+		DatabaseName: tagID.DatabaseName(),
+		SchemaName:   tagID.SchemaName(),
+		TagName:      tagID.Name(),
 	}
 	dataIDInput, err := t.String()
 	if err != nil {
@@ -153,43 +194,39 @@ func CreateTagAssociation(d *schema.ResourceData, meta interface{}) error {
 
 // ReadTagAssociation implements schema.ReadFunc.
 func ReadTagAssociation(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-
-	tagID := d.Get("tag_id").(string)
-	objectType := d.Get("object_type").(string)
-	objectDatabase, objectSchema, objectName := expandObjectIdentifier(d.Get("object_identifier"))
-	fullyQualifierObjectIdentifier := snowflakeValidation.FormatFullyQualifiedObjectID(objectDatabase, objectSchema, objectName)
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
 
-	q := snowflake.NewTagAssociationBuilder(tagID).WithObjectIdentifier(fullyQualifierObjectIdentifier).WithObjectType(objectType).Show()
-	row := snowflake.QueryRow(db, q)
+	tagID, objectType, objectID, err := tagAssociationIdentifiers(d)
+	if err != nil {
+		return err
+	}
 
-	ta, err := snowflake.ScanTagAssociation(row)
-	if errors.Is(err, sql.ErrNoRows) {
+	tagValue, err := client.SystemFunctions.GetTag(ctx, tagID, objectID, objectType)
+	if errors.Is(err, sdk.ErrTagNotSet) || errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
 		// If not found, mark resource to be removed from state file during apply or refresh
 		log.Printf("[DEBUG] tag association (%s) not found", d.Id())
 		d.SetId("")
 		return nil
 	}
 	if err != nil {
-		// return err
-		return fmt.Errorf("error listing tag associations")
+		return fmt.Errorf("error listing tag associations err = %w", err)
 	}
 
-	if err := d.Set("tag_value", ta.TagValue.String); err != nil {
+	if err := d.Set("tag_value", tagValue); err != nil {
 		return err
 	}
 	return nil
 }
 
 func UpdateTagAssociation(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-
-	tagID := d.Get("tag_id").(string)
-	objectType := d.Get("object_type").(string)
-	objectDatabase, objectSchema, objectName := expandObjectIdentifier(d.Get("object_identifier"))
-	fullyQualifierObjectIdentifier := snowflakeValidation.FormatFullyQualifiedObjectID(objectDatabase, objectSchema, objectName)
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
 
-	builder := snowflake.NewTagAssociationBuilder(tagID).WithObjectIdentifier(fullyQualifierObjectIdentifier).WithObjectType(objectType)
+	tagID, objectType, objectID, err := tagAssociationIdentifiers(d)
+	if err != nil {
+		return err
+	}
 
 	if d.HasChange("skip_validation") {
 		o, n := d.GetChange("skip_validation")
@@ -197,16 +234,15 @@ func UpdateTagAssociation(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("tag_value") {
-		tagValue, ok := d.GetOk("tag_value")
-		var q string
-		if ok {
-			q = builder.WithTagValue(tagValue.(string)).Create()
-		} else {
-			q = builder.WithTagValue("").Create()
-		}
-		err := snowflake.Exec(db, q)
+		err := client.Tags.Set(ctx, &sdk.SetTagOptions{
+			ObjectType: objectType,
+			ObjectName: objectID,
+			SetTags: []sdk.TagAssociation{
+				{Name: tagID, Value: d.Get("tag_value").(string)},
+			},
+		})
 		if err != nil {
-			return fmt.Errorf("error updating tag association value for object [%v]", fullyQualifierObjectIdentifier)
+			return fmt.Errorf("error updating tag association value for object [%v] err = %w", objectID.FullyQualifiedName(), err)
 		}
 	}
 
@@ -215,16 +251,21 @@ func UpdateTagAssociation(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteTagAssociation implements schema.DeleteFunc.
 func DeleteTagAssociation(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
 
-	tagID := d.Get("tag_id").(string)
-	objectType := d.Get("object_type").(string)
-	objectDatabase, objectSchema, objectName := expandObjectIdentifier(d.Get("object_identifier"))
-	fullyQualifierObjectIdentifier := snowflakeValidation.FormatFullyQualifiedObjectID(objectDatabase, objectSchema, objectName)
-	q := snowflake.NewTagAssociationBuilder(tagID).WithObjectIdentifier(fullyQualifierObjectIdentifier).WithObjectType(objectType).Drop()
+	tagID, objectType, objectID, err := tagAssociationIdentifiers(d)
+	if err != nil {
+		return err
+	}
 
-	if err := snowflake.Exec(db, q); err != nil {
-		return fmt.Errorf("error deleting tag association for object id [%s]: %w", tagID, err)
+	err = client.Tags.Unset(ctx, &sdk.UnsetTagOptions{
+		ObjectType: objectType,
+		ObjectName: objectID,
+		UnsetTags:  []sdk.ObjectIdentifier{tagID},
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting tag association for object id [%s]: %w", tagID.FullyQualifiedName(), err)
 	}
 
 	d.SetId("")
@@ -0,0 +1,27 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+func TestTagsToSDK(t *testing.T) {
+	r := require.New(t)
+	in := tags{
+		{name: "cost_center", value: "finance", database: "test_db", schema: "test_schema"},
+		{name: "owner", value: "", database: "test_db", schema: "other_schema"},
+	}
+
+	r.Equal([]sdk.TagAssociation{
+		{Name: sdk.NewSchemaObjectIdentifier("test_db", "test_schema", "cost_center"), Value: "finance"},
+		{Name: sdk.NewSchemaObjectIdentifier("test_db", "other_schema", "owner"), Value: ""},
+	}, in.toSDKTagAssociations())
+	r.Equal([]sdk.ObjectIdentifier{
+		sdk.NewSchemaObjectIdentifier("test_db", "test_schema", "cost_center"),
+		sdk.NewSchemaObjectIdentifier("test_db", "other_schema", "owner"),
+	}, in.toSDKObjectIdentifiers())
+	r.Empty(tags{}.toSDKTagAssociations())
+}
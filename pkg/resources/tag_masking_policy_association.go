@@ -3,7 +3,6 @@ package resources
 import (
 	"bytes"
 	"context"
-	"database/sql"
 	"encoding/csv"
 	"errors"
 	"fmt"
@@ -13,8 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	snowflakeValidation "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/validation"
 )
 
@@ -106,38 +105,35 @@ func TagMaskingPolicyAssociation() *schema.Resource {
 
 // CreateTagMaskingPolicyAssociation implements schema.CreateFunc.
 func CreateTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
 	tagID := d.Get("tag_id").(string)
 	tagIDStruct, idErr := tagIDFromString(tagID)
 	if idErr != nil {
 		return idErr
 	}
-	tagDB := tagIDStruct.DatabaseName
-	tagSchema := tagIDStruct.SchemaName
-	tagName := tagIDStruct.TagName
+	tagObjectID := sdk.NewSchemaObjectIdentifier(tagIDStruct.DatabaseName, tagIDStruct.SchemaName, tagIDStruct.TagName)
 
 	mpID := d.Get("masking_policy_id").(string)
-	mpIDStruct := helpers.DecodeSnowflakeID(mpID).(sdk.SchemaObjectIdentifier)
-	mpDB := mpIDStruct.DatabaseName()
-	mpSchema := mpIDStruct.SchemaName()
-	mpName := mpIDStruct.Name()
-
-	mP := snowflake.MaskingPolicy(mpName, mpDB, mpSchema)
-	builder := snowflake.NewTagBuilder(tagName).WithDB(tagDB).WithSchema(tagSchema).WithMaskingPolicy(mP)
+	mpObjectID := helpers.DecodeSnowflakeID(mpID).(sdk.SchemaObjectIdentifier)
 
-	q := builder.AddMaskingPolicy()
-
-	if err := snowflake.Exec(db, q); err != nil {
-		return fmt.Errorf("error attaching masking policy %v to tag %v", mpName, tagName)
+	err := client.Tags.Alter(ctx, tagObjectID, &sdk.TagAlterOptions{
+		Set: &sdk.TagSet{
+			MaskingPolicies: []sdk.TagMaskingPolicy{{Name: mpObjectID}},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error attaching masking policy %v to tag %v err = %w", mpObjectID.Name(), tagObjectID.Name(), err)
 	}
 
 	mpAttachmentID := &attachmentID{
-		TagDatabaseName:           tagDB,
-		TagSchemaName:             tagSchema,
-		TagName:                   tagName,
-		MaskingPolicyDatabaseName: mpDB,
-		MaskingPolicySchemaName:   mpSchema,
-		MaskingPolicyName:         mpName,
+		TagDatabaseName:           tagObjectID.DatabaseName(),
+		TagSchemaName:             tagObjectID.SchemaName(),
+		TagName:                   tagObjectID.Name(),
+		MaskingPolicyDatabaseName: mpObjectID.DatabaseName(),
+		MaskingPolicySchemaName:   mpObjectID.SchemaName(),
+		MaskingPolicyName:         mpObjectID.Name(),
 	}
 	dataIDInput, err := mpAttachmentID.String()
 	if err != nil {
@@ -150,7 +146,6 @@ func CreateTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{})
 
 // ReadTagTagMaskingPolicyAssociation implements schema.ReadFunc.
 func ReadTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
 	attachementID, err := attachedPolicyIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -159,15 +154,10 @@ func ReadTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{}) e
 	tagDBName := attachementID.TagDatabaseName
 	tagSchemaName := attachementID.TagSchemaName
 	tagName := attachementID.TagName
-	mpDBName := attachementID.MaskingPolicyDatabaseName
-	mpSchameName := attachementID.MaskingPolicySchemaName
-	mpName := attachementID.MaskingPolicyName
-
-	mP := snowflake.MaskingPolicy(mpName, mpDBName, mpSchameName)
-	builder := snowflake.NewTagBuilder(tagName).WithDB(tagDBName).WithSchema(tagSchemaName).WithMaskingPolicy(mP)
+	mpObjectID := sdk.NewSchemaObjectIdentifier(attachementID.MaskingPolicyDatabaseName, attachementID.MaskingPolicySchemaName, attachementID.MaskingPolicyName)
 
 	// create temp warehouse to query the tag, and make sure to clean it up
-	client := sdk.NewClientFromDB(db)
+	client := meta.(*provider.Context).Client
 	ctx := context.Background()
 	originalWarehouse, err := client.ContextFunctions.CurrentWarehouse(ctx)
 	if err != nil {
@@ -196,23 +186,34 @@ func ReadTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{}) e
 			return err
 		}
 	}
-	row := snowflake.QueryRow(db, builder.ShowAttachedPolicy())
-	t, err := snowflake.ScanTagPolicy(row)
-	if errors.Is(err, sql.ErrNoRows) {
-		// If not found, mark resource to be removed from state file during apply or refresh
-		log.Printf("[DEBUG] attached policy (%s) not found", d.Id())
+
+	references, err := client.MaskingPolicies.ShowReferences(ctx, mpObjectID)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		log.Printf("[DEBUG] masking policy (%s) not found", mpObjectID.FullyQualifiedName())
 		d.SetId("")
 		return nil
 	}
-
 	if err != nil {
 		return err
 	}
+	var reference *sdk.PolicyReference
+	for _, r := range references {
+		if r.RefEntityDomain == sdk.PolicyEntityDomainTag && r.RefDatabaseName == tagDBName && r.RefSchemaName == tagSchemaName && r.RefEntityName == tagName {
+			reference = r
+			break
+		}
+	}
+	if reference == nil {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] attached policy (%s) not found", d.Id())
+		d.SetId("")
+		return nil
+	}
 
 	tagID := TagID{
-		DatabaseName: t.RefDB.String,
-		SchemaName:   t.RefSchema.String,
-		TagName:      t.RefEntity.String,
+		DatabaseName: reference.RefDatabaseName,
+		SchemaName:   reference.RefSchemaName,
+		TagName:      reference.RefEntityName,
 	}
 
 	tagIDString, err := tagID.String()
@@ -220,7 +221,7 @@ func ReadTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	mpIDString := helpers.EncodeSnowflakeID(t.PolicyDB.String, t.PolicySchema.String, t.PolicyName.String)
+	mpIDString := helpers.EncodeSnowflakeID(reference.PolicyDatabase, reference.PolicySchema, reference.PolicyName)
 
 	if err := d.Set("tag_id", tagIDString); err != nil {
 		return err
@@ -234,24 +235,23 @@ func ReadTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{}) e
 
 // DeleteTagMaskingPolicyAssociation implements schema.DeleteFunc.
 func DeleteTagMaskingPolicyAssociation(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
 	attachmentID, err := attachedPolicyIDFromString(d.Id())
 	if err != nil {
 		return err
 	}
 
-	tagDBName := attachmentID.TagDatabaseName
-	tagSchemaName := attachmentID.TagSchemaName
-	tagName := attachmentID.TagName
-	mpDBName := attachmentID.MaskingPolicyDatabaseName
-	mpSchemaName := attachmentID.MaskingPolicySchemaName
-	mpName := attachmentID.MaskingPolicyName
-
-	mP := snowflake.MaskingPolicy(mpName, mpDBName, mpSchemaName)
+	tagObjectID := sdk.NewSchemaObjectIdentifier(attachmentID.TagDatabaseName, attachmentID.TagSchemaName, attachmentID.TagName)
+	mpObjectID := sdk.NewSchemaObjectIdentifier(attachmentID.MaskingPolicyDatabaseName, attachmentID.MaskingPolicySchemaName, attachmentID.MaskingPolicyName)
 
-	builder := snowflake.NewTagBuilder(tagName).WithDB(tagDBName).WithSchema(tagSchemaName).WithMaskingPolicy(mP)
-
-	if err := snowflake.Exec(db, builder.RemoveMaskingPolicy()); err != nil {
+	err = client.Tags.Alter(ctx, tagObjectID, &sdk.TagAlterOptions{
+		Unset: &sdk.TagUnset{
+			MaskingPolicies: []sdk.TagMaskingPolicy{{Name: mpObjectID}},
+		},
+	})
+	if err != nil {
 		return fmt.Errorf("error unattaching masking policy for %v err = %w", d.Id(), err)
 	}
 
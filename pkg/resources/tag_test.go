@@ -3,11 +3,11 @@ package resources_test
 import (
 	"database/sql"
 	"testing"
+	"time"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/require"
@@ -38,7 +38,7 @@ func TestTagCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectReadTag(mock)
-		err := resources.CreateTag(d, db)
+		err := resources.CreateTag(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -59,11 +59,10 @@ func TestTagUpdate(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`^ALTER TAG "test_db"."test_schema"."good_name" SET COMMENT = 'great comment'$`).WillReturnResult(sqlmock.NewResult(1, 1))
-		mock.ExpectExec(`^ALTER TAG "test_db"."test_schema"."good_name" UNSET ALLOWED_VALUES$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^ALTER TAG "test_db"."test_schema"."good_name" ADD ALLOWED_VALUES 'marketing', 'finance'$`).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectReadTag(mock)
-		err := resources.UpdateTag(d, db)
+		err := resources.UpdateTag(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -86,7 +85,7 @@ func TestTagDelete(t *testing.T) {
 			`^DROP TAG "test_db"."test_schema"."good_name"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		err := resources.DeleteTag(d, db)
+		err := resources.DeleteTag(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -106,9 +105,9 @@ func TestTagRead(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		// Test when resource is not found, checking if state will be empty
 		r.NotEmpty(d.State())
-		q := snowflake.NewTagBuilder("good_name").WithDB("test_db").WithSchema("test_schema").Show()
-		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadTag(d, db)
+		rows := sqlmock.NewRows([]string{"created_on", "name", "database_name", "schema_name", "owner", "comment", "allowed_values", "owner_role_type"})
+		mock.ExpectQuery(`^SHOW TAGS LIKE 'good_name' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
+		err := resources.ReadTag(d, ProviderContext(db))
 		r.Empty(d.State())
 		r.Nil(err)
 	})
@@ -116,8 +115,8 @@ func TestTagRead(t *testing.T) {
 
 func expectReadTag(mock sqlmock.Sqlmock) {
 	rows := sqlmock.NewRows([]string{
-		"created_on", "name", "database_name", "schema_name", "owner", "comment", "allowed_values",
+		"created_on", "name", "database_name", "schema_name", "owner", "comment", "allowed_values", "owner_role_type",
 	},
-	).AddRow("2019-05-19 16:55:36.530 -0700", "good_name", "test_db", "test_schema", "admin", "great comment", "'al1','al2'")
+	).AddRow(time.Now(), "good_name", "test_db", "test_schema", "admin", "great comment", `["marketing","finance"]`, "ROLE")
 	mock.ExpectQuery(`^SHOW TAGS LIKE 'good_name' IN SCHEMA "test_db"."test_schema"$`).WillReturnRows(rows)
 }
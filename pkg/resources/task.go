@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -190,7 +191,7 @@ func Task() *schema.Resource {
 
 // ReadTask implements schema.ReadFunc.
 func ReadTask(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	taskID, err := taskIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -345,7 +346,7 @@ func ReadTask(d *schema.ResourceData, meta interface{}) error {
 // CreateTask implements schema.CreateFunc.
 func CreateTask(d *schema.ResourceData, meta interface{}) error {
 	var err error
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	database := d.Get("database").(string)
 	schema := d.Get("schema").(string)
 	name := d.Get("name").(string)
@@ -457,7 +458,7 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	database := taskID.DatabaseName
 	schema := taskID.SchemaName
 	name := taskID.TaskName
@@ -715,7 +716,7 @@ func UpdateTask(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteTask implements schema.DeleteFunc.
 func DeleteTask(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	taskID, err := taskIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -37,7 +37,7 @@ func TestTaskGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT OPERATE ON TASK "test-db"."PUBLIC"."test-task" TO ROLE "test-role-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT OPERATE ON TASK "test-db"."PUBLIC"."test-task" TO ROLE "test-role-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadTaskGrant(mock)
-		err := resources.CreateTaskGrant(d, db)
+		err := resources.CreateTaskGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -58,7 +58,7 @@ func TestTaskGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadTaskGrant(mock)
-		err := resources.ReadTaskGrant(d, db)
+		err := resources.ReadTaskGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 
@@ -101,7 +101,7 @@ func TestFutureTaskGrantCreate(t *testing.T) {
 			`^GRANT OPERATE ON FUTURE TASKS IN SCHEMA "test-db"."PUBLIC" TO ROLE "test-role-2" WITH GRANT OPTION$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureTaskGrant(mock)
-		err := resources.CreateTaskGrant(d, db)
+		err := resources.CreateTaskGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 
@@ -125,7 +125,7 @@ func TestFutureTaskGrantCreate(t *testing.T) {
 			`^GRANT OPERATE ON FUTURE TASKS IN DATABASE "test-db" TO ROLE "test-role-2"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureTaskDatabaseGrant(mock)
-		err := resources.CreateTaskGrant(d, db)
+		err := resources.CreateTaskGrant(d, ProviderContext(db))
 		b.NoError(err)
 	})
 }
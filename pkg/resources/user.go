@@ -1,33 +1,18 @@
 package resources
 
 import (
-	"database/sql"
+	"context"
+	"errors"
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-var userProperties = []string{
-	"comment",
-	"login_name",
-	"password",
-	"disabled",
-	"default_namespace",
-	"default_role",
-	"default_secondary_roles",
-	"default_warehouse",
-	"rsa_public_key",
-	"rsa_public_key_2",
-	"must_change_password",
-	"email",
-	"display_name",
-	"first_name",
-	"last_name",
-}
-
 var diffCaseInsensitive = func(k, old, new string, d *schema.ResourceData) bool {
 	return strings.EqualFold(old, new)
 }
@@ -133,16 +118,42 @@ var userSchema = map[string]*schema.Schema{
 		Sensitive:   true,
 		Description: "Last name of the user.",
 	},
+	"days_to_expiry": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+		Description:  "Specifies the number of days after which the user status is set to `Expired` and the user is no longer allowed to log in. This is useful for defining temporary users.",
+	},
+	"mins_to_unlock": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+		Description:  "Specifies the number of minutes until the temporary lock on the user login is cleared.",
+	},
+	"mins_to_bypass_mfa": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+		Description:  "Specifies the number of minutes to temporarily bypass MFA for the user.",
+	},
+	"enable_unredacted_query_syntax_error": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Object parameter that controls whether query text is redacted if a SQL query fails due to a syntax or parsing error.",
+	},
+	"network_policy": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the network policy enforced for the user instead of the network policy of the account. Do not use it for users which are also listed in the `users` of `snowflake_network_policy_attachment`.",
+	},
 	"tag": tagReferenceSchema,
 
 	//    MIDDLE_NAME = <string>
 	//    SNOWFLAKE_LOCK = TRUE | FALSE
 	//    SNOWFLAKE_SUPPORT = TRUE | FALSE
-	//    DAYS_TO_EXPIRY = <integer>
-	//    MINS_TO_UNLOCK = <integer>
 	//    EXT_AUTHN_DUO = TRUE | FALSE
 	//    EXT_AUTHN_UID = <string>
-	//    MINS_TO_BYPASS_MFA = <integer>
 	//    DISABLE_MFA = TRUE | FALSE
 	//    MINS_TO_BYPASS_NETWORK POLICY = <integer>
 }
@@ -154,7 +165,8 @@ func User() *schema.Resource {
 		Update: UpdateUser,
 		Delete: DeleteUser,
 
-		Schema: userSchema,
+		Description: "Snowflake counts `days_to_expiry`, `mins_to_unlock` and `mins_to_bypass_mfa` down, so they are not read back and changes made outside of Terraform are not detected.",
+		Schema:      userSchema,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -162,80 +174,352 @@ func User() *schema.Resource {
 }
 
 func CreateUser(d *schema.ResourceData, meta interface{}) error {
-	return CreateResource("user", userProperties, userSchema, snowflake.NewUserBuilder, ReadUser)(d, meta)
-}
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
 
-func ReadUser(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
-	// We use User.Describe instead of User.Show because the "SHOW USERS ..." command
-	// requires the "MANAGE GRANTS" global privilege
-	stmt := snowflake.NewUserBuilder(d.Id()).Describe()
-	rows, err := snowflake.Query(db, stmt)
+	name := d.Get("name").(string)
+	objectIdentifier := sdk.NewAccountObjectIdentifier(name)
+
+	properties := &sdk.UserObjectProperties{}
+	if v, ok := d.GetOk("login_name"); ok {
+		properties.LoginName = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("comment"); ok {
+		properties.Comment = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("password"); ok {
+		properties.Password = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("disabled"); ok {
+		properties.Disabled = sdk.Bool(v.(bool))
+	}
+	if v, ok := d.GetOk("default_warehouse"); ok {
+		properties.DefaultWarehouse = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("default_namespace"); ok {
+		properties.DefaultNamespace = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("default_role"); ok {
+		properties.DefaultRole = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("default_secondary_roles"); ok {
+		properties.DefaultSecondaryRoles = expandSecondaryRoles(v.(*schema.Set).List())
+	}
+	if v, ok := d.GetOk("rsa_public_key"); ok {
+		properties.RSAPublicKey = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("rsa_public_key_2"); ok {
+		properties.RSAPublicKey2 = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("must_change_password"); ok {
+		properties.MustChangePassword = sdk.Bool(v.(bool))
+	}
+	if v, ok := d.GetOk("email"); ok {
+		properties.Email = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("display_name"); ok {
+		properties.DisplayName = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("first_name"); ok {
+		properties.FirstName = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("last_name"); ok {
+		properties.LastName = sdk.String(v.(string))
+	}
+	if v, ok := d.GetOk("days_to_expiry"); ok {
+		properties.DaysToExpiry = sdk.Int(v.(int))
+	}
+	if v, ok := d.GetOk("mins_to_unlock"); ok {
+		properties.MinsToUnlock = sdk.Int(v.(int))
+	}
+	if v, ok := d.GetOk("mins_to_bypass_mfa"); ok {
+		properties.MinsToBypassMFA = sdk.Int(v.(int))
+	}
+
+	createOptions := &sdk.CreateUserOptions{
+		ObjectProperties: properties,
+	}
+	parameters := &sdk.UserObjectParameters{}
+	if v, ok := d.GetOk("enable_unredacted_query_syntax_error"); ok {
+		parameters.EnableUnredactedQuerySyntaxError = sdk.Bool(v.(bool))
+		createOptions.ObjectParameters = parameters
+	}
+	if v, ok := d.GetOk("network_policy"); ok {
+		parameters.NetworkPolicy = sdk.String(v.(string))
+		createOptions.ObjectParameters = parameters
+	}
+	if v, ok := d.GetOk("tag"); ok {
+		createOptions.Tag = getTags(v).toSDKTagAssociations()
+	}
+
+	err := client.Users.Create(ctx, objectIdentifier, createOptions)
 	if err != nil {
-		if snowflake.IsResourceNotExistOrNotAuthorized(err.Error(), "User") {
-			// If not found, mark resource to be removed from state file during apply or refresh
-			log.Printf("[DEBUG] user (%s) not found or we are not authorized.Err:\n%s", d.Id(), err.Error())
-			d.SetId("")
-			return nil
-		}
 		return err
 	}
+	d.SetId(name)
+
+	return ReadUser(d, meta)
+}
+
+func ReadUser(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id := sdk.NewAccountObjectIdentifier(d.Id())
 
-	u, err := snowflake.ScanUserDescription(rows)
+	u, err := client.Users.ShowByID(ctx, id)
+	if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+		// If not found, mark resource to be removed from state file during apply or refresh
+		log.Printf("[DEBUG] user (%s) not found or we are not authorized. Err: %s", d.Id(), err)
+		d.SetId("")
+		return nil
+	}
 	if err != nil {
 		return err
 	}
-	if err = d.Set("name", u.Name.String); err != nil {
+
+	if err = d.Set("name", u.Name); err != nil {
 		return err
 	}
-	if err = d.Set("comment", u.Comment.String); err != nil {
+	if err = d.Set("comment", u.Comment); err != nil {
 		return err
 	}
-	if err = d.Set("login_name", u.LoginName.String); err != nil {
+	if err = d.Set("login_name", u.LoginName); err != nil {
 		return err
 	}
 	if err = d.Set("disabled", u.Disabled); err != nil {
 		return err
 	}
-	if err = d.Set("default_role", u.DefaultRole.String); err != nil {
+	if err = d.Set("default_role", u.DefaultRole); err != nil {
 		return err
 	}
-
-	var defaultSecondaryRoles []string
-	if len(u.DefaultSecondaryRoles.String) > 0 {
-		defaultSecondaryRoles = strings.Split(u.DefaultSecondaryRoles.String, ",")
+	if err = d.Set("default_secondary_roles", helpers.StringListToList(helpers.ListContentToString(u.DefaultSecondaryRoles))); err != nil {
+		return err
 	}
-	if err = d.Set("default_secondary_roles", defaultSecondaryRoles); err != nil {
+	if err = d.Set("default_namespace", u.DefaultNamespace); err != nil {
 		return err
 	}
-	if err = d.Set("default_namespace", u.DefaultNamespace.String); err != nil {
+	if err = d.Set("default_warehouse", u.DefaultWarehouse); err != nil {
 		return err
 	}
-	if err = d.Set("default_warehouse", u.DefaultWarehouse.String); err != nil {
+	if err = d.Set("has_rsa_public_key", u.HasRSAPublicKey); err != nil {
 		return err
 	}
-	if err = d.Set("has_rsa_public_key", u.HasRsaPublicKey); err != nil {
+	if err = d.Set("email", u.Email); err != nil {
 		return err
 	}
-	if err = d.Set("email", u.Email.String); err != nil {
+	if err = d.Set("display_name", u.DisplayName); err != nil {
 		return err
 	}
-	if err = d.Set("display_name", u.DisplayName.String); err != nil {
+	if err = d.Set("first_name", u.FirstName); err != nil {
 		return err
 	}
-	if err = d.Set("first_name", u.FirstName.String); err != nil {
+	if err = d.Set("last_name", u.LastName); err != nil {
 		return err
 	}
-	if err = d.Set("last_name", u.LastName.String); err != nil {
+
+	parameter, err := client.Sessions.ShowUserParameter(ctx, sdk.UserParameterEnableUnredactedQuerySyntaxError, id)
+	if err != nil {
+		return err
+	}
+	if err = d.Set("enable_unredacted_query_syntax_error", helpers.StringToBool(parameter.Value)); err != nil {
+		return err
+	}
+	parameter, err = client.Sessions.ShowUserParameter(ctx, sdk.UserParameterNetworkPolicy, id)
+	if err != nil {
+		return err
+	}
+	// the network policy of the account is reported for users without one of their own
+	networkPolicy := ""
+	if parameter.Level == sdk.ParameterTypeUser {
+		networkPolicy = parameter.Value
+	}
+	if err = d.Set("network_policy", networkPolicy); err != nil {
 		return err
 	}
 	return nil
 }
 
 func UpdateUser(d *schema.ResourceData, meta interface{}) error {
-	return UpdateResource("user", userProperties, userSchema, snowflake.NewUserBuilder, ReadUser)(d, meta)
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id := sdk.NewAccountObjectIdentifier(d.Id())
+
+	if d.HasChange("name") {
+		newID := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+		err := client.Users.Alter(ctx, id, &sdk.AlterUserOptions{
+			NewName: newID,
+		})
+		if err != nil {
+			return err
+		}
+		id = newID
+		d.SetId(newID.Name())
+	}
+
+	// Batch SET operations and UNSET operations
+	var runSet bool
+	var runUnset bool
+	setProperties := sdk.UserObjectProperties{}
+	unsetProperties := sdk.UserObjectPropertiesUnset{}
+	stringProperties := []struct {
+		key   string
+		set   **string
+		unset **bool
+	}{
+		{"login_name", &setProperties.LoginName, &unsetProperties.LoginName},
+		{"comment", &setProperties.Comment, &unsetProperties.Comment},
+		{"password", &setProperties.Password, &unsetProperties.Password},
+		{"default_warehouse", &setProperties.DefaultWarehouse, &unsetProperties.DefaultWarehouse},
+		{"default_namespace", &setProperties.DefaultNamespace, &unsetProperties.DefaultNamespace},
+		{"default_role", &setProperties.DefaultRole, &unsetProperties.DefaultRole},
+		{"rsa_public_key", &setProperties.RSAPublicKey, &unsetProperties.RSAPublicKey},
+		{"rsa_public_key_2", &setProperties.RSAPublicKey2, &unsetProperties.RSAPublicKey2},
+		{"email", &setProperties.Email, &unsetProperties.Email},
+		{"display_name", &setProperties.DisplayName, &unsetProperties.DisplayName},
+		{"first_name", &setProperties.FirstName, &unsetProperties.FirstName},
+		{"last_name", &setProperties.LastName, &unsetProperties.LastName},
+	}
+	for _, p := range stringProperties {
+		if !d.HasChange(p.key) {
+			continue
+		}
+		if v, ok := d.GetOk(p.key); ok {
+			runSet = true
+			*p.set = sdk.String(v.(string))
+		} else {
+			runUnset = true
+			*p.unset = sdk.Bool(true)
+		}
+	}
+	intProperties := []struct {
+		key   string
+		set   **int
+		unset **bool
+	}{
+		{"days_to_expiry", &setProperties.DaysToExpiry, &unsetProperties.DaysToExpiry},
+		{"mins_to_unlock", &setProperties.MinsToUnlock, &unsetProperties.MinsToUnlock},
+		{"mins_to_bypass_mfa", &setProperties.MinsToBypassMFA, &unsetProperties.MinsToBypassMFA},
+	}
+	for _, p := range intProperties {
+		if !d.HasChange(p.key) {
+			continue
+		}
+		if v, ok := d.GetOk(p.key); ok {
+			runSet = true
+			*p.set = sdk.Int(v.(int))
+		} else {
+			runUnset = true
+			*p.unset = sdk.Bool(true)
+		}
+	}
+	if d.HasChange("disabled") {
+		runSet = true
+		setProperties.Disabled = sdk.Bool(d.Get("disabled").(bool))
+	}
+	if d.HasChange("must_change_password") {
+		runSet = true
+		setProperties.MustChangePassword = sdk.Bool(d.Get("must_change_password").(bool))
+	}
+	if d.HasChange("default_secondary_roles") {
+		if v, ok := d.GetOk("default_secondary_roles"); ok && v.(*schema.Set).Len() > 0 {
+			runSet = true
+			setProperties.DefaultSecondaryRoles = expandSecondaryRoles(v.(*schema.Set).List())
+		} else {
+			runUnset = true
+			unsetProperties.DefaultSecondaryRoles = sdk.Bool(true)
+		}
+	}
+
+	var setParameters *sdk.UserObjectParameters
+	var unsetParameters *sdk.UserObjectParametersUnset
+	if d.HasChange("enable_unredacted_query_syntax_error") {
+		runSet = true
+		setParameters = &sdk.UserObjectParameters{
+			EnableUnredactedQuerySyntaxError: sdk.Bool(d.Get("enable_unredacted_query_syntax_error").(bool)),
+		}
+	}
+	if d.HasChange("network_policy") {
+		if v, ok := d.GetOk("network_policy"); ok {
+			runSet = true
+			if setParameters == nil {
+				setParameters = &sdk.UserObjectParameters{}
+			}
+			setParameters.NetworkPolicy = sdk.String(v.(string))
+		} else {
+			runUnset = true
+			unsetParameters = &sdk.UserObjectParametersUnset{NetworkPolicy: sdk.Bool(true)}
+		}
+	}
+
+	// Apply SET and UNSET changes
+	if runSet {
+		err := client.Users.Alter(ctx, id, &sdk.AlterUserOptions{
+			Set: &sdk.UserSet{
+				ObjectProperties: &setProperties,
+				ObjectParameters: setParameters,
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if runUnset {
+		err := client.Users.Alter(ctx, id, &sdk.AlterUserOptions{
+			Unset: &sdk.UserUnset{
+				ObjectProperties: &unsetProperties,
+				ObjectParameters: unsetParameters,
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("tag") {
+		o, n := d.GetChange("tag")
+		removed, added, changed := getTags(o).diffs(getTags(n))
+		if len(removed) > 0 {
+			err := client.Users.Alter(ctx, id, &sdk.AlterUserOptions{
+				UnsetTag: removed.toSDKObjectIdentifiers(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if len(added)+len(changed) > 0 {
+			err := client.Users.Alter(ctx, id, &sdk.AlterUserOptions{
+				SetTag: append(added, changed...).toSDKTagAssociations(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return ReadUser(d, meta)
 }
 
 func DeleteUser(d *schema.ResourceData, meta interface{}) error {
-	return DeleteResource("user", snowflake.NewUserBuilder)(d, meta)
+	client := meta.(*provider.Context).Client
+	ctx := context.Background()
+
+	id := sdk.NewAccountObjectIdentifier(d.Id())
+
+	err := client.Users.Drop(ctx, id, nil)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandSecondaryRoles(roles []interface{}) []sdk.SecondaryRole {
+	secondaryRoles := make([]sdk.SecondaryRole, len(roles))
+	for i, role := range roles {
+		secondaryRoles[i] = sdk.SecondaryRole{Value: role.(string)}
+	}
+	return secondaryRoles
 }
@@ -34,7 +34,7 @@ func TestUserGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT MONITOR ON USER "test-user" TO ROLE "test-role-1"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT MONITOR ON USER "test-user" TO ROLE "test-role-2"$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadUserGrant(mock)
-		err := resources.CreateUserGrant(d, db)
+		err := resources.CreateUserGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -7,6 +7,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -63,7 +64,7 @@ func UserOwnershipGrant() *schema.Resource {
 }
 
 func CreateUserOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	user := d.Get("on_user_name").(string)
 	role := d.Get("to_role_name").(string)
 	currentGrants := d.Get("current_grants").(string)
@@ -80,7 +81,7 @@ func CreateUserOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
 }
 
 func ReadUserOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	log.Println(d.Id())
 	user := strings.Split(d.Id(), "|")[0]
 	currentGrants := strings.Split(d.Id(), "|")[2]
@@ -126,7 +127,7 @@ func ReadUserOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
 }
 
 func UpdateUserOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	user := d.Get("on_user_name").(string)
 	role := d.Get("to_role_name").(string)
 	currentGrants := d.Get("current_grants").(string)
@@ -143,7 +144,7 @@ func UpdateUserOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
 }
 
 func DeleteUserOwnershipGrant(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	user := d.Get("on_user_name").(string)
 	currentGrants := d.Get("current_grants").(string)
 	reversionRole := d.Get("revert_ownership_to_role_name").(string)
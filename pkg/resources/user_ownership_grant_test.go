@@ -29,7 +29,7 @@ func TestUserOwnershipGrantCreate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`GRANT OWNERSHIP ON USER "user1" TO ROLE "role1" COPY CURRENT GRANTS`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadUserOwnershipGrant(mock)
-		err := resources.CreateUserOwnershipGrant(d, db)
+		err := resources.CreateUserOwnershipGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -45,7 +45,7 @@ func TestUserOwnershipGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadUserOwnershipGrant(mock)
-		err := resources.ReadUserOwnershipGrant(d, db)
+		err := resources.ReadUserOwnershipGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -93,7 +93,7 @@ func TestUserOwnershipGrantDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`GRANT OWNERSHIP ON USER "user1" TO ROLE "ACCOUNTADMIN" COPY CURRENT GRANTS`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteUserOwnershipGrant(d, db)
+		err := resources.DeleteUserOwnershipGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -7,6 +7,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -75,7 +76,7 @@ func checkUserExists(db *sql.DB, name string) (bool, error) {
 }
 
 func ReadUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	id := d.Id()
 
 	exists, err := checkUserExists(db, id)
@@ -92,7 +93,7 @@ func ReadUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
 }
 
 func CreateUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	name := d.Get("name").(string)
 
 	for _, prop := range userPublicKeyProperties {
@@ -111,7 +112,7 @@ func CreateUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
 }
 
 func UpdateUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	name := d.Id()
 
 	propsToSet := map[string]string{}
@@ -151,7 +152,7 @@ func UpdateUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
 }
 
 func DeleteUserPublicKeys(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	name := d.Id()
 
 	for _, prop := range userPublicKeyProperties {
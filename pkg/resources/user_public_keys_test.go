@@ -2,6 +2,7 @@ package resources_test
 
 import (
 	"database/sql"
+	"fmt"
 	"testing"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
@@ -32,8 +33,8 @@ func TestUserPublicKeysCreate(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`ALTER USER "good_name" SET rsa_public_key = 'asdf'`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`ALTER USER "good_name" SET rsa_public_key_2 = 'asdf2'`).WillReturnResult(sqlmock.NewResult(1, 1))
-		expectReadUser(mock, "good_name")
-		err := resources.CreateUserPublicKeys(d, db)
+		expectDescribeUser(mock, "good_name")
+		err := resources.CreateUserPublicKeys(d, ProviderContext(db))
 		r.NoError(err)
 
 		r.Equal(in["name"], d.Id())
@@ -53,7 +54,48 @@ func TestUsePublicKeysrDelete(t *testing.T) {
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`ALTER USER "good_name" UNSET rsa_public_key`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`ALTER USER "good_name" UNSET rsa_public_key_2`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteUserPublicKeys(d, db)
+		err := resources.DeleteUserPublicKeys(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
+
+func expectDescribeUser(mock sqlmock.Sqlmock, name string) {
+	rowsmap := map[string]string{
+		"NAME":                 name,
+		"CREATED_ON":           "created_on",
+		"LOGIN_NAME":           "myloginname",
+		"DISPLAY_NAME":         "display_name",
+		"FIRST_NAME":           "first_name",
+		"LAST_NAME":            "last_name",
+		"EMAIL":                "email",
+		"MINS_TO_UNLOCK":       "mins_to_unlock",
+		"DAYS_TO_EXPIRY":       "days_to_expiry",
+		"COMMENT":              "mock comment",
+		"DISABLED":             "false",
+		"MUST_CHANGE_PASSWORD": "true",
+		"SNOWFLAKE_LOCK":       "snowflake_lock",
+		"DEFAULT_WAREHOUSE":    "default_warehouse",
+		"DEFAULT_NAMESPACE":    "default_namespace",
+		"DEFAULT_ROLE":         "default_role",
+		"EXT_AUTHN_DUO":        "ext_authn_duo",
+		"EXT_AUTHN_UID":        "ext_authn_uid",
+		"MINS_TO_BYPASS_MFA":   "mins_to_bypass_mfa",
+		"OWNER":                "owner",
+		"LAST_SUCCESS_LOGIN":   "last_success_login",
+		"EXPIRES_AT_TIME":      "expires_at_time",
+		"LOCKED_UNTIL_TIME":    "locked_until_time",
+		"HAS_PASSWORD":         "has_password",
+		"HAS_RSA_PUBLIC_KEY":   "false",
+	}
+
+	rows := sqlmock.NewRows(
+		[]string{"property", "value", "default", "description"},
+	)
+
+	for k, v := range rowsmap {
+		rows.AddRow(k, v, "", "")
+	}
+
+	q := fmt.Sprintf(`^DESCRIBE USER "%s"$`, name)
+	mock.ExpectQuery(q).WillReturnRows(rows)
+}
@@ -4,11 +4,11 @@ import (
 	"database/sql"
 	"fmt"
 	"testing"
+	"time"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
-	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	. "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/testhelpers"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -40,59 +40,45 @@ func TestUserCreate(t *testing.T) {
 		"rsa_public_key":       "asdf",
 		"rsa_public_key_2":     "asdf2",
 		"must_change_password": true,
+		"network_policy":       "user_policy",
 	}
 	d := schema.TestResourceDataRaw(t, resources.User().Schema, in)
 	r.NotNil(d)
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		name := "good_name"
-		q := fmt.Sprintf(`^CREATE USER "%s" COMMENT='great comment' DEFAULT_NAMESPACE='mynamespace' DEFAULT_ROLE='bestrole' DEFAULT_WAREHOUSE='mywarehouse' DISPLAY_NAME='Display Name' EMAIL='fake@email.com' FIRST_NAME='Marcin' LAST_NAME='Zukowski' LOGIN_NAME='gname' PASSWORD='awesomepassword' RSA_PUBLIC_KEY='asdf' RSA_PUBLIC_KEY_2='asdf2' DISABLED=true MUST_CHANGE_PASSWORD=true$`, name)
+		q := fmt.Sprintf(`^CREATE USER "%s" PASSWORD = 'awesomepassword' LOGIN_NAME = 'gname' DISPLAY_NAME = 'Display Name' FIRST_NAME = 'Marcin' LAST_NAME = 'Zukowski' EMAIL = 'fake@email.com' MUST_CHANGE_PASSWORD = true DISABLED = true DEFAULT_WAREHOUSE = 'mywarehouse' DEFAULT_NAMESPACE = 'mynamespace' DEFAULT_ROLE = 'bestrole' RSA_PUBLIC_KEY = 'asdf' RSA_PUBLIC_KEY_2 = 'asdf2' COMMENT = 'great comment' NETWORK_POLICY = 'user_policy'$`, name)
 		mock.ExpectExec(q).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadUser(mock, name)
-		err := resources.CreateUser(d, db)
+		err := resources.CreateUser(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
 
 func expectReadUser(mock sqlmock.Sqlmock, name string) {
-	rowsmap := map[string]string{
-		"NAME":                 name,
-		"CREATED_ON":           "created_on",
-		"LOGIN_NAME":           "myloginname",
-		"DISPLAY_NAME":         "display_name",
-		"FIRST_NAME":           "first_name",
-		"LAST_NAME":            "last_name",
-		"EMAIL":                "email",
-		"MINS_TO_UNLOCK":       "mins_to_unlock",
-		"DAYS_TO_EXPIRY":       "days_to_expiry",
-		"COMMENT":              "mock comment",
-		"DISABLED":             "false",
-		"MUST_CHANGE_PASSWORD": "true",
-		"SNOWFLAKE_LOCK":       "snowflake_lock",
-		"DEFAULT_WAREHOUSE":    "default_warehouse",
-		"DEFAULT_NAMESPACE":    "default_namespace",
-		"DEFAULT_ROLE":         "default_role",
-		"EXT_AUTHN_DUO":        "ext_authn_duo",
-		"EXT_AUTHN_UID":        "ext_authn_uid",
-		"MINS_TO_BYPASS_MFA":   "mins_to_bypass_mfa",
-		"OWNER":                "owner",
-		"LAST_SUCCESS_LOGIN":   "last_success_login",
-		"EXPIRES_AT_TIME":      "expires_at_time",
-		"LOCKED_UNTIL_TIME":    "locked_until_time",
-		"HAS_PASSWORD":         "has_password",
-		"HAS_RSA_PUBLIC_KEY":   "false",
-	}
-
-	rows := sqlmock.NewRows(
-		[]string{"property", "value", "default", "description"},
+	rows := sqlmock.NewRows([]string{
+		"name", "created_on", "login_name", "display_name", "first_name", "last_name", "email", "mins_to_unlock",
+		"days_to_expiry", "comment", "disabled", "must_change_password", "snowflake_lock", "default_warehouse",
+		"default_namespace", "default_role", "default_secondary_roles", "ext_authn_duo", "ext_authn_uid",
+		"mins_to_bypass_mfa", "owner", "last_success_login", "expires_at_time", "locked_until_time",
+		"has_password", "has_rsa_public_key",
+	}).AddRow(
+		name, time.Now(), "myloginname", "display_name", "first_name", "last_name", "email", nil,
+		nil, "mock comment", "false", "true", "false", "default_warehouse",
+		"default_namespace", "default_role", `["ALL"]`, "false", nil,
+		nil, "ACCOUNTADMIN", nil, nil, nil,
+		"true", "false",
 	)
+	mock.ExpectQuery(fmt.Sprintf(`^SHOW USERS LIKE '%s'$`, name)).WillReturnRows(rows)
 
-	for k, v := range rowsmap {
-		rows.AddRow(k, v, "", "")
-	}
+	parameterRows := sqlmock.NewRows([]string{"key", "value", "default", "level", "description"}).
+		AddRow("ENABLE_UNREDACTED_QUERY_SYNTAX_ERROR", "false", "false", "", "")
+	mock.ExpectQuery(fmt.Sprintf(`^SHOW PARAMETERS LIKE 'ENABLE_UNREDACTED_QUERY_SYNTAX_ERROR' IN USER "%s"$`, name)).WillReturnRows(parameterRows)
 
-	q := fmt.Sprintf(`^DESCRIBE USER "%s"$`, name)
-	mock.ExpectQuery(q).WillReturnRows(rows)
+	// the user has no network policy of its own, so the one of the account is reported
+	parameterRows = sqlmock.NewRows([]string{"key", "value", "default", "level", "description"}).
+		AddRow("NETWORK_POLICY", "account_policy", "", "ACCOUNT", "")
+	mock.ExpectQuery(fmt.Sprintf(`^SHOW PARAMETERS LIKE 'NETWORK_POLICY' IN USER "%s"$`, name)).WillReturnRows(parameterRows)
 }
 
 func TestUserRead(t *testing.T) {
@@ -102,17 +88,19 @@ func TestUserRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadUser(mock, name)
-		err := resources.ReadUser(d, db)
+		err := resources.ReadUser(d, ProviderContext(db))
 		r.NoError(err)
 		r.Equal("mock comment", d.Get("comment").(string))
 		r.Equal("myloginname", d.Get("login_name").(string))
 		r.Equal(false, d.Get("disabled").(bool))
+		r.Equal([]interface{}{"ALL"}, d.Get("default_secondary_roles").(*schema.Set).List())
+		r.Equal("", d.Get("network_policy").(string))
 
 		// Test when resource is not found, checking if state will be empty
 		r.NotEmpty(d.State())
-		q := snowflake.NewUserBuilder(d.Id()).Describe()
-		mock.ExpectQuery(q).WillReturnError(fmt.Errorf("SQL compilation error:User '%s' does not exist or not authorized", name))
-		err2 := resources.ReadUser(d, db)
+		emptyRows := sqlmock.NewRows([]string{"name", "created_on"})
+		mock.ExpectQuery(fmt.Sprintf(`^SHOW USERS LIKE '%s'$`, name)).WillReturnRows(emptyRows)
+		err2 := resources.ReadUser(d, ProviderContext(db))
 		r.Empty(d.State())
 		r.Nil(err2)
 	})
@@ -125,7 +113,37 @@ func TestUserDelete(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		mock.ExpectExec(`^DROP USER "drop_it"$`).WillReturnResult(sqlmock.NewResult(1, 1))
-		err := resources.DeleteUser(d, db)
+		err := resources.DeleteUser(d, ProviderContext(db))
+		r.NoError(err)
+	})
+}
+
+func TestUserWithDottedName(t *testing.T) {
+	r := require.New(t)
+	name := "first.last@corp.com"
+
+	d := schema.TestResourceDataRaw(t, resources.User().Schema, map[string]interface{}{"name": name})
+	r.NotNil(d)
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(`^CREATE USER "first\.last@corp\.com"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		expectReadUser(mock, name)
+		err := resources.CreateUser(d, ProviderContext(db))
+		r.NoError(err)
+		r.Equal(name, d.Id())
+	})
+
+	// state written by earlier versions of the provider holds the raw user name as well
+	d = user(t, name, map[string]interface{}{"name": name})
+
+	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
+		expectReadUser(mock, name)
+		err := resources.ReadUser(d, ProviderContext(db))
+		r.NoError(err)
+		r.Equal(name, d.Get("name").(string))
+
+		mock.ExpectExec(`^DROP USER "first\.last@corp\.com"$`).WillReturnResult(sqlmock.NewResult(1, 1))
+		err = resources.DeleteUser(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -10,6 +10,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -153,7 +154,7 @@ func viewIDFromString(stringID string) (*ViewID, error) {
 
 // CreateView implements schema.CreateFunc.
 func CreateView(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	name := d.Get("name").(string)
 	schema := d.Get("schema").(string)
 	database := d.Get("database").(string)
@@ -208,7 +209,7 @@ func CreateView(d *schema.ResourceData, meta interface{}) error {
 
 // ReadView implements schema.ReadFunc.
 func ReadView(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	viewID, err := viewIDFromString(d.Id())
 	if err != nil {
 		return err
@@ -270,7 +271,7 @@ func UpdateView(d *schema.ResourceData, meta interface{}) error {
 	view := viewID.ViewName
 	builder := snowflake.NewViewBuilder(view).WithDB(dbName).WithSchema(schema)
 
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	if d.HasChange("name") {
 		name := d.Get("name")
 
@@ -368,7 +369,7 @@ func UpdateView(d *schema.ResourceData, meta interface{}) error {
 
 // DeleteView implements schema.DeleteFunc.
 func DeleteView(d *schema.ResourceData, meta interface{}) error {
-	db := meta.(*sql.DB)
+	db := meta.(*provider.Context).DB
 	viewID, err := viewIDFromString(d.Id())
 	if err != nil {
 		return err
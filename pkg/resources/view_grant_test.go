@@ -40,7 +40,7 @@ func TestViewGrantCreate(t *testing.T) {
 		mock.ExpectExec(`^GRANT SELECT ON VIEW "test-db"."PUBLIC"."test-view" TO SHARE "test-share-1" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		mock.ExpectExec(`^GRANT SELECT ON VIEW "test-db"."PUBLIC"."test-view" TO SHARE "test-share-2" WITH GRANT OPTION$`).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadViewGrant(mock)
-		err := resources.CreateViewGrant(d, db)
+		err := resources.CreateViewGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -62,7 +62,7 @@ func TestViewGrantRead(t *testing.T) {
 
 	WithMockDb(t, func(db *sql.DB, mock sqlmock.Sqlmock) {
 		expectReadViewGrant(mock)
-		err := resources.ReadViewGrant(d, db)
+		err := resources.ReadViewGrant(d, ProviderContext(db))
 		r.NoError(err)
 	})
 
@@ -114,7 +114,7 @@ func TestFutureViewGrantCreate(t *testing.T) {
 			`^GRANT SELECT ON FUTURE VIEWS IN SCHEMA "test-db"."PUBLIC" TO ROLE "test-role-2" WITH GRANT OPTION$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureViewGrant(mock)
-		err := resources.CreateViewGrant(d, db)
+		err := resources.CreateViewGrant(d, ProviderContext(db))
 		r.NoError(err)
 
 		roles := d.Get("roles").(*schema.Set)
@@ -146,7 +146,7 @@ func TestFutureViewGrantCreate(t *testing.T) {
 			`^GRANT SELECT ON FUTURE VIEWS IN DATABASE "test-db" TO ROLE "test-role-2"$`,
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 		expectReadFutureViewDatabaseGrant(mock)
-		err := resources.CreateViewGrant(d, db)
+		err := resources.CreateViewGrant(d, ProviderContext(db))
 		b.NoError(err)
 	})
 }
@@ -40,7 +40,7 @@ func TestViewCreate(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectReadView(mock)
-		err := resources.CreateView(d, db)
+		err := resources.CreateView(d, testhelpers.ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -66,7 +66,7 @@ func TestViewCreateOrReplace(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectReadView(mock)
-		err := resources.CreateView(d, db)
+		err := resources.CreateView(d, testhelpers.ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -91,7 +91,7 @@ func TestViewCreateAmpersand(t *testing.T) {
 		).WillReturnResult(sqlmock.NewResult(1, 1))
 
 		expectReadView(mock)
-		err := resources.CreateView(d, db)
+		err := resources.CreateView(d, testhelpers.ProviderContext(db))
 		r.NoError(err)
 	})
 }
@@ -147,7 +147,7 @@ func TestViewRead(t *testing.T) {
 		q := snowflake.NewViewBuilder("good_name").WithDB("test_db").WithSchema("test_schema").Show()
 		fmt.Println(q)
 		mock.ExpectQuery(q).WillReturnError(sql.ErrNoRows)
-		err := resources.ReadView(d, db)
+		err := resources.ReadView(d, testhelpers.ProviderContext(db))
 		r.Empty(d.State())
 		r.Nil(err)
 	})
@@ -68,6 +68,56 @@ func TestAccountAlter(t *testing.T) {
 		assert.Equal(t, expected, actual)
 	})
 
+	t.Run("with set event table, log level and trace level", func(t *testing.T) {
+		logLevel := LogLevelInfo
+		traceLevel := TraceLevelOnEvent
+		opts := &AlterAccountOptions{
+			Set: &AccountSet{
+				Parameters: &AccountLevelParameters{
+					AccountParameters: &AccountParameters{
+						EventTable: String("db.schema.events"),
+					},
+					ObjectParameters: &ObjectParameters{
+						LogLevel:   &logLevel,
+						TraceLevel: &traceLevel,
+					},
+				},
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER ACCOUNT SET EVENT_TABLE = 'db.schema.events', LOG_LEVEL = INFO, TRACE_LEVEL = ON_EVENT`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: event table not fully qualified", func(t *testing.T) {
+		opts := &AlterAccountOptions{
+			Set: &AccountSet{
+				Parameters: &AccountLevelParameters{
+					AccountParameters: &AccountParameters{
+						EventTable: String("events"),
+					},
+				},
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: invalid log level", func(t *testing.T) {
+		logLevel := LogLevel("VERBOSE")
+		opts := &AlterAccountOptions{
+			Set: &AccountSet{
+				Parameters: &AccountLevelParameters{
+					ObjectParameters: &ObjectParameters{
+						LogLevel: &logLevel,
+					},
+				},
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+
 	t.Run("with unset params", func(t *testing.T) {
 		opts := &AlterAccountOptions{
 			Unset: &AccountUnset{
@@ -0,0 +1,153 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/snowflakedb/gosnowflake"
+)
+
+// batchPositionVariable is the session variable counting the statements of a batch that were applied. Snowflake
+// stops a batch at the failing statement without reporting which one it was, so it is read back after a failure.
+const batchPositionVariable = "TERRAFORM_BATCH_POSITION"
+
+// BatchStatement is a statement of a batch, together with the statements undoing it.
+type BatchStatement struct {
+	SQL string
+	// Compensate holds the statements undoing SQL, e.g. REVOKE for GRANT. DDL commits on its own, so rolling
+	// back a transaction does not undo it. When a batch fails, the compensating statements of the statements that
+	// were applied run in reverse order. Should it not be known which statements were applied, those of all
+	// statements run, so compensating statements must be harmless for statements that were not applied.
+	Compensate []string
+}
+
+// BatchResult is the outcome of a statement of a batch.
+type BatchResult struct {
+	SQL string
+	// Status is the message returned for the statement, e.g. "Statement executed successfully.".
+	Status string
+	// Applied is true if the statement was run successfully, even if it was compensated afterwards.
+	Applied bool
+	// Err is the error of the statement which failed the batch. The statements after it were not run, so they have
+	// neither Applied nor Err set. Should it not be known which statement failed, Err is set for every statement.
+	Err error
+	// CompensateErr is the first error of the compensating statements of the statement.
+	CompensateErr error
+}
+
+// ExecBatch runs the statements in a single request using the MULTI_STATEMENT_COUNT parameter of the driver. Batches
+// are not retried, since a failed batch may have been applied partially.
+func (c *Client) ExecBatch(ctx context.Context, statements []BatchStatement) ([]*BatchResult, error) {
+	if len(statements) == 0 {
+		return nil, nil
+	}
+	results := make([]*BatchResult, len(statements))
+	// every statement is followed by one counting it, so that the position of a failing statement can be read back
+	sqls := []string{fmt.Sprintf("SET %s = 0", batchPositionVariable)}
+	for i, statement := range statements {
+		results[i] = &BatchResult{SQL: statement.SQL}
+		sqls = append(sqls, strings.TrimRight(strings.TrimSpace(statement.SQL), ";"), fmt.Sprintf("SET %s = %d", batchPositionVariable, i+1))
+	}
+	sqls = append(sqls, fmt.Sprintf("UNSET %s", batchPositionVariable))
+	sql := strings.Join(sqls, ";\n")
+
+	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
+	batchCtx, err := gosnowflake.WithMultiStatement(ctx, len(sqls))
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] executing batch of %d statements\n", len(statements))
+	// the position is a variable of the session, so it must be read on the connection that ran the batch
+	err = c.useDedicatedConnection(batchCtx, func(db dbExecutor) error {
+		err := execBatch(batchCtx, db, sql, results)
+		if err == nil {
+			for _, result := range results {
+				result.Applied = true
+			}
+			return nil
+		}
+
+		applied, positionErr := batchPosition(ctx, db)
+		if positionErr != nil {
+			log.Printf("[DEBUG] could not read the position of the failed batch, compensating all statements err = %v\n", positionErr)
+			applied = len(statements)
+			for _, result := range results {
+				result.Err = err
+			}
+		} else {
+			for _, result := range results[:applied] {
+				result.Applied = true
+			}
+			if applied < len(statements) {
+				results[applied].Err = err
+			}
+		}
+		for i := applied - 1; i >= 0; i-- {
+			for _, compensate := range statements[i].Compensate {
+				if _, compensateErr := db.ExecContext(ctx, compensate); compensateErr != nil && results[i].CompensateErr == nil {
+					results[i].CompensateErr = decodeDriverError(compensateErr)
+				}
+			}
+		}
+		// a failed batch stops before its trailing UNSET, so the position must not be left to a later batch on the connection
+		if _, unsetErr := db.ExecContext(ctx, fmt.Sprintf("UNSET %s", batchPositionVariable)); unsetErr != nil {
+			log.Printf("[DEBUG] discarding connection, could not unset the position of the failed batch err = %v\n", unsetErr)
+			if conn, ok := db.(*sqlx.Conn); ok {
+				discardConnection(conn)
+			}
+		}
+		return err
+	})
+	return results, err
+}
+
+// batchPosition returns the number of statements of the last batch of the session which were applied.
+func batchPosition(ctx context.Context, db dbExecutor) (int, error) {
+	position := &struct {
+		Position int `db:"POSITION"`
+	}{}
+	if err := db.GetContext(ctx, position, fmt.Sprintf("SELECT $%s AS POSITION", batchPositionVariable)); err != nil {
+		return 0, decodeDriverError(err)
+	}
+	return position.Position, nil
+}
+
+// execBatch runs the joined statements and reads the status of every statement from its result set. The result sets
+// of the statements counting the position are skipped.
+func execBatch(ctx context.Context, db dbExecutor, sql string, results []*BatchResult) error {
+	rows, err := db.QueryxContext(ctx, sql)
+	if err != nil {
+		return decodeDriverError(err)
+	}
+	defer rows.Close()
+	for resultSet := 0; ; resultSet++ {
+		i := (resultSet - 1) / 2
+		isStatement := resultSet%2 == 1 && i < len(results)
+		for rows.Next() {
+			values, err := rows.SliceScan()
+			if err != nil {
+				return decodeDriverError(err)
+			}
+			// DDL returns a single row with the status, queries return their rows
+			if isStatement && results[i].Status == "" && len(values) > 0 {
+				if columns, _ := rows.Columns(); len(columns) > 0 && strings.EqualFold(columns[0], "status") {
+					results[i].Status = statusString(values[0])
+				}
+			}
+		}
+		if !rows.NextResultSet() {
+			break
+		}
+	}
+	return decodeDriverError(rows.Err())
+}
+
+func statusString(v interface{}) string {
+	if b, ok := v.([]byte); ok {
+		return string(b)
+	}
+	return fmt.Sprint(v)
+}
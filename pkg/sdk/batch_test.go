@@ -0,0 +1,127 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ExecBatch(t *testing.T) {
+	ctx := context.Background()
+	statements := []BatchStatement{
+		{SQL: `GRANT ROLE "R" TO ROLE "A";`, Compensate: []string{`REVOKE ROLE "R" FROM ROLE "A"`}},
+		{SQL: `GRANT ROLE "R" TO USER "B"`, Compensate: []string{`REVOKE ROLE "R" FROM USER "B"`}},
+	}
+	batchSQL := "^" + regexp.QuoteMeta("SET TERRAFORM_BATCH_POSITION = 0;\n"+
+		"GRANT ROLE \"R\" TO ROLE \"A\";\nSET TERRAFORM_BATCH_POSITION = 1;\n"+
+		"GRANT ROLE \"R\" TO USER \"B\";\nSET TERRAFORM_BATCH_POSITION = 2;\n"+
+		"UNSET TERRAFORM_BATCH_POSITION") + "$"
+	positionSQL := `^SELECT \$TERRAFORM_BATCH_POSITION AS POSITION$`
+	unsetSQL := `^UNSET TERRAFORM_BATCH_POSITION$`
+	executed := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"status"}).AddRow("Statement executed successfully.")
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		client, mock := mockClient(t)
+		results, err := client.ExecBatch(ctx, nil)
+		require.NoError(t, err)
+		assert.Empty(t, results)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("per statement results", func(t *testing.T) {
+		client, mock := mockClient(t)
+		mock.ExpectQuery(batchSQL).WillReturnRows(
+			executed(),
+			sqlmock.NewRows([]string{"status"}).AddRow("Statement executed successfully."),
+			executed(),
+			sqlmock.NewRows([]string{"status"}).AddRow("Grant applied."),
+			executed(),
+			executed(),
+		)
+
+		results, err := client.ExecBatch(ctx, statements)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, `GRANT ROLE "R" TO ROLE "A";`, results[0].SQL)
+		assert.Equal(t, "Statement executed successfully.", results[0].Status)
+		assert.Equal(t, `GRANT ROLE "R" TO USER "B"`, results[1].SQL)
+		assert.Equal(t, "Grant applied.", results[1].Status)
+		for _, result := range results {
+			assert.True(t, result.Applied)
+			assert.NoError(t, result.Err)
+		}
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("only applied statements are compensated", func(t *testing.T) {
+		client, mock := mockClient(t)
+		mock.ExpectQuery(batchSQL).WillReturnError(errors.New("002003 (02000): SQL compilation error:\nUser 'B' does not exist or not authorized."))
+		mock.ExpectQuery(positionSQL).WillReturnRows(sqlmock.NewRows([]string{"POSITION"}).AddRow(1))
+		mock.ExpectExec(`^REVOKE ROLE "R" FROM ROLE "A"$`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(unsetSQL).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		results, err := client.ExecBatch(ctx, statements)
+		require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+		require.Len(t, results, 2)
+		assert.True(t, results[0].Applied)
+		assert.NoError(t, results[0].Err)
+		assert.NoError(t, results[0].CompensateErr)
+		assert.False(t, results[1].Applied)
+		assert.ErrorIs(t, results[1].Err, ErrObjectNotExistOrAuthorized)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("statements after the failing one are not compensated", func(t *testing.T) {
+		client, mock := mockClient(t)
+		mock.ExpectQuery(batchSQL).WillReturnError(errors.New("002003 (02000): SQL compilation error:\nRole 'A' does not exist or not authorized."))
+		mock.ExpectQuery(positionSQL).WillReturnRows(sqlmock.NewRows([]string{"POSITION"}).AddRow(0))
+		mock.ExpectExec(unsetSQL).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		results, err := client.ExecBatch(ctx, statements)
+		require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+		assert.ErrorIs(t, results[0].Err, ErrObjectNotExistOrAuthorized)
+		assert.False(t, results[1].Applied)
+		assert.NoError(t, results[1].Err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("unknown position compensates all statements in reverse order", func(t *testing.T) {
+		client, mock := mockClient(t)
+		mock.ExpectQuery(batchSQL).WillReturnError(errors.New("002003 (02000): SQL compilation error:\nUser 'B' does not exist or not authorized."))
+		mock.ExpectQuery(positionSQL).WillReturnError(errors.New("connection reset"))
+		mock.ExpectExec(`^REVOKE ROLE "R" FROM USER "B"$`).WillReturnError(errors.New("002003 (02000): SQL compilation error:\nUser 'B' does not exist or not authorized."))
+		mock.ExpectExec(`^REVOKE ROLE "R" FROM ROLE "A"$`).WillReturnResult(sqlmock.NewResult(0, 0))
+		// a connection still holding the position is not returned to the pool
+		mock.ExpectExec(unsetSQL).WillReturnError(errors.New("connection reset"))
+		mock.ExpectClose()
+
+		results, err := client.ExecBatch(ctx, statements)
+		require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+		require.Len(t, results, 2)
+		for _, result := range results {
+			assert.ErrorIs(t, result.Err, ErrObjectNotExistOrAuthorized)
+			assert.Empty(t, result.Status)
+		}
+		assert.NoError(t, results[0].CompensateErr)
+		assert.ErrorIs(t, results[1].CompensateErr, ErrObjectNotExistOrAuthorized)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("failed batch is not retried", func(t *testing.T) {
+		client, mock := mockClient(t)
+		mock.ExpectQuery(batchSQL).WillReturnError(errLocked)
+		mock.ExpectQuery(positionSQL).WillReturnRows(sqlmock.NewRows([]string{"POSITION"}).AddRow(0))
+		mock.ExpectExec(unsetSQL).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		_, err := client.ExecBatch(ctx, statements)
+		require.ErrorIs(t, err, ErrObjectLocked)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -18,6 +18,9 @@ type Client struct {
 	db             *sqlx.DB
 	sessionID      string
 	accountLocator string
+	retryPolicy    *RetryPolicy
+	// sessionDefaults are restored after statements with statement options
+	sessionDefaults sessionDefaults
 
 	// System-Defined Functions
 	ContextFunctions     ContextFunctions
@@ -28,16 +31,24 @@ type Client struct {
 	// DDL Commands
 	Accounts         Accounts
 	Comments         Comments
+	DatabaseRoles    DatabaseRoles
 	Databases        Databases
+	DynamicTables    DynamicTables
+	EventTables      EventTables
 	FailoverGroups   FailoverGroups
 	Grants           Grants
 	MaskingPolicies  MaskingPolicies
+	NetworkRules     NetworkRules
 	PasswordPolicies PasswordPolicies
 	ResourceMonitors ResourceMonitors
 	Roles            Roles
+	Schemas          Schemas
+	Secrets          Secrets
 	SessionPolicies  SessionPolicies
 	Sessions         Sessions
 	Shares           Shares
+	Tags             Tags
+	Users            Users
 	Warehouses       Warehouses
 }
 
@@ -78,8 +89,9 @@ func NewClient(cfg *gosnowflake.Config) (*Client, error) {
 
 	client = &Client{
 		// snowflake does not adhere to the normal sql driver interface, so we have to use unsafe
-		db:     db.Unsafe(),
-		config: cfg,
+		db:          db.Unsafe(),
+		config:      cfg,
+		retryPolicy: DefaultRetryPolicy(),
 	}
 	client.initialize()
 
@@ -107,7 +119,8 @@ func NewClient(cfg *gosnowflake.Config) (*Client, error) {
 func NewClientFromDB(db *sql.DB) *Client {
 	dbx := sqlx.NewDb(db, "snowflake")
 	client := &Client{
-		db: dbx.Unsafe(),
+		db:          dbx.Unsafe(),
+		retryPolicy: DefaultRetryPolicy(),
 	}
 	client.initialize()
 	return client
@@ -118,21 +131,35 @@ func (c *Client) initialize() {
 	c.Comments = &comments{client: c}
 	c.ContextFunctions = &contextFunctions{client: c}
 	c.ConversionFunctions = &conversionFunctions{client: c}
+	c.DatabaseRoles = &databaseRoles{client: c}
 	c.Databases = &databases{client: c}
+	c.DynamicTables = &dynamicTables{client: c}
+	c.EventTables = &eventTables{client: c}
 	c.FailoverGroups = &failoverGroups{client: c}
 	c.Grants = &grants{client: c}
 	c.MaskingPolicies = &maskingPolicies{client: c}
+	c.NetworkRules = &networkRules{client: c}
 	c.PasswordPolicies = &passwordPolicies{client: c}
 	c.ReplicationFunctions = &replicationFunctions{client: c}
 	c.ResourceMonitors = &resourceMonitors{client: c}
 	c.Roles = &roles{client: c}
+	c.Schemas = &schemas{client: c}
+	c.Secrets = &secrets{client: c}
 	c.SessionPolicies = &sessionPolicies{client: c}
 	c.Sessions = &sessions{client: c}
 	c.Shares = &shares{client: c}
 	c.SystemFunctions = &systemFunctions{client: c}
+	c.Tags = &tags{client: c}
+	c.Users = &users{client: c}
 	c.Warehouses = &warehouses{client: c}
 }
 
+// SetRetryPolicy replaces the policy for repeating statements that failed on transient errors. A nil policy
+// disables retries.
+func (c *Client) SetRetryPolicy(policy *RetryPolicy) {
+	c.retryPolicy = policy
+}
+
 func (c *Client) Ping() error {
 	return c.db.Ping()
 }
@@ -151,20 +178,28 @@ const (
 )
 
 // Exec executes a query that does not return rows.
-func (c *Client) exec(ctx context.Context, sql string) (sql.Result, error) {
+func (c *Client) exec(ctx context.Context, sql string) (result sql.Result, err error) {
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
-	result, err := c.db.ExecContext(ctx, sql)
-	return result, decodeDriverError(err)
+	err = c.run(ctx, sql, func(db dbExecutor) error {
+		var execErr error
+		result, execErr = db.ExecContext(ctx, sql)
+		return decodeDriverError(execErr)
+	})
+	return result, err
 }
 
 // query runs a query and returns the rows. dest is expected to be a slice of structs.
 func (c *Client) query(ctx context.Context, dest interface{}, sql string) error {
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
-	return decodeDriverError(c.db.SelectContext(ctx, dest, sql))
+	return c.run(ctx, sql, func(db dbExecutor) error {
+		return decodeDriverError(db.SelectContext(ctx, dest, sql))
+	})
 }
 
 // queryOne runs a query and returns one row. dest is expected to be a pointer to a struct.
 func (c *Client) queryOne(ctx context.Context, dest interface{}, sql string) error {
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
-	return decodeDriverError(c.db.GetContext(ctx, dest, sql))
+	return c.run(ctx, sql, func(db dbExecutor) error {
+		return decodeDriverError(db.GetContext(ctx, dest, sql))
+	})
 }
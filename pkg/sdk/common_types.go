@@ -74,6 +74,12 @@ type IntProperty struct {
 	Description  string
 }
 
+type BoolProperty struct {
+	Value        bool
+	DefaultValue bool
+	Description  string
+}
+
 type propertyRow struct {
 	Property     string `db:"property"`
 	Value        string `db:"value"`
@@ -102,3 +108,19 @@ func (row *propertyRow) toIntProperty() *IntProperty {
 		Description:  row.Description,
 	}
 }
+
+func (row *propertyRow) toBoolProperty() *BoolProperty {
+	var value bool
+	if row.Value != "" && row.Value != "null" {
+		value = toBool(row.Value)
+	}
+	var defaultValue bool
+	if row.DefaultValue != "" && row.DefaultValue != "null" {
+		defaultValue = toBool(row.DefaultValue)
+	}
+	return &BoolProperty{
+		Value:        value,
+		DefaultValue: defaultValue,
+		Description:  row.Description,
+	}
+}
@@ -1,24 +1,326 @@
 package sdk
 
 import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
 	"log"
+	"net/url"
 	"os"
 	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
 
+	homedir "github.com/mitchellh/go-homedir"
 	"github.com/pelletier/go-toml/v2"
 	"github.com/snowflakedb/gosnowflake"
+	"github.com/youmark/pkcs8"
+	"golang.org/x/crypto/ssh"
 )
 
+// ConfigProfile holds the connection settings of a profile in the ~/.snowflake/config file. The keys of a profile
+// are named after the attributes of the provider, except for the username, which is set with `user`, and the default
+// database and schema of the session, which have no attributes.
+// Settings which are not set are nil.
+type ConfigProfile struct {
+	Account                   *string           `toml:"account"`
+	OrganizationName          *string           `toml:"organization_name"`
+	AccountName               *string           `toml:"account_name"`
+	User                      *string           `toml:"user"`
+	Password                  *string           `toml:"password"`
+	Role                      *string           `toml:"role"`
+	Region                    *string           `toml:"region"`
+	Host                      *string           `toml:"host"`
+	Port                      *int              `toml:"port"`
+	Protocol                  *string           `toml:"protocol"`
+	Warehouse                 *string           `toml:"warehouse"`
+	Database                  *string           `toml:"database"`
+	Schema                    *string           `toml:"schema"`
+	InsecureMode              *bool             `toml:"insecure_mode"`
+	BrowserAuth               *bool             `toml:"browser_auth"`
+	Authenticator             *string           `toml:"authenticator"`
+	PrivateKeyPath            *string           `toml:"private_key_path"`
+	PrivateKey                *string           `toml:"private_key"`
+	PrivateKeyPassphrase      *string           `toml:"private_key_passphrase"`
+	OauthAccessToken          *string           `toml:"oauth_access_token"`
+	OauthRefreshToken         *string           `toml:"oauth_refresh_token"`
+	OauthClientID             *string           `toml:"oauth_client_id"`
+	OauthClientSecret         *string           `toml:"oauth_client_secret"`
+	OauthEndpoint             *string           `toml:"oauth_endpoint"`
+	OauthRedirectURL          *string           `toml:"oauth_redirect_url"`
+	OauthTokenCachePath       *string           `toml:"oauth_token_cache_path"`
+	OktaURL                   *string           `toml:"okta_url"`
+	Passcode                  *string           `toml:"passcode"`
+	PasscodeInPassword        *bool             `toml:"passcode_in_password"`
+	JWTExpireTimeout          *int              `toml:"jwt_expire_timeout"`
+	TokenFilePath             *string           `toml:"token_file_path"`
+	LoginTimeout              *int              `toml:"login_timeout"`
+	RequestTimeout            *int              `toml:"request_timeout"`
+	ClientTimeout             *int              `toml:"client_timeout"`
+	ClientSessionKeepAlive    *bool             `toml:"client_session_keep_alive"`
+	OCSPFailOpen              *bool             `toml:"ocsp_fail_open"`
+	ValidateDefaultParameters *bool             `toml:"validate_default_parameters"`
+	Tracing                   *string           `toml:"tracing"`
+	Params                    map[string]string `toml:"params"`
+}
+
+// Merge returns the settings of p, completed with the settings of other which are not set in p. The credentials and
+// the authenticator are taken as a whole from p if it sets any credentials, so that e.g. a password of p is not
+// overridden by a private key of other.
+func (p *ConfigProfile) Merge(other *ConfigProfile) *ConfigProfile {
+	if p == nil {
+		return other
+	}
+	if other == nil {
+		return p
+	}
+	merged := *p
+	mergedValue := reflect.ValueOf(&merged).Elem()
+	otherValue := reflect.ValueOf(other).Elem()
+	for i := 0; i < mergedValue.NumField(); i++ {
+		if field := mergedValue.Field(i); field.Kind() == reflect.Pointer && field.IsNil() {
+			field.Set(otherValue.Field(i))
+		}
+	}
+	// the account is identified either by its identifier, or by the organization and account names
+	if p.HasAccount() {
+		merged.Account, merged.OrganizationName, merged.AccountName = p.Account, p.OrganizationName, p.AccountName
+	}
+	if p.HasCredentials() {
+		merged.Password, merged.BrowserAuth, merged.TokenFilePath = p.Password, p.BrowserAuth, p.TokenFilePath
+		merged.PrivateKeyPath, merged.PrivateKey, merged.PrivateKeyPassphrase = p.PrivateKeyPath, p.PrivateKey, p.PrivateKeyPassphrase
+		merged.OauthAccessToken, merged.OauthRefreshToken, merged.OauthClientID = p.OauthAccessToken, p.OauthRefreshToken, p.OauthClientID
+		merged.OauthClientSecret, merged.OauthEndpoint, merged.OauthRedirectURL = p.OauthClientSecret, p.OauthEndpoint, p.OauthRedirectURL
+		merged.OauthTokenCachePath, merged.Authenticator = p.OauthTokenCachePath, p.Authenticator
+	}
+	if len(other.Params) > 0 {
+		merged.Params = map[string]string{}
+		for k, v := range other.Params {
+			merged.Params[strings.ToLower(k)] = v
+		}
+		for k, v := range p.Params {
+			merged.Params[strings.ToLower(k)] = v
+		}
+	}
+	return &merged
+}
+
+// HasAccount returns true if the account is set, either by its identifier or by the organization and account names.
+func (p *ConfigProfile) HasAccount() bool {
+	return p.Account != nil || (p.OrganizationName != nil && p.AccountName != nil)
+}
+
+// HasCredentials returns true if a password, a private key, browser authentication or an OAuth token is set.
+func (p *ConfigProfile) HasCredentials() bool {
+	return value(p.Password) != "" || value(p.PrivateKeyPath) != "" || value(p.PrivateKey) != "" || value(p.BrowserAuth) ||
+		value(p.OauthAccessToken) != "" || value(p.OauthRefreshToken) != "" || value(p.TokenFilePath) != ""
+}
+
+// DriverConfig builds the driver configuration from the profile. The authenticator is derived from the
+// credentials that are set, unless it is set explicitly. Exchanging an OAuth refresh token for an access
+// token is left to the caller.
+func (p *ConfigProfile) DriverConfig() (*gosnowflake.Config, error) {
+	config := &gosnowflake.Config{
+		Account:      value(p.Account),
+		User:         value(p.User),
+		Region:       value(p.Region),
+		Role:         value(p.Role),
+		Port:         value(p.Port),
+		Protocol:     value(p.Protocol),
+		Warehouse:    value(p.Warehouse),
+		Database:     value(p.Database),
+		Schema:       value(p.Schema),
+		InsecureMode: value(p.InsecureMode),
+	}
+
+	// us-west-2 is Snowflake's default region, but if you actually specify that it won't trigger the default code
+	//  https://github.com/snowflakedb/gosnowflake/blob/52137ce8c32eaf93b0bd22fc5c7297beff339812/dsn.go#L61
+	if config.Region == "us-west-2" {
+		config.Region = ""
+	}
+	// the account identifier of the organization is not qualified by a region
+	if p.OrganizationName != nil || p.AccountName != nil {
+		if p.OrganizationName == nil || p.AccountName == nil {
+			return nil, errors.New("organization_name and account_name must be set together")
+		}
+		config.Account = fmt.Sprintf("%s-%s", *p.OrganizationName, *p.AccountName)
+		config.Region = ""
+	}
+	// If host is set trust it and do not use the region value
+	if p.Host != nil && *p.Host != "" {
+		config.Region = ""
+		config.Host = *p.Host
+	}
+
+	if err := p.applyCredentials(config); err != nil {
+		return nil, err
+	}
+	if err := p.applyAuthenticator(config); err != nil {
+		return nil, err
+	}
+	p.applyConnectionSettings(config)
+	return config, nil
+}
+
+func (p *ConfigProfile) applyCredentials(config *gosnowflake.Config) error {
+	passphrase := []byte(value(p.PrivateKeyPassphrase))
+	switch {
+	case value(p.PrivateKeyPath) != "":
+		privateKeyBytes, err := ReadPrivateKeyFile(*p.PrivateKeyPath)
+		if err != nil {
+			return fmt.Errorf("private Key file could not be read err = %w", err)
+		}
+		rsaPrivateKey, err := ParsePrivateKey(privateKeyBytes, passphrase)
+		if err != nil {
+			return fmt.Errorf("private Key could not be parsed err = %w", err)
+		}
+		config.PrivateKey = rsaPrivateKey
+		config.Authenticator = gosnowflake.AuthTypeJwt
+	case value(p.PrivateKey) != "":
+		rsaPrivateKey, err := ParsePrivateKey([]byte(*p.PrivateKey), passphrase)
+		if err != nil {
+			return fmt.Errorf("private Key could not be parsed err = %w", err)
+		}
+		config.PrivateKey = rsaPrivateKey
+		config.Authenticator = gosnowflake.AuthTypeJwt
+	case value(p.BrowserAuth):
+		config.Authenticator = gosnowflake.AuthTypeExternalBrowser
+	case value(p.OauthAccessToken) != "":
+		config.Authenticator = gosnowflake.AuthTypeOAuth
+		config.Token = *p.OauthAccessToken
+	case value(p.Password) != "":
+		config.Password = *p.Password
+	}
+	return nil
+}
+
+// applyAuthenticator sets the authenticator explicitly chosen in the profile, together with the settings specific to it.
+func (p *ConfigProfile) applyAuthenticator(config *gosnowflake.Config) error {
+	if tokenFilePath := value(p.TokenFilePath); tokenFilePath != "" {
+		token, err := ReadTokenFile(tokenFilePath)
+		if err != nil {
+			return err
+		}
+		config.Token = token
+		config.Authenticator = gosnowflake.AuthTypeOAuth
+	}
+	if jwtExpireTimeout := value(p.JWTExpireTimeout); jwtExpireTimeout > 0 {
+		config.JWTExpireTimeout = time.Duration(jwtExpireTimeout) * time.Second
+	}
+	config.Passcode = value(p.Passcode)
+	config.PasscodeInPassword = value(p.PasscodeInPassword)
+
+	if value(p.Authenticator) == "" {
+		return nil
+	}
+	authType, err := ToAuthenticatorType(*p.Authenticator)
+	if err != nil {
+		return err
+	}
+	config.Authenticator = authType
+
+	switch authType {
+	case gosnowflake.AuthTypeOkta:
+		if value(p.OktaURL) == "" {
+			return errors.New("okta_url must be set when using the OKTA authenticator")
+		}
+		parsedURL, err := url.Parse(*p.OktaURL)
+		if err != nil {
+			return fmt.Errorf("could not parse okta_url err = %w", err)
+		}
+		config.OktaURL = parsedURL
+	case gosnowflake.AuthTypeJwt:
+		if config.PrivateKey == nil {
+			return errors.New("private_key or private_key_path must be set when using the SNOWFLAKE_JWT authenticator")
+		}
+	case gosnowflake.AuthTypeOAuth:
+		if config.Token == "" {
+			return errors.New("oauth_access_token, oauth_refresh_token or token_file_path must be set when using the OAUTH authenticator")
+		}
+	}
+	return nil
+}
+
+// applyConnectionSettings sets the timeouts, session parameters and logging of the driver. Timeouts are in seconds,
+// settings which are not set keep the driver default.
+func (p *ConfigProfile) applyConnectionSettings(config *gosnowflake.Config) {
+	if loginTimeout := value(p.LoginTimeout); loginTimeout > 0 {
+		config.LoginTimeout = time.Duration(loginTimeout) * time.Second
+	}
+	if requestTimeout := value(p.RequestTimeout); requestTimeout > 0 {
+		config.RequestTimeout = time.Duration(requestTimeout) * time.Second
+	}
+	if clientTimeout := value(p.ClientTimeout); clientTimeout > 0 {
+		config.ClientTimeout = time.Duration(clientTimeout) * time.Second
+	}
+	if p.OCSPFailOpen != nil {
+		if *p.OCSPFailOpen {
+			config.OCSPFailOpen = gosnowflake.OCSPFailOpenTrue
+		} else {
+			config.OCSPFailOpen = gosnowflake.OCSPFailOpenFalse
+		}
+	}
+	if p.ValidateDefaultParameters != nil {
+		if *p.ValidateDefaultParameters {
+			config.ValidateDefaultParameters = gosnowflake.ConfigBoolTrue
+		} else {
+			config.ValidateDefaultParameters = gosnowflake.ConfigBoolFalse
+		}
+	}
+	config.Tracing = strings.ToLower(value(p.Tracing))
+
+	if config.Params == nil {
+		config.Params = map[string]*string{}
+	}
+	for k, v := range p.Params {
+		v := v
+		// the driver sends unknown DSN parameters as session parameters, which are case-insensitive
+		config.Params[strings.ToLower(k)] = &v
+	}
+	if value(p.ClientSessionKeepAlive) {
+		config.Params["client_session_keep_alive"] = String("true")
+	}
+}
+
+func value[T any](v *T) T {
+	var zero T
+	if v == nil {
+		return zero
+	}
+	return *v
+}
+
+// DefaultConfig returns the configuration of the SNOWFLAKE_* environment variables, completed with the profile
+// selected by SNOWFLAKE_PROFILE, or the default profile, of the config file.
 func DefaultConfig() *gosnowflake.Config {
-	config, err := ProfileConfig("default")
-	if err != nil || config == nil {
-		log.Printf("[DEBUG] No Snowflake config file found, falling back to environment variables: %v\n", err)
+	config, err := defaultConfig()
+	if err != nil {
+		log.Printf("[DEBUG] Could not load the Snowflake config, falling back to environment variables: %v\n", err)
 		return EnvConfig()
 	}
 	return config
 }
 
-func ProfileConfig(profile string) (*gosnowflake.Config, error) {
+func defaultConfig() (*gosnowflake.Config, error) {
+	envProfile, err := EnvConfigProfile()
+	if err != nil {
+		return nil, err
+	}
+	profile, err := LoadConfigProfile(os.Getenv("SNOWFLAKE_PROFILE"))
+	if err != nil {
+		log.Printf("[DEBUG] No Snowflake config file found, using environment variables only: %v\n", err)
+	}
+	return envProfile.Merge(profile).DriverConfig()
+}
+
+// LoadConfigProfile returns the settings of a profile of the config file, or nil if there is no such profile.
+func LoadConfigProfile(profile string) (*ConfigProfile, error) {
 	configs, err := loadConfigFile()
 	if err != nil {
 		return nil, err
@@ -27,26 +329,25 @@ func ProfileConfig(profile string) (*gosnowflake.Config, error) {
 	if profile == "" {
 		profile = "default"
 	}
-	var config *gosnowflake.Config
-	if cfg, ok := configs[profile]; ok {
-		log.Printf("[DEBUG] loading config for profile: \"%s\"", profile)
-		config = cfg
-	}
-
-	if config == nil {
+	cfg, ok := configs[profile]
+	if !ok || cfg == nil {
 		log.Printf("[DEBUG] no config found for profile: \"%s\"", profile)
 		return nil, nil
 	}
+	log.Printf("[DEBUG] loading config for profile: \"%s\"", profile)
+	return cfg, nil
+}
 
-	// us-west-2 is Snowflake's default region, but if you actually specify that it won't trigger the default code
-	//  https://github.com/snowflakedb/gosnowflake/blob/52137ce8c32eaf93b0bd22fc5c7297beff339812/dsn.go#L61
-	if config.Region == "us-west-2" {
-		config.Region = ""
+func ProfileConfig(profile string) (*gosnowflake.Config, error) {
+	cfg, err := LoadConfigProfile(profile)
+	if err != nil || cfg == nil {
+		return nil, err
 	}
-
-	return config, nil
+	return cfg.DriverConfig()
 }
 
+// MergeConfig sets the settings of mergeConfig on baseConfig. Settings left at their zero value in mergeConfig
+// do not overwrite baseConfig.
 func MergeConfig(baseConfig *gosnowflake.Config, mergeConfig *gosnowflake.Config) *gosnowflake.Config {
 	if baseConfig == nil {
 		return mergeConfig
@@ -69,6 +370,72 @@ func MergeConfig(baseConfig *gosnowflake.Config, mergeConfig *gosnowflake.Config
 	if mergeConfig.Host != "" {
 		baseConfig.Host = mergeConfig.Host
 	}
+	if mergeConfig.Port != 0 {
+		baseConfig.Port = mergeConfig.Port
+	}
+	if mergeConfig.Protocol != "" {
+		baseConfig.Protocol = mergeConfig.Protocol
+	}
+	if mergeConfig.Warehouse != "" {
+		baseConfig.Warehouse = mergeConfig.Warehouse
+	}
+	if mergeConfig.Database != "" {
+		baseConfig.Database = mergeConfig.Database
+	}
+	if mergeConfig.Schema != "" {
+		baseConfig.Schema = mergeConfig.Schema
+	}
+	if mergeConfig.InsecureMode {
+		baseConfig.InsecureMode = mergeConfig.InsecureMode
+	}
+	if mergeConfig.Authenticator != gosnowflake.AuthTypeSnowflake {
+		baseConfig.Authenticator = mergeConfig.Authenticator
+	}
+	if mergeConfig.PrivateKey != nil {
+		baseConfig.PrivateKey = mergeConfig.PrivateKey
+	}
+	if mergeConfig.Token != "" {
+		baseConfig.Token = mergeConfig.Token
+	}
+	if mergeConfig.OktaURL != nil {
+		baseConfig.OktaURL = mergeConfig.OktaURL
+	}
+	if mergeConfig.Passcode != "" {
+		baseConfig.Passcode = mergeConfig.Passcode
+	}
+	if mergeConfig.PasscodeInPassword {
+		baseConfig.PasscodeInPassword = mergeConfig.PasscodeInPassword
+	}
+	if mergeConfig.JWTExpireTimeout != 0 {
+		baseConfig.JWTExpireTimeout = mergeConfig.JWTExpireTimeout
+	}
+	if mergeConfig.LoginTimeout != 0 {
+		baseConfig.LoginTimeout = mergeConfig.LoginTimeout
+	}
+	if mergeConfig.RequestTimeout != 0 {
+		baseConfig.RequestTimeout = mergeConfig.RequestTimeout
+	}
+	if mergeConfig.ClientTimeout != 0 {
+		baseConfig.ClientTimeout = mergeConfig.ClientTimeout
+	}
+	if mergeConfig.OCSPFailOpen != 0 {
+		baseConfig.OCSPFailOpen = mergeConfig.OCSPFailOpen
+	}
+	if mergeConfig.ValidateDefaultParameters != 0 {
+		baseConfig.ValidateDefaultParameters = mergeConfig.ValidateDefaultParameters
+	}
+	if mergeConfig.Tracing != "" {
+		baseConfig.Tracing = mergeConfig.Tracing
+	}
+	if mergeConfig.Application != "" {
+		baseConfig.Application = mergeConfig.Application
+	}
+	if len(mergeConfig.Params) > 0 && baseConfig.Params == nil {
+		baseConfig.Params = map[string]*string{}
+	}
+	for k, v := range mergeConfig.Params {
+		baseConfig.Params[k] = v
+	}
 	return baseConfig
 }
 
@@ -87,35 +454,100 @@ func configFile() (string, error) {
 	return filepath.Join(dir, ".snowflake", "config"), nil
 }
 
-func EnvConfig() *gosnowflake.Config {
-	config := &gosnowflake.Config{}
-
-	if account, ok := os.LookupEnv("SNOWFLAKE_ACCOUNT"); ok {
-		config.Account = account
+// EnvConfigProfile returns the settings of the SNOWFLAKE_* environment variables. The variables are named after
+// the environment variables of the provider attributes.
+func EnvConfigProfile() (*ConfigProfile, error) {
+	profile := &ConfigProfile{}
+	stringSettings := map[string]**string{
+		"SNOWFLAKE_ACCOUNT":                &profile.Account,
+		"SNOWFLAKE_ORGANIZATION_NAME":      &profile.OrganizationName,
+		"SNOWFLAKE_ACCOUNT_NAME":           &profile.AccountName,
+		"SNOWFLAKE_USER":                   &profile.User,
+		"SNOWFLAKE_PASSWORD":               &profile.Password,
+		"SNOWFLAKE_ROLE":                   &profile.Role,
+		"SNOWFLAKE_REGION":                 &profile.Region,
+		"SNOWFLAKE_HOST":                   &profile.Host,
+		"SNOWFLAKE_PROTOCOL":               &profile.Protocol,
+		"SNOWFLAKE_WAREHOUSE":              &profile.Warehouse,
+		"SNOWFLAKE_AUTHENTICATOR":          &profile.Authenticator,
+		"SNOWFLAKE_PRIVATE_KEY_PATH":       &profile.PrivateKeyPath,
+		"SNOWFLAKE_PRIVATE_KEY":            &profile.PrivateKey,
+		"SNOWFLAKE_PRIVATE_KEY_PASSPHRASE": &profile.PrivateKeyPassphrase,
+		"SNOWFLAKE_OAUTH_ACCESS_TOKEN":     &profile.OauthAccessToken,
+		"SNOWFLAKE_OAUTH_REFRESH_TOKEN":    &profile.OauthRefreshToken,
+		"SNOWFLAKE_OAUTH_CLIENT_ID":        &profile.OauthClientID,
+		"SNOWFLAKE_OAUTH_CLIENT_SECRET":    &profile.OauthClientSecret,
+		"SNOWFLAKE_OAUTH_ENDPOINT":         &profile.OauthEndpoint,
+		"SNOWFLAKE_OAUTH_REDIRECT_URL":     &profile.OauthRedirectURL,
+		"SNOWFLAKE_OAUTH_TOKEN_CACHE_PATH": &profile.OauthTokenCachePath,
+		"SNOWFLAKE_OKTA_URL":               &profile.OktaURL,
+		"SNOWFLAKE_PASSCODE":               &profile.Passcode,
+		"SNOWFLAKE_TOKEN_FILE_PATH":        &profile.TokenFilePath,
+		"SNOWFLAKE_TRACING":                &profile.Tracing,
+	}
+	for env, setting := range stringSettings {
+		if v := os.Getenv(env); v != "" {
+			*setting = String(v)
+		}
 	}
-	if user, ok := os.LookupEnv("SNOWFLAKE_USER"); ok {
-		config.User = user
+	intSettings := map[string]**int{
+		"SNOWFLAKE_PORT":               &profile.Port,
+		"SNOWFLAKE_JWT_EXPIRE_TIMEOUT": &profile.JWTExpireTimeout,
+		"SNOWFLAKE_LOGIN_TIMEOUT":      &profile.LoginTimeout,
+		"SNOWFLAKE_REQUEST_TIMEOUT":    &profile.RequestTimeout,
+		"SNOWFLAKE_CLIENT_TIMEOUT":     &profile.ClientTimeout,
 	}
-	if password, ok := os.LookupEnv("SNOWFLAKE_PASSWORD"); ok {
-		config.Password = password
+	for env, setting := range intSettings {
+		if v := os.Getenv(env); v != "" {
+			i, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %s of %s, expected a number", v, env)
+			}
+			*setting = Int(i)
+		}
 	}
-	if role, ok := os.LookupEnv("SNOWFLAKE_ROLE"); ok {
-		config.Role = role
+	boolSettings := map[string]**bool{
+		"SNOWFLAKE_INSECURE_MODE":               &profile.InsecureMode,
+		"SNOWFLAKE_USE_BROWSER_AUTH":            &profile.BrowserAuth,
+		"SNOWFLAKE_PASSCODE_IN_PASSWORD":        &profile.PasscodeInPassword,
+		"SNOWFLAKE_CLIENT_SESSION_KEEP_ALIVE":   &profile.ClientSessionKeepAlive,
+		"SNOWFLAKE_OCSP_FAIL_OPEN":              &profile.OCSPFailOpen,
+		"SNOWFLAKE_VALIDATE_DEFAULT_PARAMETERS": &profile.ValidateDefaultParameters,
 	}
-	if region, ok := os.LookupEnv("SNOWFLAKE_REGION"); ok {
-		config.Region = region
+	for env, setting := range boolSettings {
+		if v := os.Getenv(env); v != "" {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %s of %s, expected a boolean", v, env)
+			}
+			*setting = Bool(b)
+		}
 	}
-	if host, ok := os.LookupEnv("SNOWFLAKE_HOST"); ok {
-		config.Host = host
+	params, err := ParseParams(os.Getenv("SNOWFLAKE_PARAMS"))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse SNOWFLAKE_PARAMS err = %w", err)
 	}
-	if warehouse, ok := os.LookupEnv("SNOWFLAKE_WAREHOUSE"); ok {
-		config.Warehouse = warehouse
+	if len(params) > 0 {
+		profile.Params = params
 	}
+	return profile, nil
+}
 
+func EnvConfig() *gosnowflake.Config {
+	profile, err := EnvConfigProfile()
+	if err != nil {
+		log.Printf("[WARN] Could not read the Snowflake environment variables: %v\n", err)
+		return &gosnowflake.Config{}
+	}
+	config, err := profile.DriverConfig()
+	if err != nil {
+		log.Printf("[WARN] Could not build the Snowflake config from the environment variables: %v\n", err)
+		return &gosnowflake.Config{}
+	}
 	return config
 }
 
-func loadConfigFile() (map[string]*gosnowflake.Config, error) {
+func loadConfigFile() (map[string]*ConfigProfile, error) {
 	path, err := configFile()
 	if err != nil {
 		return nil, err
@@ -124,11 +556,189 @@ func loadConfigFile() (map[string]*gosnowflake.Config, error) {
 	if err != nil {
 		return nil, err
 	}
-	var s map[string]*gosnowflake.Config
-	err = toml.Unmarshal(dat, &s)
+	var profiles map[string]map[string]interface{}
+	if err := toml.Unmarshal(dat, &profiles); err != nil {
+		return nil, fmt.Errorf("could not parse config file %s err = %w", path, err)
+	}
+	for _, profile := range profiles {
+		renameLegacyConfigKeys(profile)
+	}
+	// the profiles are decoded again after renaming the legacy keys, so that unknown keys are reported
+	dat, err = toml.Marshal(profiles)
 	if err != nil {
-		log.Printf("[DEBUG] error unmarshalling config file: %v\n", err)
-		return nil, nil
+		return nil, fmt.Errorf("could not parse config file %s err = %w", path, err)
+	}
+	var s map[string]*ConfigProfile
+	err = toml.NewDecoder(bytes.NewReader(dat)).DisallowUnknownFields().Decode(&s)
+	var strictErr *toml.StrictMissingError
+	if errors.As(err, &strictErr) {
+		keys := make([]string, 0, len(strictErr.Errors))
+		for _, e := range strictErr.Errors {
+			keys = append(keys, strings.Join(e.Key(), "."))
+		}
+		return nil, fmt.Errorf("could not parse config file %s, unknown keys %s", path, strings.Join(keys, ", "))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not parse config file %s err = %w", path, err)
 	}
 	return s, nil
 }
+
+// legacyConfigKeys maps the keys of profiles written for earlier versions, which were read into the driver
+// configuration by its case-insensitive field names, to the keys of ConfigProfile.
+var legacyConfigKeys = map[string]string{
+	"insecuremode":              "insecure_mode",
+	"passcodeinpassword":        "passcode_in_password",
+	"token":                     "oauth_access_token",
+	"ocspfailopen":              "ocsp_fail_open",
+	"validatedefaultparameters": "validate_default_parameters",
+	"logintimeout":              "login_timeout",
+	"requesttimeout":            "request_timeout",
+	"jwtexpiretimeout":          "jwt_expire_timeout",
+	"clienttimeout":             "client_timeout",
+}
+
+// renameLegacyConfigKeys renames the legacy keys of the profile, unless the profile also sets the current key.
+// The legacy timeouts were durations in nanoseconds and the legacy OCSP and validation settings were the driver
+// constants, 1 for true and 2 for false, so their values are converted as well.
+func renameLegacyConfigKeys(profile map[string]interface{}) {
+	for key, v := range profile {
+		newKey, ok := legacyConfigKeys[strings.ToLower(key)]
+		if !ok {
+			continue
+		}
+		delete(profile, key)
+		if _, ok := profile[newKey]; ok {
+			continue
+		}
+		switch n := v.(type) {
+		case int64:
+			if strings.HasSuffix(newKey, "_timeout") {
+				v = int64(time.Duration(n) / time.Second)
+			} else {
+				v = n == 1
+			}
+		}
+		profile[newKey] = v
+	}
+}
+
+// ParseParams parses a comma separated list of key=value pairs, e.g. QUERY_TAG=terraform,TIMEZONE=UTC.
+func ParseParams(s string) (map[string]string, error) {
+	params := map[string]string{}
+	if strings.TrimSpace(s) == "" {
+		return params, nil
+	}
+	for _, pair := range strings.Split(s, ",") {
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(k) == "" {
+			return nil, fmt.Errorf("invalid parameter %s, expected key=value", pair)
+		}
+		params[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	return params, nil
+}
+
+// AuthenticatorValues are the gosnowflake authenticators that can be passed to the driver in a DSN.
+// TOKENACCESSOR is left out, as it needs a token accessor living in the same process as the driver.
+var AuthenticatorValues = []string{
+	gosnowflake.AuthTypeSnowflake.String(),
+	gosnowflake.AuthTypeOAuth.String(),
+	gosnowflake.AuthTypeExternalBrowser.String(),
+	gosnowflake.AuthTypeOkta.String(),
+	gosnowflake.AuthTypeJwt.String(),
+	gosnowflake.AuthTypeUsernamePasswordMFA.String(),
+}
+
+// ToAuthenticatorType maps the name of an authenticator to its gosnowflake AuthType.
+func ToAuthenticatorType(authenticator string) (gosnowflake.AuthType, error) {
+	switch strings.ToUpper(authenticator) {
+	case gosnowflake.AuthTypeSnowflake.String():
+		return gosnowflake.AuthTypeSnowflake, nil
+	case gosnowflake.AuthTypeOAuth.String():
+		return gosnowflake.AuthTypeOAuth, nil
+	case gosnowflake.AuthTypeExternalBrowser.String():
+		return gosnowflake.AuthTypeExternalBrowser, nil
+	case gosnowflake.AuthTypeOkta.String():
+		return gosnowflake.AuthTypeOkta, nil
+	case gosnowflake.AuthTypeJwt.String():
+		return gosnowflake.AuthTypeJwt, nil
+	case gosnowflake.AuthTypeUsernamePasswordMFA.String():
+		return gosnowflake.AuthTypeUsernamePasswordMFA, nil
+	}
+	return gosnowflake.AuthTypeSnowflake, fmt.Errorf("invalid authenticator %s, expected one of %s", authenticator, strings.Join(AuthenticatorValues, ", "))
+}
+
+func ReadTokenFile(tokenFilePath string) (string, error) {
+	expandedTokenFilePath, err := homedir.Expand(tokenFilePath)
+	if err != nil {
+		return "", fmt.Errorf("invalid path to token file err = %w", err)
+	}
+	token, err := os.ReadFile(expandedTokenFilePath)
+	if err != nil {
+		return "", fmt.Errorf("could not read token file err = %w", err)
+	}
+	if len(strings.TrimSpace(string(token))) == 0 {
+		return "", errors.New("token file is empty")
+	}
+	return strings.TrimSpace(string(token)), nil
+}
+
+func ReadPrivateKeyFile(privateKeyPath string) ([]byte, error) {
+	expandedPrivateKeyPath, err := homedir.Expand(privateKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Path to private key err = %w", err)
+	}
+
+	privateKeyBytes, err := os.ReadFile(expandedPrivateKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not read private key err = %w", err)
+	}
+
+	if len(privateKeyBytes) == 0 {
+		return nil, errors.New("private key is empty")
+	}
+
+	return privateKeyBytes, nil
+}
+
+func ParsePrivateKey(privateKeyBytes []byte, passhrase []byte) (*rsa.PrivateKey, error) {
+	privateKeyBlock, _ := pem.Decode(privateKeyBytes)
+	if privateKeyBlock == nil {
+		return nil, fmt.Errorf("could not parse private key, key is not in PEM format")
+	}
+
+	var privateKey interface{}
+	var err error
+	switch privateKeyBlock.Type {
+	case "ENCRYPTED PRIVATE KEY":
+		if len(passhrase) == 0 {
+			return nil, fmt.Errorf("private key requires a passphrase, but private_key_passphrase was not supplied")
+		}
+		privateKey, err = pkcs8.ParsePKCS8PrivateKey(privateKeyBlock.Bytes, passhrase)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse encrypted private key with passphrase, only ciphers aes-128-cbc, aes-128-gcm, aes-192-cbc, aes-192-gcm, aes-256-cbc, aes-256-gcm, and des-ede3-cbc are supported err = %w", err)
+		}
+	case "PRIVATE KEY":
+		// PKCS#8 can hold RSA, ECDSA and ed25519 keys alike
+		privateKey, err = x509.ParsePKCS8PrivateKey(privateKeyBlock.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse PKCS#8 private key err = %w", err)
+		}
+	default:
+		privateKey, err = ssh.ParseRawPrivateKey(privateKeyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse private key err = %w", err)
+		}
+	}
+
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		return key, nil
+	case *ecdsa.PrivateKey:
+		// the driver signs the JWT with RS256, and Snowflake only accepts RSA public keys for key pair authentication
+		return nil, fmt.Errorf("private key is an ECDSA key on curve %s, but Snowflake key pair authentication requires an RSA key", key.Curve.Params().Name)
+	default:
+		return nil, fmt.Errorf("private key of type %T is not supported, Snowflake key pair authentication requires an RSA key", privateKey)
+	}
+}
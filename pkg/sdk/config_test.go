@@ -1,12 +1,20 @@
 package sdk
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/snowflakedb/gosnowflake"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/youmark/pkcs8"
 )
 
 func TestLoadConfigFile(t *testing.T) {
@@ -28,14 +36,64 @@ func TestLoadConfigFile(t *testing.T) {
 	t.Cleanup(cleanupEnvVars)
 	m, err := loadConfigFile()
 	require.NoError(t, err)
-	assert.Equal(t, "TEST_ACCOUNT", m["default"].Account)
-	assert.Equal(t, "TEST_USER", m["default"].User)
-	assert.Equal(t, "abcd1234", m["default"].Password)
-	assert.Equal(t, "ACCOUNTADMIN", m["default"].Role)
-	assert.Equal(t, "TEST_ACCOUNT", m["securityadmin"].Account)
-	assert.Equal(t, "TEST_USER", m["securityadmin"].User)
-	assert.Equal(t, "abcd1234", m["securityadmin"].Password)
-	assert.Equal(t, "SECURITYADMIN", m["securityadmin"].Role)
+	assert.Equal(t, "TEST_ACCOUNT", *m["default"].Account)
+	assert.Equal(t, "TEST_USER", *m["default"].User)
+	assert.Equal(t, "abcd1234", *m["default"].Password)
+	assert.Equal(t, "ACCOUNTADMIN", *m["default"].Role)
+	assert.Equal(t, "TEST_ACCOUNT", *m["securityadmin"].Account)
+	assert.Equal(t, "TEST_USER", *m["securityadmin"].User)
+	assert.Equal(t, "abcd1234", *m["securityadmin"].Password)
+	assert.Equal(t, "SECURITYADMIN", *m["securityadmin"].Role)
+}
+
+func TestLoadConfigFileLegacyKeys(t *testing.T) {
+	// profiles of earlier versions were read into the driver configuration by its field names
+	c := `
+	[default]
+	Account='TEST_ACCOUNT'
+	user='TEST_USER'
+	database='TEST_DB'
+	schema='TEST_SCHEMA'
+	InsecureMode=true
+	token='TEST_TOKEN'
+	logintimeout=30000000000
+	ocspfailopen=2
+	request_timeout=10
+	requesttimeout=60000000000
+	`
+	configPath := testFile(t, "config", []byte(c))
+	cleanupEnvVars := setupEnvVars(t, "", "", "", "", configPath)
+	t.Cleanup(cleanupEnvVars)
+	m, err := loadConfigFile()
+	require.NoError(t, err)
+	profile := m["default"]
+	assert.Equal(t, "TEST_ACCOUNT", *profile.Account)
+	assert.Equal(t, "TEST_DB", *profile.Database)
+	assert.Equal(t, "TEST_SCHEMA", *profile.Schema)
+	assert.True(t, *profile.InsecureMode)
+	assert.Equal(t, "TEST_TOKEN", *profile.OauthAccessToken)
+	assert.Equal(t, 30, *profile.LoginTimeout)
+	assert.False(t, *profile.OCSPFailOpen)
+	// the current key takes precedence over the legacy one
+	assert.Equal(t, 10, *profile.RequestTimeout)
+
+	config, err := profile.DriverConfig()
+	require.NoError(t, err)
+	assert.Equal(t, "TEST_DB", config.Database)
+	assert.Equal(t, "TEST_SCHEMA", config.Schema)
+}
+
+func TestLoadConfigFileUnknownKeys(t *testing.T) {
+	c := `
+	[default]
+	account='TEST_ACCOUNT'
+	application='terraform'
+	`
+	configPath := testFile(t, "config", []byte(c))
+	cleanupEnvVars := setupEnvVars(t, "", "", "", "", configPath)
+	t.Cleanup(cleanupEnvVars)
+	_, err := loadConfigFile()
+	require.ErrorContains(t, err, "unknown keys default.application")
 }
 
 func TestProfileConfig(t *testing.T) {
@@ -68,6 +126,255 @@ func TestProfileConfig(t *testing.T) {
 	})
 }
 
+func TestLoadConfigProfile(t *testing.T) {
+	c := `
+	[dev]
+	account='TEST_ACCOUNT'
+	user='TEST_USER'
+	warehouse='TEST_WAREHOUSE'
+	private_key_path='~/.ssh/snowflake_key.p8'
+	private_key_passphrase='passphrase'
+	authenticator='SNOWFLAKE_JWT'
+	port=8443
+	protocol='http'
+	insecure_mode=true
+	oauth_client_id='client'
+	ocsp_fail_open=false
+	params={ QUERY_TAG='terraform' }
+	`
+	configPath := testFile(t, "config", []byte(c))
+	t.Setenv("SNOWFLAKE_CONFIG_PATH", configPath)
+
+	profile, err := LoadConfigProfile("dev")
+	require.NoError(t, err)
+	assert.Equal(t, &ConfigProfile{
+		Account:              String("TEST_ACCOUNT"),
+		User:                 String("TEST_USER"),
+		Warehouse:            String("TEST_WAREHOUSE"),
+		PrivateKeyPath:       String("~/.ssh/snowflake_key.p8"),
+		PrivateKeyPassphrase: String("passphrase"),
+		Authenticator:        String("SNOWFLAKE_JWT"),
+		Port:                 Int(8443),
+		Protocol:             String("http"),
+		InsecureMode:         Bool(true),
+		OauthClientID:        String("client"),
+		OCSPFailOpen:         Bool(false),
+		Params:               map[string]string{"QUERY_TAG": "terraform"},
+	}, profile)
+}
+
+func TestMergeConfigProfile(t *testing.T) {
+	profile := &ConfigProfile{
+		Account:      String("ACCOUNT"),
+		Role:         String("ROLE"),
+		InsecureMode: Bool(false),
+		Params:       map[string]string{"QUERY_TAG": "first"},
+	}
+	other := &ConfigProfile{
+		Account:      String("OTHER_ACCOUNT"),
+		User:         String("OTHER_USER"),
+		InsecureMode: Bool(true),
+		Port:         Int(8443),
+		Params:       map[string]string{"query_tag": "other", "TIMEZONE": "UTC"},
+	}
+	assert.Equal(t, &ConfigProfile{
+		Account:      String("ACCOUNT"),
+		User:         String("OTHER_USER"),
+		Role:         String("ROLE"),
+		InsecureMode: Bool(false),
+		Port:         Int(8443),
+		Params:       map[string]string{"query_tag": "first", "timezone": "UTC"},
+	}, profile.Merge(other))
+	assert.Equal(t, other, (*ConfigProfile)(nil).Merge(other))
+
+	named := &ConfigProfile{OrganizationName: String("ORG"), AccountName: String("ACCOUNT")}
+	assert.Equal(t, named, named.Merge(&ConfigProfile{Account: String("OTHER_ACCOUNT")}))
+	assert.Equal(t, profile.Account, profile.Merge(named).Account)
+	assert.Nil(t, profile.Merge(named).OrganizationName)
+	assert.Equal(t, profile, profile.Merge(nil))
+
+	keyPair := &ConfigProfile{PrivateKeyPath: String("/tmp/rsa_key.p8"), PrivateKeyPassphrase: String("passphrase"), Role: String("KEY_ROLE")}
+	withPassword := (&ConfigProfile{Password: String("secret")}).Merge(keyPair)
+	assert.Equal(t, "secret", *withPassword.Password)
+	assert.Nil(t, withPassword.PrivateKeyPath)
+	assert.Nil(t, withPassword.PrivateKeyPassphrase)
+	assert.Equal(t, "KEY_ROLE", *withPassword.Role)
+	assert.Equal(t, keyPair.PrivateKeyPath, profile.Merge(keyPair).PrivateKeyPath)
+}
+
+func TestDriverConfig(t *testing.T) {
+	profile := func() *ConfigProfile {
+		return &ConfigProfile{
+			Account:  String("acct"),
+			User:     String("user"),
+			Password: String("pass"),
+		}
+	}
+
+	t.Run("okta", func(t *testing.T) {
+		p := profile()
+		p.Authenticator = String("OKTA")
+		p.OktaURL = String("https://example.okta.com")
+		config, err := p.DriverConfig()
+		require.NoError(t, err)
+		dsn, err := gosnowflake.DSN(config)
+		require.NoError(t, err)
+		require.Contains(t, dsn, "authenticator=https%3A%2F%2Fexample.okta.com")
+	})
+
+	t.Run("okta without url", func(t *testing.T) {
+		p := profile()
+		p.Authenticator = String("OKTA")
+		_, err := p.DriverConfig()
+		require.Error(t, err)
+	})
+
+	t.Run("username password mfa", func(t *testing.T) {
+		p := profile()
+		p.Authenticator = String("USERNAME_PASSWORD_MFA")
+		p.Passcode = String("123456")
+		config, err := p.DriverConfig()
+		require.NoError(t, err)
+		dsn, err := gosnowflake.DSN(config)
+		require.NoError(t, err)
+		require.Contains(t, dsn, "authenticator=username_password_mfa")
+		require.Contains(t, dsn, "passcode=123456")
+	})
+
+	t.Run("jwt without private key", func(t *testing.T) {
+		p := profile()
+		p.Authenticator = String("SNOWFLAKE_JWT")
+		p.JWTExpireTimeout = Int(120)
+		_, err := p.DriverConfig()
+		require.Error(t, err)
+	})
+
+	t.Run("jwt with private key file", func(t *testing.T) {
+		rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		der, err := x509.MarshalPKCS8PrivateKey(rsaKey)
+		require.NoError(t, err)
+		p := profile()
+		p.PrivateKeyPath = String(testFile(t, "rsa_key.p8", pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})))
+		config, err := p.DriverConfig()
+		require.NoError(t, err)
+		require.Equal(t, gosnowflake.AuthTypeJwt, config.Authenticator)
+		require.True(t, rsaKey.Equal(config.PrivateKey))
+		require.Empty(t, config.Password)
+	})
+
+	t.Run("token from file", func(t *testing.T) {
+		p := profile()
+		p.TokenFilePath = String(testFile(t, "token", []byte("secret-token\n")))
+		config, err := p.DriverConfig()
+		require.NoError(t, err)
+		require.Equal(t, gosnowflake.AuthTypeOAuth, config.Authenticator)
+		require.Equal(t, "secret-token", config.Token)
+	})
+
+	t.Run("organization and account names", func(t *testing.T) {
+		p := profile()
+		p.OrganizationName = String("myorg")
+		p.AccountName = String("myaccount")
+		p.Region = String("eu-central-1")
+		config, err := p.DriverConfig()
+		require.NoError(t, err)
+		dsn, err := gosnowflake.DSN(config)
+		require.NoError(t, err)
+		require.Equal(t, "user:pass@myorg-myaccount.snowflakecomputing.com:443?ocspFailOpen=true&validateDefaultParameters=true", dsn)
+	})
+
+	t.Run("organization name without account name", func(t *testing.T) {
+		p := profile()
+		p.OrganizationName = String("myorg")
+		_, err := p.DriverConfig()
+		require.ErrorContains(t, err, "organization_name and account_name must be set together")
+	})
+
+	t.Run("connection settings", func(t *testing.T) {
+		p := profile()
+		p.LoginTimeout = Int(120)
+		p.RequestTimeout = Int(30)
+		p.ClientTimeout = Int(600)
+		p.ClientSessionKeepAlive = Bool(true)
+		p.OCSPFailOpen = Bool(false)
+		p.ValidateDefaultParameters = Bool(false)
+		p.Tracing = String("DEBUG")
+		p.Params = map[string]string{"QUERY_TAG": "terraform"}
+		config, err := p.DriverConfig()
+		require.NoError(t, err)
+
+		dsn, err := gosnowflake.DSN(config)
+		require.NoError(t, err)
+		require.Equal(t, "user:pass@acct.snowflakecomputing.com:443?clientTimeout=600&client_session_keep_alive=true&loginTimeout=120&ocspFailOpen=false&query_tag=terraform&requestTimeout=30&tracing=debug&validateDefaultParameters=false", dsn)
+
+		parsed, err := gosnowflake.ParseDSN(dsn)
+		require.NoError(t, err)
+		require.Equal(t, "terraform", *parsed.Params["query_tag"])
+	})
+}
+
+func TestDefaultConfig(t *testing.T) {
+	c := `
+	[default]
+	account='DEFAULT_ACCOUNT'
+	user='DEFAULT_USER'
+	password='abcd1234'
+
+	[dev]
+	account='DEV_ACCOUNT'
+	user='DEV_USER'
+	password='abcd1234'
+	warehouse='DEV_WAREHOUSE'
+	`
+	configPath := testFile(t, "config", []byte(c))
+	cleanupEnvVars := setupEnvVars(t, "", "", "", "ENV_ROLE", configPath)
+	t.Cleanup(cleanupEnvVars)
+
+	t.Run("default profile", func(t *testing.T) {
+		t.Setenv("SNOWFLAKE_PROFILE", "")
+		config := DefaultConfig()
+		assert.Equal(t, "DEFAULT_ACCOUNT", config.Account)
+		assert.Equal(t, "ENV_ROLE", config.Role)
+	})
+
+	t.Run("profile from environment", func(t *testing.T) {
+		t.Setenv("SNOWFLAKE_PROFILE", "dev")
+		t.Setenv("SNOWFLAKE_USER", "ENV_USER")
+		config := DefaultConfig()
+		assert.Equal(t, "DEV_ACCOUNT", config.Account)
+		assert.Equal(t, "ENV_USER", config.User)
+		assert.Equal(t, "DEV_WAREHOUSE", config.Warehouse)
+		assert.Equal(t, "ENV_ROLE", config.Role)
+	})
+}
+
+func TestMergeConfig(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	config := MergeConfig(&gosnowflake.Config{
+		Account:   "ACCOUNT",
+		Warehouse: "WAREHOUSE",
+		Params:    map[string]*string{"query_tag": String("base")},
+	}, &gosnowflake.Config{
+		User:          "USER",
+		Port:          8443,
+		Protocol:      "http",
+		Authenticator: gosnowflake.AuthTypeJwt,
+		PrivateKey:    rsaKey,
+		Params:        map[string]*string{"timezone": String("UTC")},
+	})
+	assert.Equal(t, "ACCOUNT", config.Account)
+	assert.Equal(t, "USER", config.User)
+	assert.Equal(t, "WAREHOUSE", config.Warehouse)
+	assert.Equal(t, 8443, config.Port)
+	assert.Equal(t, "http", config.Protocol)
+	assert.Equal(t, gosnowflake.AuthTypeJwt, config.Authenticator)
+	assert.Equal(t, rsaKey, config.PrivateKey)
+	assert.Equal(t, "base", *config.Params["query_tag"])
+	assert.Equal(t, "UTC", *config.Params["timezone"])
+}
+
 func TestEnvConfig(t *testing.T) {
 	t.Run("with no environment variables", func(t *testing.T) {
 		cleanupEnvVars := setupEnvVars(t, "", "", "", "", "")
@@ -90,6 +397,92 @@ func TestEnvConfig(t *testing.T) {
 	})
 }
 
+func TestEnvConfigProfile(t *testing.T) {
+	t.Setenv("SNOWFLAKE_WAREHOUSE", "WAREHOUSE")
+	t.Setenv("SNOWFLAKE_AUTHENTICATOR", "OKTA")
+	t.Setenv("SNOWFLAKE_OKTA_URL", "https://example.okta.com")
+	t.Setenv("SNOWFLAKE_PORT", "8443")
+	t.Setenv("SNOWFLAKE_INSECURE_MODE", "true")
+	t.Setenv("SNOWFLAKE_PARAMS", "QUERY_TAG=terraform")
+
+	profile, err := EnvConfigProfile()
+	require.NoError(t, err)
+	assert.Equal(t, "WAREHOUSE", *profile.Warehouse)
+	assert.Equal(t, "OKTA", *profile.Authenticator)
+	assert.Equal(t, "https://example.okta.com", *profile.OktaURL)
+	assert.Equal(t, 8443, *profile.Port)
+	assert.True(t, *profile.InsecureMode)
+	assert.Equal(t, map[string]string{"QUERY_TAG": "terraform"}, profile.Params)
+
+	t.Setenv("SNOWFLAKE_PORT", "https")
+	_, err = EnvConfigProfile()
+	require.Error(t, err)
+}
+
+func TestToAuthenticatorType(t *testing.T) {
+	for _, tt := range []struct {
+		authenticator string
+		want          gosnowflake.AuthType
+	}{
+		{"snowflake", gosnowflake.AuthTypeSnowflake},
+		{"OAUTH", gosnowflake.AuthTypeOAuth},
+		{"ExternalBrowser", gosnowflake.AuthTypeExternalBrowser},
+		{"OKTA", gosnowflake.AuthTypeOkta},
+		{"SNOWFLAKE_JWT", gosnowflake.AuthTypeJwt},
+		{"username_password_mfa", gosnowflake.AuthTypeUsernamePasswordMFA},
+	} {
+		got, err := ToAuthenticatorType(tt.authenticator)
+		require.NoError(t, err)
+		require.Equal(t, tt.want, got)
+	}
+
+	_, err := ToAuthenticatorType("TOKENACCESSOR")
+	require.Error(t, err)
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	t.Run("unencrypted PKCS#8 RSA key", func(t *testing.T) {
+		der, err := x509.MarshalPKCS8PrivateKey(rsaKey)
+		require.NoError(t, err)
+		key, err := ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil)
+		require.NoError(t, err)
+		require.True(t, rsaKey.Equal(key))
+	})
+
+	t.Run("encrypted PKCS#8 RSA key", func(t *testing.T) {
+		der, err := pkcs8.MarshalPrivateKey(rsaKey, []byte("passphrase"), nil)
+		require.NoError(t, err)
+		key, err := ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: der}), []byte("passphrase"))
+		require.NoError(t, err)
+		require.True(t, rsaKey.Equal(key))
+	})
+
+	t.Run("PKCS#8 ECDSA key", func(t *testing.T) {
+		ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		der, err := pkcs8.MarshalPrivateKey(ecdsaKey, []byte("passphrase"), nil)
+		require.NoError(t, err)
+		_, err = ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: der}), []byte("passphrase"))
+		require.ErrorContains(t, err, "ECDSA key on curve P-256")
+	})
+}
+
+func TestParseParams(t *testing.T) {
+	params, err := ParseParams("QUERY_TAG=terraform, TIMEZONE=UTC")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"QUERY_TAG": "terraform", "TIMEZONE": "UTC"}, params)
+
+	params, err = ParseParams("")
+	require.NoError(t, err)
+	require.Empty(t, params)
+
+	_, err = ParseParams("QUERY_TAG")
+	require.Error(t, err)
+}
+
 func testFile(t *testing.T, filename string, dat []byte) string {
 	t.Helper()
 	path := filepath.Join(t.TempDir(), filename)
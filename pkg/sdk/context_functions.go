@@ -13,6 +13,8 @@ type ContextFunctions interface {
 	CurrentRegion(ctx context.Context) (string, error)
 	CurrentSession(ctx context.Context) (string, error)
 	CurrentUser(ctx context.Context) (string, error)
+	CurrentSessionDetails(ctx context.Context) (*CurrentSessionDetails, error)
+	CurrentAccountEdition(ctx context.Context) (AccountEdition, error)
 
 	// Session Object functions.
 	CurrentDatabase(ctx context.Context) (string, error)
@@ -82,6 +84,38 @@ func (c *contextFunctions) CurrentUser(ctx context.Context) (string, error) {
 	return s.CurrentUser, nil
 }
 
+// CurrentSessionDetails contains the session function values that are commonly needed together.
+type CurrentSessionDetails struct {
+	Account string `db:"CURRENT_ACCOUNT"`
+	Role    string `db:"CURRENT_ROLE"`
+	Region  string `db:"CURRENT_REGION"`
+	Session string `db:"CURRENT_SESSION"`
+	User    string `db:"CURRENT_USER"`
+}
+
+// CurrentSessionDetails retrieves the account locator, role, region, session and user in a single query.
+func (c *contextFunctions) CurrentSessionDetails(ctx context.Context) (*CurrentSessionDetails, error) {
+	s := &CurrentSessionDetails{}
+	err := c.client.queryOne(ctx, s, "SELECT CURRENT_ACCOUNT() as CURRENT_ACCOUNT, CURRENT_ROLE() as CURRENT_ROLE, CURRENT_REGION() as CURRENT_REGION, CURRENT_SESSION() as CURRENT_SESSION, CURRENT_USER() as CURRENT_USER")
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// CurrentAccountEdition retrieves the edition of the current account from the bootstrap data of the session, which
+// unlike SHOW ORGANIZATION ACCOUNTS does not need ORGADMIN. The edition is empty if the bootstrap data has none.
+func (c *contextFunctions) CurrentAccountEdition(ctx context.Context) (AccountEdition, error) {
+	s := &struct {
+		Edition sql.NullString `db:"EDITION"`
+	}{}
+	err := c.client.queryOne(ctx, s, "SELECT PARSE_JSON(SYSTEM$BOOTSTRAP_DATA_REQUEST('ACCOUNT')):accountInfo.serviceLevelName::VARCHAR AS EDITION")
+	if err != nil {
+		return "", err
+	}
+	return AccountEdition(s.Edition.String), nil
+}
+
 func (c *contextFunctions) CurrentDatabase(ctx context.Context) (string, error) {
 	s := &struct {
 		CurrentDatabase sql.NullString `db:"CURRENT_DATABASE"`
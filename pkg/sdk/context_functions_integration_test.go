@@ -49,6 +49,20 @@ func TestInt_CurrentUser(t *testing.T) {
 	assert.NotEmpty(t, user)
 }
 
+func TestInt_CurrentSessionDetails(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	details, err := client.ContextFunctions.CurrentSessionDetails(ctx)
+	require.NoError(t, err)
+	account, err := client.ContextFunctions.CurrentAccount(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, account, details.Account)
+	assert.NotEmpty(t, details.Role)
+	assert.NotEmpty(t, details.Region)
+	assert.NotEmpty(t, details.Session)
+	assert.NotEmpty(t, details.User)
+}
+
 func TestInt_CurrentDatabase(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -98,3 +112,11 @@ func TestInt_IsRoleInSession(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, role)
 }
+
+func TestInt_CurrentAccountEdition(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	edition, err := client.ContextFunctions.CurrentAccountEdition(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, []AccountEdition{EditionStandard, EditionEnterprise, EditionBusinessCritical}, edition)
+}
@@ -0,0 +1,245 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+type DatabaseRoles interface {
+	// Create creates a database role.
+	Create(ctx context.Context, id DatabaseObjectIdentifier, opts *CreateDatabaseRoleOptions) error
+	// Drop removes a database role.
+	Drop(ctx context.Context, id DatabaseObjectIdentifier, opts *DropDatabaseRoleOptions) error
+	// Grant grants a database role to an account role or to another database role.
+	Grant(ctx context.Context, id DatabaseObjectIdentifier, opts *DatabaseRoleGrantOptions) error
+	// Revoke revokes a database role from an account role or from another database role.
+	Revoke(ctx context.Context, id DatabaseObjectIdentifier, opts *DatabaseRoleRevokeOptions) error
+	// GrantToShare grants a database role to a share.
+	GrantToShare(ctx context.Context, id DatabaseObjectIdentifier, share AccountObjectIdentifier) error
+	// RevokeFromShare revokes a database role from a share.
+	RevokeFromShare(ctx context.Context, id DatabaseObjectIdentifier, share AccountObjectIdentifier) error
+}
+
+var _ DatabaseRoles = (*databaseRoles)(nil)
+
+type databaseRoles struct {
+	client *Client
+}
+
+// CreateDatabaseRoleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-database-role.
+type CreateDatabaseRoleOptions struct {
+	create       bool                     `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace    *bool                    `ddl:"keyword" sql:"OR REPLACE"`
+	databaseRole bool                     `ddl:"static" sql:"DATABASE ROLE"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists  *bool                    `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name         DatabaseObjectIdentifier `ddl:"identifier"`
+	Comment      *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateDatabaseRoleOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	return nil
+}
+
+func (v *databaseRoles) Create(ctx context.Context, id DatabaseObjectIdentifier, opts *CreateDatabaseRoleOptions) error {
+	if opts == nil {
+		opts = &CreateDatabaseRoleOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// DropDatabaseRoleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-database-role.
+type DropDatabaseRoleOptions struct {
+	drop         bool                     `ddl:"static" sql:"DROP"`          //lint:ignore U1000 This is used in the ddl tag
+	databaseRole bool                     `ddl:"static" sql:"DATABASE ROLE"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists     *bool                    `ddl:"keyword" sql:"IF EXISTS"`
+	name         DatabaseObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropDatabaseRoleOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *databaseRoles) Drop(ctx context.Context, id DatabaseObjectIdentifier, opts *DropDatabaseRoleOptions) error {
+	if opts == nil {
+		opts = &DropDatabaseRoleOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// DatabaseRoleGrantOptions is based on https://docs.snowflake.com/en/sql-reference/sql/grant-database-role.
+type DatabaseRoleGrantOptions struct {
+	grant        bool                     `ddl:"static" sql:"GRANT"`         //lint:ignore U1000 This is used in the ddl tag
+	databaseRole bool                     `ddl:"static" sql:"DATABASE ROLE"` //lint:ignore U1000 This is used in the ddl tag
+	name         DatabaseObjectIdentifier `ddl:"identifier"`
+	Grant        GrantDatabaseRole        `ddl:"keyword,no_parentheses" sql:"TO"`
+}
+
+type GrantDatabaseRole struct {
+	// DatabaseRole is the parent database role the database role is granted to.
+	DatabaseRole DatabaseObjectIdentifier `ddl:"identifier" sql:"DATABASE ROLE"`
+	// Role is the parent account role the database role is granted to.
+	Role AccountObjectIdentifier `ddl:"identifier" sql:"ROLE"`
+}
+
+func (v *GrantDatabaseRole) validate() error {
+	if !exactlyOneValueSet(v.DatabaseRole, v.Role) {
+		return fmt.Errorf("exactly one of database role or role must be set")
+	}
+	return nil
+}
+
+func (opts *DatabaseRoleGrantOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return opts.Grant.validate()
+}
+
+func (v *databaseRoles) Grant(ctx context.Context, id DatabaseObjectIdentifier, opts *DatabaseRoleGrantOptions) error {
+	if opts == nil {
+		opts = &DatabaseRoleGrantOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// DatabaseRoleRevokeOptions is based on https://docs.snowflake.com/en/sql-reference/sql/revoke-database-role.
+type DatabaseRoleRevokeOptions struct {
+	revoke       bool                     `ddl:"static" sql:"REVOKE"`        //lint:ignore U1000 This is used in the ddl tag
+	databaseRole bool                     `ddl:"static" sql:"DATABASE ROLE"` //lint:ignore U1000 This is used in the ddl tag
+	name         DatabaseObjectIdentifier `ddl:"identifier"`
+	Revoke       RevokeDatabaseRole       `ddl:"keyword,no_parentheses" sql:"FROM"`
+}
+
+type RevokeDatabaseRole struct {
+	// DatabaseRole is the parent database role the database role is revoked from.
+	DatabaseRole DatabaseObjectIdentifier `ddl:"identifier" sql:"DATABASE ROLE"`
+	// Role is the parent account role the database role is revoked from.
+	Role AccountObjectIdentifier `ddl:"identifier" sql:"ROLE"`
+}
+
+func (v *RevokeDatabaseRole) validate() error {
+	if !exactlyOneValueSet(v.DatabaseRole, v.Role) {
+		return fmt.Errorf("exactly one of database role or role must be set")
+	}
+	return nil
+}
+
+func (opts *DatabaseRoleRevokeOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return opts.Revoke.validate()
+}
+
+func (v *databaseRoles) Revoke(ctx context.Context, id DatabaseObjectIdentifier, opts *DatabaseRoleRevokeOptions) error {
+	if opts == nil {
+		opts = &DatabaseRoleRevokeOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type grantDatabaseRoleToShareOptions struct {
+	grant        bool                     `ddl:"static" sql:"GRANT"`         //lint:ignore U1000 This is used in the ddl tag
+	databaseRole bool                     `ddl:"static" sql:"DATABASE ROLE"` //lint:ignore U1000 This is used in the ddl tag
+	name         DatabaseObjectIdentifier `ddl:"identifier"`
+	share        AccountObjectIdentifier  `ddl:"identifier" sql:"TO SHARE"`
+}
+
+func (opts *grantDatabaseRoleToShareOptions) validate() error {
+	if !validObjectidentifier(opts.name) || !validObjectidentifier(opts.share) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *databaseRoles) GrantToShare(ctx context.Context, id DatabaseObjectIdentifier, share AccountObjectIdentifier) error {
+	opts := &grantDatabaseRoleToShareOptions{
+		name:  id,
+		share: share,
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type revokeDatabaseRoleFromShareOptions struct {
+	revoke       bool                     `ddl:"static" sql:"REVOKE"`        //lint:ignore U1000 This is used in the ddl tag
+	databaseRole bool                     `ddl:"static" sql:"DATABASE ROLE"` //lint:ignore U1000 This is used in the ddl tag
+	name         DatabaseObjectIdentifier `ddl:"identifier"`
+	share        AccountObjectIdentifier  `ddl:"identifier" sql:"FROM SHARE"`
+}
+
+func (opts *revokeDatabaseRoleFromShareOptions) validate() error {
+	if !validObjectidentifier(opts.name) || !validObjectidentifier(opts.share) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *databaseRoles) RevokeFromShare(ctx context.Context, id DatabaseObjectIdentifier, share AccountObjectIdentifier) error {
+	opts := &revokeDatabaseRoleFromShareOptions{
+		name:  id,
+		share: share,
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
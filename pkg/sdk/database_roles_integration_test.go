@@ -0,0 +1,84 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_DatabaseRolesGrantAndRevoke(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	databaseRole, databaseRoleCleanup := createDatabaseRole(t, client, database)
+	t.Cleanup(databaseRoleCleanup)
+
+	showGrantees := func(t *testing.T) []string {
+		t.Helper()
+		grants, err := client.Grants.Show(ctx, &ShowGrantOptions{
+			Of: &ShowGrantsOf{
+				DatabaseRole: databaseRole,
+			},
+		})
+		require.NoError(t, err)
+		grantees := make([]string, len(grants))
+		for i, grant := range grants {
+			grantees[i] = grant.GranteeName.Name()
+		}
+		return grantees
+	}
+
+	t.Run("to role", func(t *testing.T) {
+		role, roleCleanup := createRole(t, client)
+		t.Cleanup(roleCleanup)
+
+		err := client.DatabaseRoles.Grant(ctx, databaseRole, &DatabaseRoleGrantOptions{
+			Grant: GrantDatabaseRole{Role: role.ID()},
+		})
+		require.NoError(t, err)
+		assert.Contains(t, showGrantees(t), role.Name)
+
+		err = client.DatabaseRoles.Revoke(ctx, databaseRole, &DatabaseRoleRevokeOptions{
+			Revoke: RevokeDatabaseRole{Role: role.ID()},
+		})
+		require.NoError(t, err)
+		assert.NotContains(t, showGrantees(t), role.Name)
+	})
+
+	t.Run("to database role", func(t *testing.T) {
+		parent, parentCleanup := createDatabaseRole(t, client, database)
+		t.Cleanup(parentCleanup)
+
+		err := client.DatabaseRoles.Grant(ctx, databaseRole, &DatabaseRoleGrantOptions{
+			Grant: GrantDatabaseRole{DatabaseRole: parent},
+		})
+		require.NoError(t, err)
+		assert.Contains(t, showGrantees(t), database.Name+"."+parent.Name())
+
+		err = client.DatabaseRoles.Revoke(ctx, databaseRole, &DatabaseRoleRevokeOptions{
+			Revoke: RevokeDatabaseRole{DatabaseRole: parent},
+		})
+		require.NoError(t, err)
+		assert.NotContains(t, showGrantees(t), database.Name+"."+parent.Name())
+	})
+
+	t.Run("to share", func(t *testing.T) {
+		share, shareCleanup := createShare(t, client)
+		t.Cleanup(shareCleanup)
+		err := client.Grants.GrantPrivilegeToShare(ctx, PrivilegeUsage, &GrantPrivilegeToShareOn{
+			Database: database.ID(),
+		}, share.ID())
+		require.NoError(t, err)
+
+		err = client.DatabaseRoles.GrantToShare(ctx, databaseRole, share.ID())
+		require.NoError(t, err)
+		assert.Contains(t, showGrantees(t), share.ID().Name())
+
+		err = client.DatabaseRoles.RevokeFromShare(ctx, databaseRole, share.ID())
+		require.NoError(t, err)
+		assert.NotContains(t, showGrantees(t), share.ID().Name())
+	})
+}
@@ -0,0 +1,150 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatabaseRolesCreate(t *testing.T) {
+	id := NewDatabaseObjectIdentifier("db", "role")
+
+	t.Run("complete", func(t *testing.T) {
+		opts := &CreateDatabaseRoleOptions{
+			name:        id,
+			IfNotExists: Bool(true),
+			Comment:     String("comment"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE DATABASE ROLE IF NOT EXISTS "db"."role" COMMENT = 'comment'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: or replace and if not exists", func(t *testing.T) {
+		opts := &CreateDatabaseRoleOptions{
+			name:        id,
+			OrReplace:   Bool(true),
+			IfNotExists: Bool(true),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestDatabaseRolesDrop(t *testing.T) {
+	opts := &DropDatabaseRoleOptions{
+		name:     NewDatabaseObjectIdentifier("db", "role"),
+		IfExists: Bool(true),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	expected := `DROP DATABASE ROLE IF EXISTS "db"."role"`
+	assert.Equal(t, expected, actual)
+}
+
+func TestDatabaseRolesGrant(t *testing.T) {
+	id := NewDatabaseObjectIdentifier("db", "role")
+
+	t.Run("to role", func(t *testing.T) {
+		opts := &DatabaseRoleGrantOptions{
+			name: id,
+			Grant: GrantDatabaseRole{
+				Role: NewAccountObjectIdentifier("parent"),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `GRANT DATABASE ROLE "db"."role" TO ROLE "parent"`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("to database role", func(t *testing.T) {
+		opts := &DatabaseRoleGrantOptions{
+			name: id,
+			Grant: GrantDatabaseRole{
+				DatabaseRole: NewDatabaseObjectIdentifier("db", "parent"),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `GRANT DATABASE ROLE "db"."role" TO DATABASE ROLE "db"."parent"`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("to share", func(t *testing.T) {
+		opts := &grantDatabaseRoleToShareOptions{
+			name:  id,
+			share: NewAccountObjectIdentifier("share"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `GRANT DATABASE ROLE "db"."role" TO SHARE "share"`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: both role and database role", func(t *testing.T) {
+		opts := &DatabaseRoleGrantOptions{
+			name: id,
+			Grant: GrantDatabaseRole{
+				Role:         NewAccountObjectIdentifier("parent"),
+				DatabaseRole: NewDatabaseObjectIdentifier("db", "parent"),
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestDatabaseRolesRevoke(t *testing.T) {
+	id := NewDatabaseObjectIdentifier("db", "role")
+
+	t.Run("from role", func(t *testing.T) {
+		opts := &DatabaseRoleRevokeOptions{
+			name: id,
+			Revoke: RevokeDatabaseRole{
+				Role: NewAccountObjectIdentifier("parent"),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `REVOKE DATABASE ROLE "db"."role" FROM ROLE "parent"`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("from database role", func(t *testing.T) {
+		opts := &DatabaseRoleRevokeOptions{
+			name: id,
+			Revoke: RevokeDatabaseRole{
+				DatabaseRole: NewDatabaseObjectIdentifier("db", "parent"),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `REVOKE DATABASE ROLE "db"."role" FROM DATABASE ROLE "db"."parent"`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("from share", func(t *testing.T) {
+		opts := &revokeDatabaseRoleFromShareOptions{
+			name:  id,
+			share: NewAccountObjectIdentifier("share"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `REVOKE DATABASE ROLE "db"."role" FROM SHARE "share"`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: neither role nor database role", func(t *testing.T) {
+		opts := &DatabaseRoleRevokeOptions{
+			name: id,
+		}
+		assert.Error(t, opts.validate())
+	})
+}
@@ -0,0 +1,372 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ DynamicTables = (*dynamicTables)(nil)
+
+// DynamicTables describes all the dynamic table related methods that the
+// Snowflake API supports.
+type DynamicTables interface {
+	// Create creates a dynamic table.
+	Create(ctx context.Context, id SchemaObjectIdentifier, warehouse AccountObjectIdentifier, targetLag TargetLag, query string, opts *CreateDynamicTableOptions) error
+	// Alter modifies an existing dynamic table, e.g. to suspend, resume or refresh it.
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterDynamicTableOptions) error
+	// Drop removes a dynamic table.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropDynamicTableOptions) error
+	// Show returns a list of dynamic tables.
+	Show(ctx context.Context, opts *ShowDynamicTableOptions) ([]*DynamicTable, error)
+	// ShowByID returns a dynamic table by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*DynamicTable, error)
+}
+
+// dynamicTables implements DynamicTables.
+type dynamicTables struct {
+	client *Client
+}
+
+// TargetLag is the maximum amount of time the content of a dynamic table may lag behind its base tables. Exactly
+// one of Lagtime, e.g. "5 minutes", and Downstream must be set.
+type TargetLag struct {
+	Lagtime    *string `ddl:"keyword,single_quotes"`
+	Downstream *bool   `ddl:"keyword" sql:"DOWNSTREAM"`
+}
+
+func (v *TargetLag) validate() error {
+	if !exactlyOneValueSet(v.Lagtime, v.Downstream) {
+		return errors.New("exactly one of Lagtime or Downstream must be set")
+	}
+	return nil
+}
+
+// String returns the target lag the way SHOW DYNAMIC TABLES reports it.
+func (v TargetLag) String() string {
+	if v.Downstream != nil && *v.Downstream {
+		return "DOWNSTREAM"
+	}
+	if v.Lagtime != nil {
+		return *v.Lagtime
+	}
+	return ""
+}
+
+type DynamicTableRefreshMode string
+
+var (
+	DynamicTableRefreshModeAuto        DynamicTableRefreshMode = "AUTO"
+	DynamicTableRefreshModeFull        DynamicTableRefreshMode = "FULL"
+	DynamicTableRefreshModeIncremental DynamicTableRefreshMode = "INCREMENTAL"
+)
+
+type DynamicTableSchedulingState string
+
+var (
+	DynamicTableSchedulingStateRunning   DynamicTableSchedulingState = "RUNNING"
+	DynamicTableSchedulingStateSuspended DynamicTableSchedulingState = "SUSPENDED"
+)
+
+// CreateDynamicTableOptions contains options for creating a dynamic table.
+type CreateDynamicTableOptions struct {
+	create       bool                   `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace    *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	dynamicTable bool                   `ddl:"static" sql:"DYNAMIC TABLE"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists  *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name         SchemaObjectIdentifier `ddl:"identifier"`
+
+	// required
+	targetLag TargetLag               `ddl:"keyword" sql:"TARGET_LAG ="`
+	warehouse AccountObjectIdentifier `ddl:"identifier,equals" sql:"WAREHOUSE"`
+
+	// optional
+	RefreshMode *DynamicTableRefreshMode `ddl:"parameter" sql:"REFRESH_MODE"`
+	ClusterBy   []string                 `ddl:"keyword,parentheses" sql:"CLUSTER BY"`
+	Comment     *string                  `ddl:"parameter,single_quotes" sql:"COMMENT"`
+
+	query string `ddl:"parameter,no_equals" sql:"AS"`
+}
+
+func (opts *CreateDynamicTableOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("OR REPLACE and IF NOT EXISTS are incompatible")
+	}
+	if !validObjectidentifier(opts.warehouse) {
+		return errors.New("warehouse must be set")
+	}
+	if err := opts.targetLag.validate(); err != nil {
+		return err
+	}
+	if opts.query == "" {
+		return errors.New("query must be set")
+	}
+	return nil
+}
+
+func (v *dynamicTables) Create(ctx context.Context, id SchemaObjectIdentifier, warehouse AccountObjectIdentifier, targetLag TargetLag, query string, opts *CreateDynamicTableOptions) error {
+	if opts == nil {
+		opts = &CreateDynamicTableOptions{}
+	}
+	opts.name = id
+	opts.warehouse = warehouse
+	opts.targetLag = targetLag
+	opts.query = query
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// AlterDynamicTableOptions contains options for altering a dynamic table.
+type AlterDynamicTableOptions struct {
+	alter        bool                   `ddl:"static" sql:"ALTER"`         //lint:ignore U1000 This is used in the ddl tag
+	dynamicTable bool                   `ddl:"static" sql:"DYNAMIC TABLE"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists     *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name         SchemaObjectIdentifier `ddl:"identifier"`
+
+	Suspend           *bool            `ddl:"keyword" sql:"SUSPEND"`
+	Resume            *bool            `ddl:"keyword" sql:"RESUME"`
+	Refresh           *bool            `ddl:"keyword" sql:"REFRESH"`
+	ClusterBy         []string         `ddl:"keyword,parentheses" sql:"CLUSTER BY"`
+	DropClusteringKey *bool            `ddl:"keyword" sql:"DROP CLUSTERING KEY"`
+	Set               *DynamicTableSet `ddl:"keyword" sql:"SET"`
+}
+
+func (opts *AlterDynamicTableOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Suspend, opts.Resume, opts.Refresh, opts.ClusterBy, opts.DropClusteringKey, opts.Set) {
+		return errors.New("exactly one of Suspend, Resume, Refresh, ClusterBy, DropClusteringKey or Set must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type DynamicTableSet struct {
+	TargetLag *TargetLag              `ddl:"keyword" sql:"TARGET_LAG ="`
+	Warehouse AccountObjectIdentifier `ddl:"identifier,equals" sql:"WAREHOUSE"`
+}
+
+func (v *DynamicTableSet) validate() error {
+	if everyValueNil(v.TargetLag, v.Warehouse) {
+		return errors.New("must set at least one parameter")
+	}
+	if valueSet(v.TargetLag) {
+		if err := v.TargetLag.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *dynamicTables) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterDynamicTableOptions) error {
+	if opts == nil {
+		opts = &AlterDynamicTableOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// DropDynamicTableOptions contains options for dropping a dynamic table.
+type DropDynamicTableOptions struct {
+	drop         bool                   `ddl:"static" sql:"DROP"`          //lint:ignore U1000 This is used in the ddl tag
+	dynamicTable bool                   `ddl:"static" sql:"DYNAMIC TABLE"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists     *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name         SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropDynamicTableOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *dynamicTables) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropDynamicTableOptions) error {
+	if opts == nil {
+		opts = &DropDynamicTableOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return fmt.Errorf("validate drop options: %w", err)
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ShowDynamicTableOptions contains options for listing dynamic tables.
+type ShowDynamicTableOptions struct {
+	show          bool    `ddl:"static" sql:"SHOW"`           //lint:ignore U1000 This is used in the ddl tag
+	dynamicTables bool    `ddl:"static" sql:"DYNAMIC TABLES"` //lint:ignore U1000 This is used in the ddl tag
+	Like          *Like   `ddl:"keyword" sql:"LIKE"`
+	In            *In     `ddl:"keyword" sql:"IN"`
+	StartsWith    *string `ddl:"parameter,single_quotes,no_equals" sql:"STARTS WITH"`
+	Limit         *int    `ddl:"parameter,no_equals" sql:"LIMIT"`
+}
+
+func (opts *ShowDynamicTableOptions) validate() error {
+	return nil
+}
+
+// DynamicTable is a user friendly result for a SHOW DYNAMIC TABLES query.
+type DynamicTable struct {
+	CreatedOn    time.Time
+	Name         string
+	DatabaseName string
+	SchemaName   string
+	// ClusterBy is the clustering key, e.g. "LINEAR(ID, NAME)", or empty.
+	ClusterBy           string
+	Rows                int
+	Bytes               int
+	Owner               string
+	TargetLag           string
+	RefreshMode         DynamicTableRefreshMode
+	RefreshModeReason   string
+	Warehouse           string
+	Comment             string
+	Text                string
+	AutomaticClustering bool
+	SchedulingState     DynamicTableSchedulingState
+	LastSuspendedOn     *time.Time
+	IsClone             bool
+	IsReplica           bool
+	// DataTimestamp is the time the content of the dynamic table is up to date with, if it was refreshed.
+	DataTimestamp *time.Time
+}
+
+func (v *DynamicTable) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *DynamicTable) ObjectType() ObjectType {
+	return ObjectTypeDynamicTable
+}
+
+// dynamicTableDBRow is used to decode the result of a SHOW DYNAMIC TABLES query.
+type dynamicTableDBRow struct {
+	CreatedOn           time.Time      `db:"created_on"`
+	Name                string         `db:"name"`
+	DatabaseName        string         `db:"database_name"`
+	SchemaName          string         `db:"schema_name"`
+	ClusterBy           sql.NullString `db:"cluster_by"`
+	Rows                sql.NullInt64  `db:"rows"`
+	Bytes               sql.NullInt64  `db:"bytes"`
+	Owner               sql.NullString `db:"owner"`
+	TargetLag           sql.NullString `db:"target_lag"`
+	RefreshMode         sql.NullString `db:"refresh_mode"`
+	RefreshModeReason   sql.NullString `db:"refresh_mode_reason"`
+	Warehouse           sql.NullString `db:"warehouse"`
+	Comment             sql.NullString `db:"comment"`
+	Text                sql.NullString `db:"text"`
+	AutomaticClustering sql.NullString `db:"automatic_clustering"`
+	SchedulingState     sql.NullString `db:"scheduling_state"`
+	LastSuspendedOn     sql.NullTime   `db:"last_suspended_on"`
+	IsClone             sql.NullBool   `db:"is_clone"`
+	IsReplica           sql.NullBool   `db:"is_replica"`
+	DataTimestamp       sql.NullTime   `db:"data_timestamp"`
+	OwnerRoleType       sql.NullString `db:"owner_role_type"`
+}
+
+func (row dynamicTableDBRow) toDynamicTable() *DynamicTable {
+	dt := &DynamicTable{
+		CreatedOn:           row.CreatedOn,
+		Name:                row.Name,
+		DatabaseName:        row.DatabaseName,
+		SchemaName:          row.SchemaName,
+		ClusterBy:           row.ClusterBy.String,
+		Rows:                int(row.Rows.Int64),
+		Bytes:               int(row.Bytes.Int64),
+		Owner:               row.Owner.String,
+		TargetLag:           row.TargetLag.String,
+		RefreshMode:         DynamicTableRefreshMode(strings.ToUpper(row.RefreshMode.String)),
+		RefreshModeReason:   row.RefreshModeReason.String,
+		Warehouse:           row.Warehouse.String,
+		Comment:             row.Comment.String,
+		Text:                row.Text.String,
+		AutomaticClustering: strings.EqualFold(row.AutomaticClustering.String, "ON"),
+		SchedulingState:     DynamicTableSchedulingState(strings.ToUpper(row.SchedulingState.String)),
+		IsClone:             row.IsClone.Bool,
+		IsReplica:           row.IsReplica.Bool,
+	}
+	if row.LastSuspendedOn.Valid {
+		dt.LastSuspendedOn = &row.LastSuspendedOn.Time
+	}
+	if row.DataTimestamp.Valid {
+		dt.DataTimestamp = &row.DataTimestamp.Time
+	}
+	return dt
+}
+
+// Show lists all the dynamic tables matching the options.
+func (v *dynamicTables) Show(ctx context.Context, opts *ShowDynamicTableOptions) ([]*DynamicTable, error) {
+	if opts == nil {
+		opts = &ShowDynamicTableOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []dynamicTableDBRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*DynamicTable, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toDynamicTable()
+	}
+	return resultList, nil
+}
+
+func (v *dynamicTables) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*DynamicTable, error) {
+	dynamicTables, err := v.Show(ctx, &ShowDynamicTableOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, dynamicTable := range dynamicTables {
+		if dynamicTable.Name == id.Name() {
+			return dynamicTable, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
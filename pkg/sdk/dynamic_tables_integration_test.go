@@ -0,0 +1,182 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_DynamicTablesShow(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+	warehouseTest, warehouseCleanup := createWarehouse(t, client)
+	t.Cleanup(warehouseCleanup)
+	table, tableCleanup := createTable(t, client, databaseTest, schemaTest)
+	t.Cleanup(tableCleanup)
+
+	dynamicTableTest, dynamicTableCleanup := createDynamicTable(t, client, warehouseTest, table)
+	t.Cleanup(dynamicTableCleanup)
+	dynamicTable2Test, dynamicTable2Cleanup := createDynamicTable(t, client, warehouseTest, table)
+	t.Cleanup(dynamicTable2Cleanup)
+
+	t.Run("in schema", func(t *testing.T) {
+		dynamicTables, err := client.DynamicTables.Show(ctx, &ShowDynamicTableOptions{
+			In: &In{
+				Schema: schemaTest.ID(),
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, len(dynamicTables))
+	})
+
+	t.Run("with like", func(t *testing.T) {
+		dynamicTables, err := client.DynamicTables.Show(ctx, &ShowDynamicTableOptions{
+			Like: &Like{
+				Pattern: String(dynamicTableTest.Name),
+			},
+			In: &In{
+				Database: databaseTest.ID(),
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(dynamicTables))
+		assert.Equal(t, dynamicTableTest.ID(), dynamicTables[0].ID())
+		assert.NotEqual(t, dynamicTable2Test.Name, dynamicTables[0].Name)
+	})
+}
+
+func TestInt_DynamicTableCreate(t *testing.T) {
+	client := testClient(t)
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+	warehouseTest, warehouseCleanup := createWarehouse(t, client)
+	t.Cleanup(warehouseCleanup)
+	table, tableCleanup := createTable(t, client, databaseTest, schemaTest)
+	t.Cleanup(tableCleanup)
+
+	dynamicTable, dynamicTableCleanup := createDynamicTableWithOptions(t, client, warehouseTest, table, &CreateDynamicTableOptions{
+		RefreshMode: &DynamicTableRefreshModeFull,
+		ClusterBy:   []string{"ID"},
+		Comment:     String("test comment"),
+	})
+	t.Cleanup(dynamicTableCleanup)
+
+	assert.Equal(t, "2 minutes", dynamicTable.TargetLag)
+	assert.Equal(t, warehouseTest.Name, dynamicTable.Warehouse)
+	assert.Equal(t, DynamicTableRefreshModeFull, dynamicTable.RefreshMode)
+	assert.Contains(t, dynamicTable.ClusterBy, "ID")
+	assert.Equal(t, "test comment", dynamicTable.Comment)
+	assert.Equal(t, DynamicTableSchedulingStateRunning, dynamicTable.SchedulingState)
+}
+
+func TestInt_DynamicTableAlter(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+	warehouseTest, warehouseCleanup := createWarehouse(t, client)
+	t.Cleanup(warehouseCleanup)
+	table, tableCleanup := createTable(t, client, databaseTest, schemaTest)
+	t.Cleanup(tableCleanup)
+
+	t.Run("suspend and resume", func(t *testing.T) {
+		dynamicTable, dynamicTableCleanup := createDynamicTable(t, client, warehouseTest, table)
+		t.Cleanup(dynamicTableCleanup)
+		id := dynamicTable.ID()
+
+		err := client.DynamicTables.Alter(ctx, id, &AlterDynamicTableOptions{Suspend: Bool(true)})
+		require.NoError(t, err)
+		dynamicTable, err = client.DynamicTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, DynamicTableSchedulingStateSuspended, dynamicTable.SchedulingState)
+
+		err = client.DynamicTables.Alter(ctx, id, &AlterDynamicTableOptions{Resume: Bool(true)})
+		require.NoError(t, err)
+		dynamicTable, err = client.DynamicTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, DynamicTableSchedulingStateRunning, dynamicTable.SchedulingState)
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		dynamicTable, dynamicTableCleanup := createDynamicTable(t, client, warehouseTest, table)
+		t.Cleanup(dynamicTableCleanup)
+		id := dynamicTable.ID()
+
+		err := client.DynamicTables.Alter(ctx, id, &AlterDynamicTableOptions{Refresh: Bool(true)})
+		require.NoError(t, err)
+		dynamicTable, err = client.DynamicTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.NotNil(t, dynamicTable.DataTimestamp)
+	})
+
+	t.Run("set target lag and warehouse", func(t *testing.T) {
+		dynamicTable, dynamicTableCleanup := createDynamicTable(t, client, warehouseTest, table)
+		t.Cleanup(dynamicTableCleanup)
+		id := dynamicTable.ID()
+		warehouse2Test, warehouse2Cleanup := createWarehouse(t, client)
+		t.Cleanup(warehouse2Cleanup)
+
+		err := client.DynamicTables.Alter(ctx, id, &AlterDynamicTableOptions{
+			Set: &DynamicTableSet{
+				TargetLag: &TargetLag{Lagtime: String("1 hour")},
+				Warehouse: NewAccountObjectIdentifier(warehouse2Test.Name),
+			},
+		})
+		require.NoError(t, err)
+		dynamicTable, err = client.DynamicTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "1 hour", dynamicTable.TargetLag)
+		assert.Equal(t, warehouse2Test.Name, dynamicTable.Warehouse)
+	})
+
+	t.Run("cluster by and drop clustering key", func(t *testing.T) {
+		dynamicTable, dynamicTableCleanup := createDynamicTable(t, client, warehouseTest, table)
+		t.Cleanup(dynamicTableCleanup)
+		id := dynamicTable.ID()
+
+		err := client.DynamicTables.Alter(ctx, id, &AlterDynamicTableOptions{ClusterBy: []string{"NAME"}})
+		require.NoError(t, err)
+		dynamicTable, err = client.DynamicTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Contains(t, dynamicTable.ClusterBy, "NAME")
+
+		err = client.DynamicTables.Alter(ctx, id, &AlterDynamicTableOptions{DropClusteringKey: Bool(true)})
+		require.NoError(t, err)
+		dynamicTable, err = client.DynamicTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, dynamicTable.ClusterBy)
+	})
+}
+
+func TestInt_DynamicTableDrop(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+	warehouseTest, warehouseCleanup := createWarehouse(t, client)
+	t.Cleanup(warehouseCleanup)
+	table, tableCleanup := createTable(t, client, databaseTest, schemaTest)
+	t.Cleanup(tableCleanup)
+
+	dynamicTable, _ := createDynamicTable(t, client, warehouseTest, table)
+	id := dynamicTable.ID()
+	err := client.DynamicTables.Drop(ctx, id, nil)
+	require.NoError(t, err)
+	_, err = client.DynamicTables.ShowByID(ctx, id)
+	assert.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+
+	err = client.DynamicTables.Drop(ctx, id, &DropDynamicTableOptions{IfExists: Bool(true)})
+	require.NoError(t, err)
+}
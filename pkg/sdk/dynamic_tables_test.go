@@ -0,0 +1,218 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDynamicTableCreate(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+	warehouse := randomAccountObjectIdentifier(t)
+
+	t.Run("required options", func(t *testing.T) {
+		opts := &CreateDynamicTableOptions{
+			name:      id,
+			warehouse: warehouse,
+			targetLag: TargetLag{Lagtime: String("1 minute")},
+			query:     "SELECT * FROM foo",
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE DYNAMIC TABLE %s TARGET_LAG = '1 minute' WAREHOUSE = %s AS SELECT * FROM foo`, id.FullyQualifiedName(), warehouse.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &CreateDynamicTableOptions{
+			OrReplace:   Bool(true),
+			name:        id,
+			warehouse:   warehouse,
+			targetLag:   TargetLag{Downstream: Bool(true)},
+			RefreshMode: &DynamicTableRefreshModeIncremental,
+			ClusterBy:   []string{"id", "name"},
+			Comment:     String("test comment"),
+			query:       "SELECT id, name FROM foo",
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE OR REPLACE DYNAMIC TABLE %s TARGET_LAG = DOWNSTREAM WAREHOUSE = %s REFRESH_MODE = INCREMENTAL CLUSTER BY (id, name) COMMENT = 'test comment' AS SELECT id, name FROM foo`, id.FullyQualifiedName(), warehouse.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: target lag", func(t *testing.T) {
+		opts := &CreateDynamicTableOptions{
+			name:      id,
+			warehouse: warehouse,
+			targetLag: TargetLag{Lagtime: String("1 minute"), Downstream: Bool(true)},
+			query:     "SELECT * FROM foo",
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: missing warehouse", func(t *testing.T) {
+		opts := &CreateDynamicTableOptions{
+			name:      id,
+			targetLag: TargetLag{Lagtime: String("1 minute")},
+			query:     "SELECT * FROM foo",
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: missing query", func(t *testing.T) {
+		opts := &CreateDynamicTableOptions{
+			name:      id,
+			warehouse: warehouse,
+			targetLag: TargetLag{Lagtime: String("1 minute")},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestDynamicTableAlter(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("suspend", func(t *testing.T) {
+		opts := &AlterDynamicTableOptions{
+			name:    id,
+			Suspend: Bool(true),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER DYNAMIC TABLE %s SUSPEND", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		opts := &AlterDynamicTableOptions{
+			name:   id,
+			Resume: Bool(true),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER DYNAMIC TABLE %s RESUME", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("refresh", func(t *testing.T) {
+		opts := &AlterDynamicTableOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Refresh:  Bool(true),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER DYNAMIC TABLE IF EXISTS %s REFRESH", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("cluster by", func(t *testing.T) {
+		opts := &AlterDynamicTableOptions{
+			name:      id,
+			ClusterBy: []string{"id"},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER DYNAMIC TABLE %s CLUSTER BY (id)", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("drop clustering key", func(t *testing.T) {
+		opts := &AlterDynamicTableOptions{
+			name:              id,
+			DropClusteringKey: Bool(true),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER DYNAMIC TABLE %s DROP CLUSTERING KEY", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with set", func(t *testing.T) {
+		warehouse := randomAccountObjectIdentifier(t)
+		opts := &AlterDynamicTableOptions{
+			name: id,
+			Set: &DynamicTableSet{
+				TargetLag: &TargetLag{Lagtime: String("1 hour")},
+				Warehouse: warehouse,
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER DYNAMIC TABLE %s SET TARGET_LAG = '1 hour' WAREHOUSE = %s", id.FullyQualifiedName(), warehouse.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: no action", func(t *testing.T) {
+		opts := &AlterDynamicTableOptions{
+			name: id,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: suspend and resume", func(t *testing.T) {
+		opts := &AlterDynamicTableOptions{
+			name:    id,
+			Suspend: Bool(true),
+			Resume:  Bool(true),
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: empty set", func(t *testing.T) {
+		opts := &AlterDynamicTableOptions{
+			name: id,
+			Set:  &DynamicTableSet{},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestDynamicTableDrop(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("with if exists", func(t *testing.T) {
+		opts := &DropDynamicTableOptions{
+			IfExists: Bool(true),
+			name:     id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("DROP DYNAMIC TABLE IF EXISTS %s", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestDynamicTableShow(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("empty options", func(t *testing.T) {
+		opts := &ShowDynamicTableOptions{}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, "SHOW DYNAMIC TABLES", actual)
+	})
+
+	t.Run("with like and in schema", func(t *testing.T) {
+		opts := &ShowDynamicTableOptions{
+			Like: &Like{
+				Pattern: String(id.Name()),
+			},
+			In: &In{
+				Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+			},
+			StartsWith: String("A"),
+			Limit:      Int(10),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`SHOW DYNAMIC TABLES LIKE '%s' IN SCHEMA "%s"."%s" STARTS WITH 'A' LIMIT 10`, id.Name(), id.DatabaseName(), id.SchemaName())
+		assert.Equal(t, expected, actual)
+	})
+}
@@ -3,32 +3,205 @@ package sdk
 import (
 	"errors"
 	"log"
+	"net/http"
 	"strings"
+
+	"github.com/snowflakedb/gosnowflake"
 )
 
 var (
 	// go-snowflake errors.
 	ErrObjectNotExistOrAuthorized = errors.New("object does not exist or not authorized")
+	ErrObjectAlreadyExists        = errors.New("object already exists")
+	ErrInsufficientPrivileges     = errors.New("insufficient privileges")
+	ErrObjectLocked               = errors.New("object is locked by another statement")
+	ErrQuotaExceeded              = errors.New("quota exceeded")
+	ErrWarehouseSuspended         = errors.New("warehouse is suspended or not selected")
+	ErrStatementTimeout           = errors.New("statement timed out")
+	ErrConcurrentModification     = errors.New("object was modified concurrently")
+	ErrWarehouseResuming          = errors.New("warehouse is resuming")
+	ErrServiceUnavailable         = errors.New("service is unavailable")
 	ErrAccountIsEmpty             = errors.New("account is empty")
 
 	// snowflake-sdk errors.
 	ErrInvalidObjectIdentifier = errors.New("invalid object identifier")
+	ErrTagNotSet               = errors.New("tag is not set on the object")
 )
 
+// Error is a Snowflake error classified into one of the error kinds above. It keeps the message of the
+// original error, which is returned by Unwrap, so that errors.As still finds the *gosnowflake.SnowflakeError.
+type Error struct {
+	Kind     error
+	Code     int
+	SQLState string
+
+	err error
+}
+
+func (e *Error) Error() string {
+	return e.err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+func (e *Error) Is(target error) bool {
+	return e.Kind == target
+}
+
+// errorCodes maps the error numbers of Snowflake and the driver to the error kinds.
+var errorCodes = map[int]error{
+	2002:                                  ErrObjectAlreadyExists,
+	2003:                                  ErrObjectNotExistOrAuthorized,
+	2043:                                  ErrObjectNotExistOrAuthorized,
+	3001:                                  ErrInsufficientPrivileges,
+	606:                                   ErrWarehouseSuspended,
+	625:                                   ErrObjectLocked,
+	630:                                   ErrStatementTimeout,
+	gosnowflake.ErrCodeServiceUnavailable: ErrServiceUnavailable,
+	gosnowflake.ErrCodeEmptyAccountCode:   ErrAccountIsEmpty,
+}
+
+// sqlStates maps the SQLSTATE of errors without a known error number to the error kinds.
+var sqlStates = map[string]error{
+	"02000": ErrObjectNotExistOrAuthorized,
+	"42S02": ErrObjectNotExistOrAuthorized,
+	"42710": ErrObjectAlreadyExists,
+	"42501": ErrInsufficientPrivileges,
+	"57P03": ErrWarehouseSuspended,
+}
+
+// errorMessages classifies errors that carry neither, e.g. errors returned by the driver before reaching
+// Snowflake. The first matching message wins.
+var errorMessages = []struct {
+	message string
+	kind    error
+}{
+	// only the full messages, as e.g. a missing integration referenced by an existing object does not exist either
+	{"does not exist or not authorized", ErrObjectNotExistOrAuthorized},
+	{"does not exist, or operation cannot be performed", ErrObjectNotExistOrAuthorized},
+	{"already exists", ErrObjectAlreadyExists},
+	{"insufficient privileges", ErrInsufficientPrivileges},
+	{"has locked table", ErrObjectLocked},
+	{"exceeded its quota", ErrQuotaExceeded},
+	{"quota exceeded", ErrQuotaExceeded},
+	{"no active warehouse selected", ErrWarehouseSuspended},
+	{"reached its statement or warehouse timeout", ErrStatementTimeout},
+	{"modified concurrently", ErrConcurrentModification},
+	{"is being resumed", ErrWarehouseResuming},
+	{"warehouse is resuming", ErrWarehouseResuming},
+	{"http status: 429", ErrServiceUnavailable},
+	{"http status: 503", ErrServiceUnavailable},
+	{"account is empty", ErrAccountIsEmpty},
+}
+
+// classifyError returns the error kind of err, or nil if it is not one of the known kinds.
+func classifyError(err error) error {
+	var classified *Error
+	if errors.As(err, &classified) {
+		return classified.Kind
+	}
+	var snowflakeErr *gosnowflake.SnowflakeError
+	if errors.As(err, &snowflakeErr) {
+		// the service rejected the request, e.g. when throttling
+		if snowflakeErr.Number == gosnowflake.ErrFailedToPostQuery && len(snowflakeErr.MessageArgs) > 0 {
+			if status, ok := snowflakeErr.MessageArgs[0].(int); ok && (status == http.StatusTooManyRequests || status == http.StatusServiceUnavailable) {
+				return ErrServiceUnavailable
+			}
+		}
+		if kind, ok := errorCodes[snowflakeErr.Number]; ok {
+			return kind
+		}
+		if kind, ok := sqlStates[snowflakeErr.SQLState]; ok {
+			return kind
+		}
+	}
+	message := strings.ToLower(err.Error())
+	for _, m := range errorMessages {
+		if strings.Contains(message, m.message) {
+			return m.kind
+		}
+	}
+	return nil
+}
+
 func decodeDriverError(err error) error {
 	if err == nil {
 		return nil
 	}
 	log.Printf("[DEBUG] err: %v\n", err)
-	m := map[string]error{
-		"does not exist or not authorized": ErrObjectNotExistOrAuthorized,
-		"account is empty":                 ErrAccountIsEmpty,
+	kind := classifyError(err)
+	if kind == nil {
+		return err
 	}
-	for k, v := range m {
-		if strings.Contains(err.Error(), k) {
-			return v
-		}
+	e := &Error{Kind: kind, err: err}
+	var snowflakeErr *gosnowflake.SnowflakeError
+	if errors.As(err, &snowflakeErr) {
+		e.Code = snowflakeErr.Number
+		e.SQLState = snowflakeErr.SQLState
 	}
+	return e
+}
+
+// isErrorKind reports whether err is of the given kind. Errors that did not pass through the client, e.g. from
+// legacy code using the database directly, are classified as well.
+func isErrorKind(err error, kind error) bool {
+	if err == nil {
+		return false
+	}
+	return errors.Is(err, kind) || classifyError(err) == kind
+}
+
+// IsNotFound reports whether the object does not exist, or the role is not authorized to see it.
+func IsNotFound(err error) bool {
+	return isErrorKind(err, ErrObjectNotExistOrAuthorized)
+}
+
+func IsAlreadyExists(err error) bool {
+	return isErrorKind(err, ErrObjectAlreadyExists)
+}
+
+func IsInsufficientPrivileges(err error) bool {
+	return isErrorKind(err, ErrInsufficientPrivileges)
+}
+
+func IsObjectLocked(err error) bool {
+	return isErrorKind(err, ErrObjectLocked)
+}
+
+func IsQuotaExceeded(err error) bool {
+	return isErrorKind(err, ErrQuotaExceeded)
+}
+
+func IsWarehouseSuspended(err error) bool {
+	return isErrorKind(err, ErrWarehouseSuspended)
+}
+
+func IsStatementTimeout(err error) bool {
+	return isErrorKind(err, ErrStatementTimeout)
+}
+
+func IsConcurrentModification(err error) bool {
+	return isErrorKind(err, ErrConcurrentModification)
+}
+
+func IsWarehouseResuming(err error) bool {
+	return isErrorKind(err, ErrWarehouseResuming)
+}
+
+func IsServiceUnavailable(err error) bool {
+	return isErrorKind(err, ErrServiceUnavailable)
+}
+
+// IsRetryable reports whether the statement may succeed when it is repeated, i.e. it failed on a transient
+// condition such as waiting for another statement or a throttled request.
+func IsRetryable(err error) bool {
+	return isTransient(err) || IsStatementTimeout(err)
+}
 
-	return err
+// isTransient reports whether the statement failed before Snowflake executed it, so that repeating it
+// cannot apply it twice.
+func isTransient(err error) bool {
+	return IsObjectLocked(err) || IsConcurrentModification(err) || IsWarehouseResuming(err) || IsServiceUnavailable(err)
 }
@@ -0,0 +1,79 @@
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeDriverError(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		assert.NoError(t, decodeDriverError(nil))
+	})
+
+	t.Run("unknown errors are returned unchanged", func(t *testing.T) {
+		err := &gosnowflake.SnowflakeError{Number: 1003, SQLState: "42000", Message: "syntax error line 1 at position 0 unexpected 'SELEC'."}
+		assert.Same(t, err, decodeDriverError(err))
+	})
+
+	tests := []struct {
+		name string
+		err  error
+		kind error
+	}{
+		{"not found by code", &gosnowflake.SnowflakeError{Number: 2003, SQLState: "02000", Message: "SQL compilation error:\nDatabase 'DB' does not exist or not authorized."}, ErrObjectNotExistOrAuthorized},
+		{"not found by sqlstate", &gosnowflake.SnowflakeError{Number: 2140, SQLState: "42S02", Message: "SQL compilation error:\nUnknown function FN"}, ErrObjectNotExistOrAuthorized},
+		{"already exists", &gosnowflake.SnowflakeError{Number: 2002, SQLState: "42710", Message: "SQL compilation error:\nObject 'DB' already exists."}, ErrObjectAlreadyExists},
+		{"insufficient privileges", &gosnowflake.SnowflakeError{Number: 3001, SQLState: "42501", Message: "SQL access control error:\nInsufficient privileges to operate on account 'AB12345'"}, ErrInsufficientPrivileges},
+		{"object locked", &gosnowflake.SnowflakeError{Number: 625, SQLState: "57014", Message: "Statement '01ab' has locked table 'T' in transaction 1234 and this lock has not yet been released."}, ErrObjectLocked},
+		{"warehouse suspended", &gosnowflake.SnowflakeError{Number: 606, SQLState: "57P03", Message: "No active warehouse selected in the current session."}, ErrWarehouseSuspended},
+		{"statement timeout", &gosnowflake.SnowflakeError{Number: 630, SQLState: "57014", Message: "Statement reached its statement or warehouse timeout of 10 second(s) and was canceled."}, ErrStatementTimeout},
+		{"quota exceeded by message", errors.New("Warehouse 'WH' cannot be resumed because resource monitor 'RM' has exceeded its quota."), ErrQuotaExceeded},
+		{"account is empty", &gosnowflake.SnowflakeError{Number: gosnowflake.ErrCodeEmptyAccountCode, Message: "account is empty"}, ErrAccountIsEmpty},
+		{"concurrent modification", errors.New("Object 'DB' was modified concurrently. Please retry."), ErrConcurrentModification},
+		{"throttled", &gosnowflake.SnowflakeError{Number: gosnowflake.ErrFailedToPostQuery, SQLState: "08006", Message: "failed to POST. HTTP: %v, URL: %v", MessageArgs: []interface{}{503, "https://example.com"}}, ErrServiceUnavailable},
+		{"service unavailable", &gosnowflake.SnowflakeError{Number: gosnowflake.ErrCodeServiceUnavailable, Message: "service is unavailable. check your connectivity."}, ErrServiceUnavailable},
+		{"not found by message", errors.New("Object does not exist, or operation cannot be performed."), ErrObjectNotExistOrAuthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := decodeDriverError(tt.err)
+			assert.ErrorIs(t, err, tt.kind)
+			assert.Equal(t, tt.err.Error(), err.Error())
+
+			var snowflakeErr *gosnowflake.SnowflakeError
+			if errors.As(tt.err, &snowflakeErr) {
+				var decoded *Error
+				require.ErrorAs(t, err, &decoded)
+				assert.Equal(t, snowflakeErr.Number, decoded.Code)
+				assert.Equal(t, snowflakeErr.SQLState, decoded.SQLState)
+				assert.ErrorAs(t, err, &snowflakeErr)
+			}
+		})
+	}
+}
+
+func TestErrorHelpers(t *testing.T) {
+	notFound := &gosnowflake.SnowflakeError{Number: 2003, SQLState: "02000", Message: "Schema 'DB.S' does not exist or not authorized."}
+	locked := &gosnowflake.SnowflakeError{Number: 625, Message: "Statement '01ab' has locked table 'T'."}
+
+	assert.True(t, IsNotFound(decodeDriverError(notFound)))
+	// errors of the legacy code did not pass through the client
+	assert.True(t, IsNotFound(notFound))
+	assert.True(t, IsNotFound(fmt.Errorf("error reading schema err = %w", notFound)))
+	assert.True(t, IsNotFound(ErrObjectNotExistOrAuthorized))
+	assert.False(t, IsNotFound(nil))
+	assert.False(t, IsNotFound(locked))
+	assert.False(t, IsAlreadyExists(notFound))
+	assert.True(t, IsNotFound(errors.New("Database 'DB' does not exist or not authorized.")))
+	assert.False(t, IsNotFound(errors.New("Integration 'API' associated with the function does not exist.")))
+
+	assert.True(t, IsObjectLocked(locked))
+	assert.True(t, IsRetryable(locked))
+	assert.False(t, IsRetryable(notFound))
+	assert.False(t, IsRetryable(nil))
+}
@@ -0,0 +1,304 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ EventTables = (*eventTables)(nil)
+
+// EventTables describes all the event table related methods that the
+// Snowflake API supports.
+type EventTables interface {
+	// Create creates an event table.
+	Create(ctx context.Context, id SchemaObjectIdentifier, opts *CreateEventTableOptions) error
+	// Alter modifies an existing event table.
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterEventTableOptions) error
+	// Drop removes an event table.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropEventTableOptions) error
+	// Show returns a list of event tables.
+	Show(ctx context.Context, opts *ShowEventTableOptions) ([]*EventTable, error)
+	// ShowByID returns an event table by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*EventTable, error)
+}
+
+// eventTables implements EventTables.
+type eventTables struct {
+	client *Client
+}
+
+// CreateEventTableOptions contains options for creating an event table.
+type CreateEventTableOptions struct {
+	create      bool                   `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace   *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	eventTable  bool                   `ddl:"static" sql:"EVENT TABLE"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name        SchemaObjectIdentifier `ddl:"identifier"`
+
+	ClusterBy                  []string `ddl:"keyword,parentheses" sql:"CLUSTER BY"`
+	DataRetentionTimeInDays    *int     `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *int     `ddl:"parameter" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	ChangeTracking             *bool    `ddl:"parameter" sql:"CHANGE_TRACKING"`
+	DefaultDDLCollation        *string  `ddl:"parameter,single_quotes" sql:"DEFAULT_DDL_COLLATION"`
+	CopyGrants                 *bool    `ddl:"keyword" sql:"COPY GRANTS"`
+	Comment                    *string  `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateEventTableOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("OR REPLACE and IF NOT EXISTS are incompatible")
+	}
+	if valueSet(opts.DataRetentionTimeInDays) {
+		if ok := validateIntInRange(*opts.DataRetentionTimeInDays, 0, 90); !ok {
+			return errors.New("DATA_RETENTION_TIME_IN_DAYS must be between 0 and 90")
+		}
+	}
+	if valueSet(opts.MaxDataExtensionTimeInDays) {
+		if ok := validateIntInRange(*opts.MaxDataExtensionTimeInDays, 0, 90); !ok {
+			return errors.New("MAX_DATA_EXTENSION_TIME_IN_DAYS must be between 0 and 90")
+		}
+	}
+	return nil
+}
+
+func (v *eventTables) Create(ctx context.Context, id SchemaObjectIdentifier, opts *CreateEventTableOptions) error {
+	if opts == nil {
+		opts = &CreateEventTableOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// AlterEventTableOptions contains options for altering an event table. Event tables are altered with ALTER TABLE.
+type AlterEventTableOptions struct {
+	alter    bool                   `ddl:"static" sql:"ALTER"` //lint:ignore U1000 This is used in the ddl tag
+	table    bool                   `ddl:"static" sql:"TABLE"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+
+	NewName           SchemaObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	ClusterBy         []string               `ddl:"keyword,parentheses" sql:"CLUSTER BY"`
+	DropClusteringKey *bool                  `ddl:"keyword" sql:"DROP CLUSTERING KEY"`
+	Set               *EventTableSet         `ddl:"keyword" sql:"SET"`
+	Unset             *EventTableUnset       `ddl:"list,no_parentheses" sql:"UNSET"`
+}
+
+func (opts *AlterEventTableOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.NewName, opts.ClusterBy, opts.DropClusteringKey, opts.Set, opts.Unset) {
+		return errors.New("exactly one of NewName, ClusterBy, DropClusteringKey, Set or Unset must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type EventTableSet struct {
+	DataRetentionTimeInDays    *int    `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *int    `ddl:"parameter" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	ChangeTracking             *bool   `ddl:"parameter" sql:"CHANGE_TRACKING"`
+	Comment                    *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (v *EventTableSet) validate() error {
+	if everyValueNil(v.DataRetentionTimeInDays, v.MaxDataExtensionTimeInDays, v.ChangeTracking, v.Comment) {
+		return errors.New("must set at least one parameter")
+	}
+	if valueSet(v.DataRetentionTimeInDays) {
+		if ok := validateIntInRange(*v.DataRetentionTimeInDays, 0, 90); !ok {
+			return errors.New("DATA_RETENTION_TIME_IN_DAYS must be between 0 and 90")
+		}
+	}
+	if valueSet(v.MaxDataExtensionTimeInDays) {
+		if ok := validateIntInRange(*v.MaxDataExtensionTimeInDays, 0, 90); !ok {
+			return errors.New("MAX_DATA_EXTENSION_TIME_IN_DAYS must be between 0 and 90")
+		}
+	}
+	return nil
+}
+
+type EventTableUnset struct {
+	DataRetentionTimeInDays    *bool `ddl:"keyword" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *bool `ddl:"keyword" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	ChangeTracking             *bool `ddl:"keyword" sql:"CHANGE_TRACKING"`
+	Comment                    *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *EventTableUnset) validate() error {
+	if everyValueNil(v.DataRetentionTimeInDays, v.MaxDataExtensionTimeInDays, v.ChangeTracking, v.Comment) {
+		return errors.New("must unset at least one parameter")
+	}
+	return nil
+}
+
+func (v *eventTables) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterEventTableOptions) error {
+	if opts == nil {
+		opts = &AlterEventTableOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// DropEventTableOptions contains options for dropping an event table. Event tables are dropped with DROP TABLE.
+type DropEventTableOptions struct {
+	drop     bool                   `ddl:"static" sql:"DROP"`  //lint:ignore U1000 This is used in the ddl tag
+	table    bool                   `ddl:"static" sql:"TABLE"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropEventTableOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *eventTables) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropEventTableOptions) error {
+	if opts == nil {
+		opts = &DropEventTableOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return fmt.Errorf("validate drop options: %w", err)
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ShowEventTableOptions contains options for listing event tables.
+type ShowEventTableOptions struct {
+	show        bool    `ddl:"static" sql:"SHOW"`         //lint:ignore U1000 This is used in the ddl tag
+	eventTables bool    `ddl:"static" sql:"EVENT TABLES"` //lint:ignore U1000 This is used in the ddl tag
+	Like        *Like   `ddl:"keyword" sql:"LIKE"`
+	In          *In     `ddl:"keyword" sql:"IN"`
+	StartsWith  *string `ddl:"parameter,single_quotes,no_equals" sql:"STARTS WITH"`
+	Limit       *int    `ddl:"parameter,no_equals" sql:"LIMIT"`
+}
+
+func (opts *ShowEventTableOptions) validate() error {
+	return nil
+}
+
+// EventTable is a user friendly result for a SHOW EVENT TABLES query.
+type EventTable struct {
+	CreatedOn     time.Time
+	Name          string
+	DatabaseName  string
+	SchemaName    string
+	Owner         string
+	Comment       string
+	OwnerRoleType string
+}
+
+func (v *EventTable) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *EventTable) ObjectType() ObjectType {
+	return ObjectTypeEventTable
+}
+
+// eventTableDBRow is used to decode the result of a SHOW EVENT TABLES query.
+type eventTableDBRow struct {
+	CreatedOn     time.Time      `db:"created_on"`
+	Name          string         `db:"name"`
+	DatabaseName  string         `db:"database_name"`
+	SchemaName    string         `db:"schema_name"`
+	Owner         sql.NullString `db:"owner"`
+	Comment       sql.NullString `db:"comment"`
+	OwnerRoleType sql.NullString `db:"owner_role_type"`
+}
+
+func (row eventTableDBRow) toEventTable() *EventTable {
+	return &EventTable{
+		CreatedOn:     row.CreatedOn,
+		Name:          row.Name,
+		DatabaseName:  row.DatabaseName,
+		SchemaName:    row.SchemaName,
+		Owner:         row.Owner.String,
+		Comment:       row.Comment.String,
+		OwnerRoleType: row.OwnerRoleType.String,
+	}
+}
+
+// Show lists all the event tables matching the options.
+func (v *eventTables) Show(ctx context.Context, opts *ShowEventTableOptions) ([]*EventTable, error) {
+	if opts == nil {
+		opts = &ShowEventTableOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []eventTableDBRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*EventTable, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toEventTable()
+	}
+	return resultList, nil
+}
+
+func (v *eventTables) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*EventTable, error) {
+	eventTables, err := v.Show(ctx, &ShowEventTableOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, eventTable := range eventTables {
+		if eventTable.Name == id.Name() {
+			return eventTable, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
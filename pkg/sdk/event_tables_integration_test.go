@@ -0,0 +1,181 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_EventTablesShow(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+
+	eventTableTest, eventTableCleanup := createEventTable(t, client, databaseTest, schemaTest)
+	t.Cleanup(eventTableCleanup)
+	eventTable2Test, eventTable2Cleanup := createEventTable(t, client, databaseTest, schemaTest)
+	t.Cleanup(eventTable2Cleanup)
+
+	t.Run("in schema", func(t *testing.T) {
+		eventTables, err := client.EventTables.Show(ctx, &ShowEventTableOptions{
+			In: &In{
+				Schema: schemaTest.ID(),
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, len(eventTables))
+	})
+
+	t.Run("with like", func(t *testing.T) {
+		eventTables, err := client.EventTables.Show(ctx, &ShowEventTableOptions{
+			Like: &Like{
+				Pattern: String(eventTableTest.Name),
+			},
+			In: &In{
+				Database: databaseTest.ID(),
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(eventTables))
+		assert.Equal(t, eventTableTest.ID(), eventTables[0].ID())
+		assert.NotEqual(t, eventTable2Test.Name, eventTables[0].Name)
+	})
+}
+
+func TestInt_EventTableCreate(t *testing.T) {
+	client := testClient(t)
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+
+	eventTable, eventTableCleanup := createEventTableWithOptions(t, client, databaseTest, schemaTest, &CreateEventTableOptions{
+		ClusterBy:               []string{"timestamp"},
+		DataRetentionTimeInDays: Int(1),
+		ChangeTracking:          Bool(true),
+		Comment:                 String("test comment"),
+	})
+	t.Cleanup(eventTableCleanup)
+
+	assert.Equal(t, databaseTest.Name, eventTable.DatabaseName)
+	assert.Equal(t, schemaTest.Name, eventTable.SchemaName)
+	assert.Equal(t, "test comment", eventTable.Comment)
+	assert.NotEmpty(t, eventTable.Owner)
+}
+
+func TestInt_EventTableAlter(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+
+	t.Run("set and unset comment", func(t *testing.T) {
+		eventTable, eventTableCleanup := createEventTable(t, client, databaseTest, schemaTest)
+		t.Cleanup(eventTableCleanup)
+		id := eventTable.ID()
+
+		err := client.EventTables.Alter(ctx, id, &AlterEventTableOptions{
+			Set: &EventTableSet{
+				DataRetentionTimeInDays: Int(2),
+				Comment:                 String("new comment"),
+			},
+		})
+		require.NoError(t, err)
+		eventTable, err = client.EventTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "new comment", eventTable.Comment)
+
+		err = client.EventTables.Alter(ctx, id, &AlterEventTableOptions{
+			Unset: &EventTableUnset{
+				DataRetentionTimeInDays: Bool(true),
+				Comment:                 Bool(true),
+			},
+		})
+		require.NoError(t, err)
+		eventTable, err = client.EventTables.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, eventTable.Comment)
+	})
+
+	t.Run("cluster by and drop clustering key", func(t *testing.T) {
+		eventTable, eventTableCleanup := createEventTable(t, client, databaseTest, schemaTest)
+		t.Cleanup(eventTableCleanup)
+		id := eventTable.ID()
+
+		err := client.EventTables.Alter(ctx, id, &AlterEventTableOptions{ClusterBy: []string{"timestamp"}})
+		require.NoError(t, err)
+		err = client.EventTables.Alter(ctx, id, &AlterEventTableOptions{DropClusteringKey: Bool(true)})
+		require.NoError(t, err)
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		eventTable, eventTableCleanup := createEventTable(t, client, databaseTest, schemaTest)
+		t.Cleanup(eventTableCleanup)
+		id := eventTable.ID()
+		newID := NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, randomStringRange(t, 8, 28))
+
+		err := client.EventTables.Alter(ctx, id, &AlterEventTableOptions{NewName: newID})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.EventTables.Drop(ctx, newID, &DropEventTableOptions{IfExists: Bool(true)})
+			require.NoError(t, err)
+		})
+		eventTable, err = client.EventTables.ShowByID(ctx, newID)
+		require.NoError(t, err)
+		assert.Equal(t, newID.Name(), eventTable.Name)
+	})
+
+	t.Run("set event table on account", func(t *testing.T) {
+		eventTable, eventTableCleanup := createEventTable(t, client, databaseTest, schemaTest)
+		t.Cleanup(eventTableCleanup)
+
+		err := client.Accounts.Alter(ctx, &AlterAccountOptions{
+			Set: &AccountSet{
+				Parameters: &AccountLevelParameters{
+					AccountParameters: &AccountParameters{
+						EventTable: String(eventTable.ID().FullyQualifiedName()),
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.Accounts.Alter(ctx, &AlterAccountOptions{
+				Unset: &AccountUnset{
+					Parameters: &AccountLevelParametersUnset{
+						AccountParameters: &AccountParametersUnset{
+							EventTable: Bool(true),
+						},
+					},
+				},
+			})
+			require.NoError(t, err)
+		})
+	})
+}
+
+func TestInt_EventTableDrop(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+
+	eventTable, _ := createEventTable(t, client, databaseTest, schemaTest)
+	id := eventTable.ID()
+	err := client.EventTables.Drop(ctx, id, nil)
+	require.NoError(t, err)
+	_, err = client.EventTables.ShowByID(ctx, id)
+	assert.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+
+	err = client.EventTables.Drop(ctx, id, &DropEventTableOptions{IfExists: Bool(true)})
+	require.NoError(t, err)
+}
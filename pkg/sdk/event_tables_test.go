@@ -0,0 +1,187 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventTableCreate(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("empty options", func(t *testing.T) {
+		opts := &CreateEventTableOptions{
+			name: id,
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE EVENT TABLE %s`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &CreateEventTableOptions{
+			OrReplace:                  Bool(true),
+			name:                       id,
+			ClusterBy:                  []string{"timestamp"},
+			DataRetentionTimeInDays:    Int(7),
+			MaxDataExtensionTimeInDays: Int(14),
+			ChangeTracking:             Bool(true),
+			DefaultDDLCollation:        String("en_US"),
+			CopyGrants:                 Bool(true),
+			Comment:                    String("test comment"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE OR REPLACE EVENT TABLE %s CLUSTER BY (timestamp) DATA_RETENTION_TIME_IN_DAYS = 7 MAX_DATA_EXTENSION_TIME_IN_DAYS = 14 CHANGE_TRACKING = true DEFAULT_DDL_COLLATION = 'en_US' COPY GRANTS COMMENT = 'test comment'`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: or replace and if not exists", func(t *testing.T) {
+		opts := &CreateEventTableOptions{
+			OrReplace:   Bool(true),
+			IfNotExists: Bool(true),
+			name:        id,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: data retention time out of range", func(t *testing.T) {
+		opts := &CreateEventTableOptions{
+			name:                    id,
+			DataRetentionTimeInDays: Int(91),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestEventTableAlter(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("rename", func(t *testing.T) {
+		newID := randomSchemaObjectIdentifier(t)
+		opts := &AlterEventTableOptions{
+			IfExists: Bool(true),
+			name:     id,
+			NewName:  newID,
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER TABLE IF EXISTS %s RENAME TO %s", id.FullyQualifiedName(), newID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("cluster by", func(t *testing.T) {
+		opts := &AlterEventTableOptions{
+			name:      id,
+			ClusterBy: []string{"timestamp", "observed_timestamp"},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER TABLE %s CLUSTER BY (timestamp, observed_timestamp)", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("drop clustering key", func(t *testing.T) {
+		opts := &AlterEventTableOptions{
+			name:              id,
+			DropClusteringKey: Bool(true),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER TABLE %s DROP CLUSTERING KEY", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with set", func(t *testing.T) {
+		opts := &AlterEventTableOptions{
+			name: id,
+			Set: &EventTableSet{
+				DataRetentionTimeInDays: Int(1),
+				ChangeTracking:          Bool(false),
+				Comment:                 String("new comment"),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER TABLE %s SET DATA_RETENTION_TIME_IN_DAYS = 1 CHANGE_TRACKING = false COMMENT = 'new comment'", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with unset", func(t *testing.T) {
+		opts := &AlterEventTableOptions{
+			name: id,
+			Unset: &EventTableUnset{
+				MaxDataExtensionTimeInDays: Bool(true),
+				Comment:                    Bool(true),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER TABLE %s UNSET MAX_DATA_EXTENSION_TIME_IN_DAYS, COMMENT", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: no action", func(t *testing.T) {
+		opts := &AlterEventTableOptions{
+			name: id,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: empty set", func(t *testing.T) {
+		opts := &AlterEventTableOptions{
+			name: id,
+			Set:  &EventTableSet{},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestEventTableDrop(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("with if exists", func(t *testing.T) {
+		opts := &DropEventTableOptions{
+			IfExists: Bool(true),
+			name:     id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("DROP TABLE IF EXISTS %s", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestEventTableShow(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("empty options", func(t *testing.T) {
+		opts := &ShowEventTableOptions{}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, "SHOW EVENT TABLES", actual)
+	})
+
+	t.Run("with like and in schema", func(t *testing.T) {
+		opts := &ShowEventTableOptions{
+			Like: &Like{
+				Pattern: String(id.Name()),
+			},
+			In: &In{
+				Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`SHOW EVENT TABLES LIKE '%s' IN SCHEMA "%s"."%s"`, id.Name(), id.DatabaseName(), id.SchemaName())
+		assert.Equal(t, expected, actual)
+	})
+}
@@ -8,6 +8,11 @@ import (
 )
 
 type Grants interface {
+	GrantPrivilegesToAccountRole(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role AccountObjectIdentifier, opts *GrantPrivilegesToAccountRoleOptions) error
+	RevokePrivilegesFromAccountRole(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role AccountObjectIdentifier, opts *RevokePrivilegesFromAccountRoleOptions) error
+	GrantPrivilegesToDatabaseRole(ctx context.Context, privileges *DatabaseRoleGrantPrivileges, on *DatabaseRoleGrantOn, role DatabaseObjectIdentifier, opts *GrantPrivilegesToDatabaseRoleOptions) error
+	RevokePrivilegesFromDatabaseRole(ctx context.Context, privileges *DatabaseRoleGrantPrivileges, on *DatabaseRoleGrantOn, role DatabaseObjectIdentifier, opts *RevokePrivilegesFromDatabaseRoleOptions) error
+	GrantOwnership(ctx context.Context, on OwnershipGrantOn, to OwnershipGrantTo, opts *GrantOwnershipOptions) error
 	GrantPrivilegeToShare(ctx context.Context, objectPrivilege Privilege, on *GrantPrivilegeToShareOn, to AccountObjectIdentifier) error
 	RevokePrivilegeFromShare(ctx context.Context, objectPrivilege Privilege, on *RevokePrivilegeFromShareOn, from AccountObjectIdentifier) error
 	Show(ctx context.Context, opts *ShowGrantOptions) ([]*Grant, error)
@@ -38,14 +43,23 @@ type grantRow struct {
 	CreatedOn   time.Time `db:"created_on"`
 	Privilege   string    `db:"privilege"`
 	GrantedOn   string    `db:"granted_on"`
+	GrantOn     string    `db:"grant_on"`
 	Name        string    `db:"name"`
 	GrantedTo   string    `db:"granted_to"`
+	GrantTo     string    `db:"grant_to"`
 	GranteeName string    `db:"grantee_name"`
 	GrantOption bool      `db:"grant_option"`
 	GrantedBy   string    `db:"granted_by"`
 }
 
 func (row *grantRow) toGrant() (*Grant, error) {
+	// SHOW FUTURE GRANTS names the columns grant_on and grant_to instead of granted_on and granted_to
+	if row.GrantedOn == "" {
+		row.GrantedOn = row.GrantOn
+	}
+	if row.GrantedTo == "" {
+		row.GrantedTo = row.GrantTo
+	}
 	grantedTo := ObjectType(row.GrantedTo)
 	granteeName := NewAccountObjectIdentifier(row.GranteeName)
 	if grantedTo == ObjectTypeShare {
@@ -66,6 +80,431 @@ func (row *grantRow) toGrant() (*Grant, error) {
 	return grant, nil
 }
 
+// GrantPrivilegesToAccountRoleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/grant-privilege.
+type GrantPrivilegesToAccountRoleOptions struct {
+	grant           bool                        `ddl:"static" sql:"GRANT"` //lint:ignore U1000 This is used in the ddl tag
+	privileges      *AccountRoleGrantPrivileges `ddl:"-"`
+	on              *AccountRoleGrantOn         `ddl:"keyword" sql:"ON"`
+	accountRole     AccountObjectIdentifier     `ddl:"identifier" sql:"TO ROLE"`
+	WithGrantOption *bool                       `ddl:"keyword" sql:"WITH GRANT OPTION"`
+}
+
+func (opts *GrantPrivilegesToAccountRoleOptions) validate() error {
+	if !validObjectidentifier(opts.accountRole) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !valueSet(opts.privileges) || !valueSet(opts.on) {
+		return fmt.Errorf("privileges and on are required")
+	}
+	if err := opts.privileges.validate(); err != nil {
+		return err
+	}
+	return opts.on.validate(opts.privileges)
+}
+
+type AccountRoleGrantPrivileges struct {
+	GlobalPrivileges        []GlobalPrivilege        `ddl:"-"`
+	AccountObjectPrivileges []AccountObjectPrivilege `ddl:"-"`
+	SchemaPrivileges        []SchemaPrivilege        `ddl:"-"`
+	SchemaObjectPrivileges  []SchemaObjectPrivilege  `ddl:"-"`
+	AllPrivileges           *bool                    `ddl:"keyword" sql:"ALL PRIVILEGES"`
+}
+
+func (v *AccountRoleGrantPrivileges) validate() error {
+	if !exactlyOneValueSet(v.AllPrivileges, v.GlobalPrivileges, v.AccountObjectPrivileges, v.SchemaPrivileges, v.SchemaObjectPrivileges) {
+		return fmt.Errorf("exactly one of AllPrivileges, GlobalPrivileges, AccountObjectPrivileges, SchemaPrivileges, or SchemaObjectPrivileges must be set")
+	}
+	return nil
+}
+
+type AccountRoleGrantOn struct {
+	Account       *bool                 `ddl:"keyword" sql:"ACCOUNT"`
+	AccountObject *GrantOnAccountObject `ddl:"-"`
+	Schema        *GrantOnSchema        `ddl:"-"`
+	SchemaObject  *GrantOnSchemaObject  `ddl:"-"`
+}
+
+func (v *AccountRoleGrantOn) validate(privileges *AccountRoleGrantPrivileges) error {
+	if !exactlyOneValueSet(v.Account, v.AccountObject, v.Schema, v.SchemaObject) {
+		return fmt.Errorf("exactly one of Account, AccountObject, Schema, or SchemaObject must be set")
+	}
+	all := privileges.AllPrivileges != nil && *privileges.AllPrivileges
+	switch {
+	case valueSet(v.Account):
+		if !all && !valueSet(privileges.GlobalPrivileges) {
+			return fmt.Errorf("only global privileges can be granted on account")
+		}
+	case valueSet(v.AccountObject):
+		if !all && !valueSet(privileges.AccountObjectPrivileges) {
+			return fmt.Errorf("only account object privileges can be granted on an account object")
+		}
+		return v.AccountObject.validate()
+	case valueSet(v.Schema):
+		if !all && !valueSet(privileges.SchemaPrivileges) {
+			return fmt.Errorf("only schema privileges can be granted on a schema")
+		}
+		return v.Schema.validate()
+	case valueSet(v.SchemaObject):
+		if !all && !valueSet(privileges.SchemaObjectPrivileges) {
+			return fmt.Errorf("only schema object privileges can be granted on a schema object")
+		}
+		return v.SchemaObject.validate()
+	}
+	return nil
+}
+
+type GrantOnAccountObject struct {
+	User             *AccountObjectIdentifier `ddl:"identifier" sql:"USER"`
+	ResourceMonitor  *AccountObjectIdentifier `ddl:"identifier" sql:"RESOURCE MONITOR"`
+	Warehouse        *AccountObjectIdentifier `ddl:"identifier" sql:"WAREHOUSE"`
+	Database         *AccountObjectIdentifier `ddl:"identifier" sql:"DATABASE"`
+	Integration      *AccountObjectIdentifier `ddl:"identifier" sql:"INTEGRATION"`
+	FailoverGroup    *AccountObjectIdentifier `ddl:"identifier" sql:"FAILOVER GROUP"`
+	ReplicationGroup *AccountObjectIdentifier `ddl:"identifier" sql:"REPLICATION GROUP"`
+}
+
+func (v *GrantOnAccountObject) validate() error {
+	if !exactlyOneValueSet(v.User, v.ResourceMonitor, v.Warehouse, v.Database, v.Integration, v.FailoverGroup, v.ReplicationGroup) {
+		return fmt.Errorf("exactly one of User, ResourceMonitor, Warehouse, Database, Integration, FailoverGroup, or ReplicationGroup must be set")
+	}
+	return nil
+}
+
+type GrantOnSchema struct {
+	Schema                  *SchemaIdentifier        `ddl:"identifier" sql:"SCHEMA"`
+	AllSchemasInDatabase    *AccountObjectIdentifier `ddl:"identifier" sql:"ALL SCHEMAS IN DATABASE"`
+	FutureSchemasInDatabase *AccountObjectIdentifier `ddl:"identifier" sql:"FUTURE SCHEMAS IN DATABASE"`
+}
+
+func (v *GrantOnSchema) validate() error {
+	if !exactlyOneValueSet(v.Schema, v.AllSchemasInDatabase, v.FutureSchemasInDatabase) {
+		return fmt.Errorf("exactly one of Schema, AllSchemasInDatabase, or FutureSchemasInDatabase must be set")
+	}
+	return nil
+}
+
+type GrantOnSchemaObject struct {
+	SchemaObject *Object                `ddl:"-"`
+	All          *GrantOnSchemaObjectIn `ddl:"keyword" sql:"ALL"`
+	Future       *GrantOnSchemaObjectIn `ddl:"keyword" sql:"FUTURE"`
+}
+
+func (v *GrantOnSchemaObject) validate() error {
+	if !exactlyOneValueSet(v.SchemaObject, v.All, v.Future) {
+		return fmt.Errorf("exactly one of SchemaObject, All, or Future must be set")
+	}
+	if valueSet(v.All) {
+		return v.All.validate()
+	}
+	if valueSet(v.Future) {
+		return v.Future.validate()
+	}
+	return nil
+}
+
+// GrantOnSchemaObjectIn targets every (ALL) or every future (FUTURE) object of a given type in a database or schema.
+type GrantOnSchemaObjectIn struct {
+	PluralObjectType PluralObjectType         `ddl:"keyword"`
+	InDatabase       *AccountObjectIdentifier `ddl:"identifier" sql:"IN DATABASE"`
+	InSchema         *SchemaIdentifier        `ddl:"identifier" sql:"IN SCHEMA"`
+}
+
+func (v *GrantOnSchemaObjectIn) validate() error {
+	if v.PluralObjectType == "" {
+		return fmt.Errorf("PluralObjectType is required")
+	}
+	if !exactlyOneValueSet(v.InDatabase, v.InSchema) {
+		return fmt.Errorf("exactly one of InDatabase or InSchema must be set")
+	}
+	return nil
+}
+
+func (v *grants) GrantPrivilegesToAccountRole(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role AccountObjectIdentifier, opts *GrantPrivilegesToAccountRoleOptions) error {
+	if opts == nil {
+		opts = &GrantPrivilegesToAccountRoleOptions{}
+	}
+	opts.privileges = privileges
+	opts.on = on
+	opts.accountRole = role
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// RevokePrivilegesFromAccountRoleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/revoke-privilege.
+type RevokePrivilegesFromAccountRoleOptions struct {
+	revoke         bool                        `ddl:"static" sql:"REVOKE"` //lint:ignore U1000 This is used in the ddl tag
+	GrantOptionFor *bool                       `ddl:"keyword" sql:"GRANT OPTION FOR"`
+	privileges     *AccountRoleGrantPrivileges `ddl:"-"`
+	on             *AccountRoleGrantOn         `ddl:"keyword" sql:"ON"`
+	accountRole    AccountObjectIdentifier     `ddl:"identifier" sql:"FROM ROLE"`
+	Restrict       *bool                       `ddl:"keyword" sql:"RESTRICT"`
+	Cascade        *bool                       `ddl:"keyword" sql:"CASCADE"`
+}
+
+func (opts *RevokePrivilegesFromAccountRoleOptions) validate() error {
+	if !validObjectidentifier(opts.accountRole) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !valueSet(opts.privileges) || !valueSet(opts.on) {
+		return fmt.Errorf("privileges and on are required")
+	}
+	if err := opts.privileges.validate(); err != nil {
+		return err
+	}
+	if err := opts.on.validate(opts.privileges); err != nil {
+		return err
+	}
+	if everyValueSet(opts.Restrict, opts.Cascade) {
+		return fmt.Errorf("either Restrict or Cascade can be set, or neither but not both")
+	}
+	return nil
+}
+
+func (v *grants) RevokePrivilegesFromAccountRole(ctx context.Context, privileges *AccountRoleGrantPrivileges, on *AccountRoleGrantOn, role AccountObjectIdentifier, opts *RevokePrivilegesFromAccountRoleOptions) error {
+	if opts == nil {
+		opts = &RevokePrivilegesFromAccountRoleOptions{}
+	}
+	opts.privileges = privileges
+	opts.on = on
+	opts.accountRole = role
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// GrantPrivilegesToDatabaseRoleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/grant-privilege.
+type GrantPrivilegesToDatabaseRoleOptions struct {
+	grant           bool                         `ddl:"static" sql:"GRANT"` //lint:ignore U1000 This is used in the ddl tag
+	privileges      *DatabaseRoleGrantPrivileges `ddl:"-"`
+	on              *DatabaseRoleGrantOn         `ddl:"keyword" sql:"ON"`
+	databaseRole    DatabaseObjectIdentifier     `ddl:"identifier" sql:"TO DATABASE ROLE"`
+	WithGrantOption *bool                        `ddl:"keyword" sql:"WITH GRANT OPTION"`
+}
+
+func (opts *GrantPrivilegesToDatabaseRoleOptions) validate() error {
+	if !validObjectidentifier(opts.databaseRole) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !valueSet(opts.privileges) || !valueSet(opts.on) {
+		return fmt.Errorf("privileges and on are required")
+	}
+	if err := opts.privileges.validate(); err != nil {
+		return err
+	}
+	return opts.on.validate(opts.privileges)
+}
+
+type DatabaseRoleGrantPrivileges struct {
+	DatabasePrivileges     []AccountObjectPrivilege `ddl:"-"`
+	SchemaPrivileges       []SchemaPrivilege        `ddl:"-"`
+	SchemaObjectPrivileges []SchemaObjectPrivilege  `ddl:"-"`
+	AllPrivileges          *bool                    `ddl:"keyword" sql:"ALL PRIVILEGES"`
+}
+
+func (v *DatabaseRoleGrantPrivileges) validate() error {
+	if !exactlyOneValueSet(v.AllPrivileges, v.DatabasePrivileges, v.SchemaPrivileges, v.SchemaObjectPrivileges) {
+		return fmt.Errorf("exactly one of AllPrivileges, DatabasePrivileges, SchemaPrivileges, or SchemaObjectPrivileges must be set")
+	}
+	return nil
+}
+
+type DatabaseRoleGrantOn struct {
+	Database     *AccountObjectIdentifier `ddl:"identifier" sql:"DATABASE"`
+	Schema       *GrantOnSchema           `ddl:"-"`
+	SchemaObject *GrantOnSchemaObject     `ddl:"-"`
+}
+
+func (v *DatabaseRoleGrantOn) validate(privileges *DatabaseRoleGrantPrivileges) error {
+	if !exactlyOneValueSet(v.Database, v.Schema, v.SchemaObject) {
+		return fmt.Errorf("exactly one of Database, Schema, or SchemaObject must be set")
+	}
+	all := privileges.AllPrivileges != nil && *privileges.AllPrivileges
+	switch {
+	case valueSet(v.Database):
+		if !all && !valueSet(privileges.DatabasePrivileges) {
+			return fmt.Errorf("only database privileges can be granted on a database")
+		}
+	case valueSet(v.Schema):
+		if !all && !valueSet(privileges.SchemaPrivileges) {
+			return fmt.Errorf("only schema privileges can be granted on a schema")
+		}
+		return v.Schema.validate()
+	case valueSet(v.SchemaObject):
+		if !all && !valueSet(privileges.SchemaObjectPrivileges) {
+			return fmt.Errorf("only schema object privileges can be granted on a schema object")
+		}
+		return v.SchemaObject.validate()
+	}
+	return nil
+}
+
+func (v *grants) GrantPrivilegesToDatabaseRole(ctx context.Context, privileges *DatabaseRoleGrantPrivileges, on *DatabaseRoleGrantOn, role DatabaseObjectIdentifier, opts *GrantPrivilegesToDatabaseRoleOptions) error {
+	if opts == nil {
+		opts = &GrantPrivilegesToDatabaseRoleOptions{}
+	}
+	opts.privileges = privileges
+	opts.on = on
+	opts.databaseRole = role
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// RevokePrivilegesFromDatabaseRoleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/revoke-privilege.
+type RevokePrivilegesFromDatabaseRoleOptions struct {
+	revoke         bool                         `ddl:"static" sql:"REVOKE"` //lint:ignore U1000 This is used in the ddl tag
+	GrantOptionFor *bool                        `ddl:"keyword" sql:"GRANT OPTION FOR"`
+	privileges     *DatabaseRoleGrantPrivileges `ddl:"-"`
+	on             *DatabaseRoleGrantOn         `ddl:"keyword" sql:"ON"`
+	databaseRole   DatabaseObjectIdentifier     `ddl:"identifier" sql:"FROM DATABASE ROLE"`
+	Restrict       *bool                        `ddl:"keyword" sql:"RESTRICT"`
+	Cascade        *bool                        `ddl:"keyword" sql:"CASCADE"`
+}
+
+func (opts *RevokePrivilegesFromDatabaseRoleOptions) validate() error {
+	if !validObjectidentifier(opts.databaseRole) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !valueSet(opts.privileges) || !valueSet(opts.on) {
+		return fmt.Errorf("privileges and on are required")
+	}
+	if err := opts.privileges.validate(); err != nil {
+		return err
+	}
+	if err := opts.on.validate(opts.privileges); err != nil {
+		return err
+	}
+	if everyValueSet(opts.Restrict, opts.Cascade) {
+		return fmt.Errorf("either Restrict or Cascade can be set, or neither but not both")
+	}
+	return nil
+}
+
+func (v *grants) RevokePrivilegesFromDatabaseRole(ctx context.Context, privileges *DatabaseRoleGrantPrivileges, on *DatabaseRoleGrantOn, role DatabaseObjectIdentifier, opts *RevokePrivilegesFromDatabaseRoleOptions) error {
+	if opts == nil {
+		opts = &RevokePrivilegesFromDatabaseRoleOptions{}
+	}
+	opts.privileges = privileges
+	opts.on = on
+	opts.databaseRole = role
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// GrantOwnershipOptions is based on https://docs.snowflake.com/en/sql-reference/sql/grant-ownership.
+type GrantOwnershipOptions struct {
+	grantOwnership bool                    `ddl:"static" sql:"GRANT OWNERSHIP"` //lint:ignore U1000 This is used in the ddl tag
+	on             OwnershipGrantOn        `ddl:"keyword" sql:"ON"`
+	to             OwnershipGrantTo        `ddl:"keyword" sql:"TO"`
+	CurrentGrants  *OwnershipCurrentGrants `ddl:"-"`
+}
+
+func (opts *GrantOwnershipOptions) validate() error {
+	if err := opts.on.validate(); err != nil {
+		return err
+	}
+	if err := opts.to.validate(); err != nil {
+		return err
+	}
+	if valueSet(opts.CurrentGrants) {
+		return opts.CurrentGrants.validate()
+	}
+	return nil
+}
+
+type OwnershipGrantOn struct {
+	Object *Object                `ddl:"-"`
+	All    *GrantOnSchemaObjectIn `ddl:"keyword" sql:"ALL"`
+	Future *GrantOnSchemaObjectIn `ddl:"keyword" sql:"FUTURE"`
+}
+
+func (v *OwnershipGrantOn) validate() error {
+	if !exactlyOneValueSet(v.Object, v.All, v.Future) {
+		return fmt.Errorf("exactly one of Object, All, or Future must be set")
+	}
+	if valueSet(v.All) {
+		return v.All.validate()
+	}
+	if valueSet(v.Future) {
+		return v.Future.validate()
+	}
+	return nil
+}
+
+type OwnershipGrantTo struct {
+	DatabaseRoleName *DatabaseObjectIdentifier `ddl:"identifier" sql:"DATABASE ROLE"`
+	AccountRoleName  *AccountObjectIdentifier  `ddl:"identifier" sql:"ROLE"`
+}
+
+func (v *OwnershipGrantTo) validate() error {
+	if !exactlyOneValueSet(v.DatabaseRoleName, v.AccountRoleName) {
+		return fmt.Errorf("exactly one of DatabaseRoleName or AccountRoleName must be set")
+	}
+	return nil
+}
+
+type OwnershipCurrentGrantsOutboundPrivileges string
+
+const (
+	Copy   OwnershipCurrentGrantsOutboundPrivileges = "COPY"
+	Revoke OwnershipCurrentGrantsOutboundPrivileges = "REVOKE"
+)
+
+type OwnershipCurrentGrants struct {
+	OutboundPrivileges OwnershipCurrentGrantsOutboundPrivileges `ddl:"keyword"`
+	currentGrants      bool                                     `ddl:"static" sql:"CURRENT GRANTS"` //lint:ignore U1000 This is used in the ddl tag
+}
+
+func (v *OwnershipCurrentGrants) validate() error {
+	if v.OutboundPrivileges != Copy && v.OutboundPrivileges != Revoke {
+		return fmt.Errorf("OutboundPrivileges must be one of COPY or REVOKE")
+	}
+	return nil
+}
+
+func (v *grants) GrantOwnership(ctx context.Context, on OwnershipGrantOn, to OwnershipGrantTo, opts *GrantOwnershipOptions) error {
+	if opts == nil {
+		opts = &GrantOwnershipOptions{}
+	}
+	opts.on = on
+	opts.to = to
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
 type grantPrivilegeToShareOptions struct {
 	grant           bool                     `ddl:"static" sql:"GRANT"` //lint:ignore U1000 This is used in the ddl tag
 	objectPrivilege Privilege                `ddl:"keyword"`
@@ -210,19 +649,27 @@ func (v *grants) RevokePrivilegeFromShare(ctx context.Context, objectPrivilege P
 }
 
 type ShowGrantOptions struct {
-	show   bool          `ddl:"static" sql:"SHOW"`   //lint:ignore U1000 This is used in the ddl tag
+	show   bool          `ddl:"static" sql:"SHOW"` //lint:ignore U1000 This is used in the ddl tag
+	Future *bool         `ddl:"keyword" sql:"FUTURE"`
 	grants bool          `ddl:"static" sql:"GRANTS"` //lint:ignore U1000 This is used in the ddl tag
 	On     *ShowGrantsOn `ddl:"keyword" sql:"ON"`
 	To     *ShowGrantsTo `ddl:"keyword" sql:"TO"`
 	Of     *ShowGrantsOf `ddl:"keyword" sql:"OF"`
+	In     *ShowGrantsIn `ddl:"keyword" sql:"IN"`
 }
 
 func (opts *ShowGrantOptions) validate() error {
-	if everyValueNil(opts.On, opts.To, opts.Of) {
-		return fmt.Errorf("at least one of on, to, or of is required")
+	if everyValueNil(opts.On, opts.To, opts.Of, opts.In) {
+		return fmt.Errorf("at least one of on, to, of, or in is required")
+	}
+	if !exactlyOneValueSet(opts.On, opts.To, opts.Of, opts.In) {
+		return fmt.Errorf("only one of on, to, of, or in can be set")
 	}
-	if !exactlyOneValueSet(opts.On, opts.To, opts.Of) {
-		return fmt.Errorf("only one of on, to, or of can be set")
+	if valueSet(opts.Future) && anyValueSet(opts.On, opts.Of) {
+		return fmt.Errorf("future grants can only be shown in a database or schema, or to a role")
+	}
+	if valueSet(opts.In) && !exactlyOneValueSet(opts.In.Database, opts.In.Schema) {
+		return fmt.Errorf("exactly one of database or schema must be set in in")
 	}
 	return nil
 }
@@ -233,14 +680,21 @@ type ShowGrantsOn struct {
 }
 
 type ShowGrantsTo struct {
-	Role  AccountObjectIdentifier `ddl:"identifier" sql:"ROLE"`
-	User  AccountObjectIdentifier `ddl:"identifier" sql:"USER"`
-	Share AccountObjectIdentifier `ddl:"identifier" sql:"SHARE"`
+	Role         AccountObjectIdentifier  `ddl:"identifier" sql:"ROLE"`
+	DatabaseRole DatabaseObjectIdentifier `ddl:"identifier" sql:"DATABASE ROLE"`
+	User         AccountObjectIdentifier  `ddl:"identifier" sql:"USER"`
+	Share        AccountObjectIdentifier  `ddl:"identifier" sql:"SHARE"`
 }
 
 type ShowGrantsOf struct {
-	Role  AccountObjectIdentifier `ddl:"identifier" sql:"ROLE"`
-	Share AccountObjectIdentifier `ddl:"identifier" sql:"SHARE"`
+	Role         AccountObjectIdentifier  `ddl:"identifier" sql:"ROLE"`
+	DatabaseRole DatabaseObjectIdentifier `ddl:"identifier" sql:"DATABASE ROLE"`
+	Share        AccountObjectIdentifier  `ddl:"identifier" sql:"SHARE"`
+}
+
+type ShowGrantsIn struct {
+	Database *AccountObjectIdentifier `ddl:"identifier" sql:"DATABASE"`
+	Schema   *SchemaIdentifier        `ddl:"identifier" sql:"SCHEMA"`
 }
 
 func (v *grants) Show(ctx context.Context, opts *ShowGrantOptions) ([]*Grant, error) {
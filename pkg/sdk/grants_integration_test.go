@@ -2,6 +2,7 @@ package sdk
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -110,3 +111,174 @@ func TestInt_ShowGrants(t *testing.T) {
 		assert.LessOrEqual(t, 2, len(grants))
 	})
 }
+
+func TestInt_GrantAndRevokePrivilegesToAccountRole(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+
+	showGrantsTo := func(t *testing.T, role AccountObjectIdentifier) []*Grant {
+		t.Helper()
+		grants, err := client.Grants.Show(ctx, &ShowGrantOptions{
+			To: &ShowGrantsTo{
+				Role: role,
+			},
+		})
+		require.NoError(t, err)
+		return grants
+	}
+
+	t.Run("on account", func(t *testing.T) {
+		role, roleCleanup := createRole(t, client)
+		t.Cleanup(roleCleanup)
+		privileges := &AccountRoleGrantPrivileges{
+			GlobalPrivileges: []GlobalPrivilege{GlobalPrivilegeMonitorUsage},
+		}
+		on := &AccountRoleGrantOn{
+			Account: Bool(true),
+		}
+		err := client.Grants.GrantPrivilegesToAccountRole(ctx, privileges, on, role.ID(), &GrantPrivilegesToAccountRoleOptions{
+			WithGrantOption: Bool(true),
+		})
+		require.NoError(t, err)
+		grants := showGrantsTo(t, role.ID())
+		require.Len(t, grants, 1)
+		assert.Equal(t, Privilege(GlobalPrivilegeMonitorUsage), grants[0].Privilege)
+		assert.True(t, grants[0].GrantOption)
+
+		err = client.Grants.RevokePrivilegesFromAccountRole(ctx, privileges, on, role.ID(), nil)
+		require.NoError(t, err)
+		assert.Empty(t, showGrantsTo(t, role.ID()))
+	})
+
+	t.Run("on schema object", func(t *testing.T) {
+		role, roleCleanup := createRole(t, client)
+		t.Cleanup(roleCleanup)
+		privileges := &AccountRoleGrantPrivileges{
+			SchemaObjectPrivileges: []SchemaObjectPrivilege{SchemaObjectPrivilegeSelect},
+		}
+		on := &AccountRoleGrantOn{
+			SchemaObject: &GrantOnSchemaObject{
+				All: &GrantOnSchemaObjectIn{
+					PluralObjectType: PluralObjectTypeTables,
+					InSchema:         Pointer(schema.ID()),
+				},
+			},
+		}
+		err := client.Grants.GrantPrivilegesToAccountRole(ctx, privileges, on, role.ID(), nil)
+		require.NoError(t, err)
+		err = client.Grants.RevokePrivilegesFromAccountRole(ctx, privileges, on, role.ID(), nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("on future schema objects", func(t *testing.T) {
+		role, roleCleanup := createRole(t, client)
+		t.Cleanup(roleCleanup)
+		privileges := &AccountRoleGrantPrivileges{
+			SchemaObjectPrivileges: []SchemaObjectPrivilege{SchemaObjectPrivilegeSelect},
+		}
+		on := &AccountRoleGrantOn{
+			SchemaObject: &GrantOnSchemaObject{
+				Future: &GrantOnSchemaObjectIn{
+					PluralObjectType: PluralObjectTypeTables,
+					InSchema:         Pointer(schema.ID()),
+				},
+			},
+		}
+		err := client.Grants.GrantPrivilegesToAccountRole(ctx, privileges, on, role.ID(), nil)
+		require.NoError(t, err)
+		grants, err := client.Grants.Show(ctx, &ShowGrantOptions{
+			Future: Bool(true),
+			In: &ShowGrantsIn{
+				Schema: Pointer(schema.ID()),
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, grants, 1)
+		assert.Equal(t, PrivilegeSelect, grants[0].Privilege)
+		assert.Equal(t, ObjectTypeTable, grants[0].GrantedOn)
+		assert.Equal(t, ObjectTypeRole, grants[0].GrantedTo)
+
+		err = client.Grants.RevokePrivilegesFromAccountRole(ctx, privileges, on, role.ID(), nil)
+		require.NoError(t, err)
+	})
+}
+
+func TestInt_GrantAndRevokePrivilegesToDatabaseRole(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+
+	role, roleCleanup := createDatabaseRole(t, client, database)
+	t.Cleanup(roleCleanup)
+
+	privileges := &DatabaseRoleGrantPrivileges{
+		DatabasePrivileges: []AccountObjectPrivilege{AccountObjectPrivilegeCreateSchema},
+	}
+	on := &DatabaseRoleGrantOn{
+		Database: Pointer(database.ID()),
+	}
+	err := client.Grants.GrantPrivilegesToDatabaseRole(ctx, privileges, on, role, nil)
+	require.NoError(t, err)
+	grants, err := client.Grants.Show(ctx, &ShowGrantOptions{
+		To: &ShowGrantsTo{
+			DatabaseRole: role,
+		},
+	})
+	require.NoError(t, err)
+	var found bool
+	for _, grant := range grants {
+		if grant.Privilege == Privilege(AccountObjectPrivilegeCreateSchema) {
+			found = true
+		}
+	}
+	assert.True(t, found)
+
+	err = client.Grants.RevokePrivilegesFromDatabaseRole(ctx, privileges, on, role, nil)
+	require.NoError(t, err)
+}
+
+func TestInt_GrantOwnership(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	database, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := createSchema(t, client, database)
+	t.Cleanup(schemaCleanup)
+	role, roleCleanup := createRole(t, client)
+	t.Cleanup(roleCleanup)
+
+	currentRole, err := client.ContextFunctions.CurrentRole(ctx)
+	require.NoError(t, err)
+
+	on := OwnershipGrantOn{
+		Object: &Object{
+			ObjectType: ObjectTypeSchema,
+			Name:       schema.ID(),
+		},
+	}
+	err = client.Grants.GrantOwnership(ctx, on, OwnershipGrantTo{
+		AccountRoleName: Pointer(role.ID()),
+	}, &GrantOwnershipOptions{
+		CurrentGrants: &OwnershipCurrentGrants{
+			OutboundPrivileges: Copy,
+		},
+	})
+	require.NoError(t, err)
+	// the role has to be granted to the current role before the ownership can be transferred back
+	_, err = client.exec(ctx, fmt.Sprintf("GRANT ROLE %s TO ROLE %s", role.ID().FullyQualifiedName(), NewAccountObjectIdentifier(currentRole).FullyQualifiedName()))
+	require.NoError(t, err)
+
+	owned, err := client.Schemas.ShowByID(ctx, schema.ID())
+	require.NoError(t, err)
+	assert.Equal(t, role.Name, owned.Owner)
+
+	err = client.Grants.GrantOwnership(ctx, on, OwnershipGrantTo{
+		AccountRoleName: Pointer(NewAccountObjectIdentifier(currentRole)),
+	}, nil)
+	require.NoError(t, err)
+}
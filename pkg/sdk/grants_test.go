@@ -191,6 +191,369 @@ func TestRevokePrivilegeFromShare(t *testing.T) {
 	})
 }
 
+func TestGrantPrivilegesToAccountRole(t *testing.T) {
+	role := NewAccountObjectIdentifier("role")
+
+	t.Run("on account", func(t *testing.T) {
+		opts := &GrantPrivilegesToAccountRoleOptions{
+			privileges: &AccountRoleGrantPrivileges{
+				GlobalPrivileges: []GlobalPrivilege{GlobalPrivilegeMonitorUsage, GlobalPrivilegeApplyTag},
+			},
+			on: &AccountRoleGrantOn{
+				Account: Bool(true),
+			},
+			accountRole:     role,
+			WithGrantOption: Bool(true),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `GRANT MONITOR USAGE, APPLY TAG ON ACCOUNT TO ROLE "role" WITH GRANT OPTION`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("all privileges on account object", func(t *testing.T) {
+		opts := &GrantPrivilegesToAccountRoleOptions{
+			privileges: &AccountRoleGrantPrivileges{
+				AllPrivileges: Bool(true),
+			},
+			on: &AccountRoleGrantOn{
+				AccountObject: &GrantOnAccountObject{
+					Warehouse: Pointer(NewAccountObjectIdentifier("wh")),
+				},
+			},
+			accountRole: role,
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `GRANT ALL PRIVILEGES ON WAREHOUSE "wh" TO ROLE "role"`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("on schema", func(t *testing.T) {
+		opts := &GrantPrivilegesToAccountRoleOptions{
+			privileges: &AccountRoleGrantPrivileges{
+				SchemaPrivileges: []SchemaPrivilege{SchemaPrivilegeCreateTable, SchemaPrivilegeUsage},
+			},
+			on: &AccountRoleGrantOn{
+				Schema: &GrantOnSchema{
+					Schema: Pointer(NewSchemaIdentifier("db", "schema")),
+				},
+			},
+			accountRole: role,
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `GRANT CREATE TABLE, USAGE ON SCHEMA "db"."schema" TO ROLE "role"`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("on future schemas in database", func(t *testing.T) {
+		opts := &GrantPrivilegesToAccountRoleOptions{
+			privileges: &AccountRoleGrantPrivileges{
+				SchemaPrivileges: []SchemaPrivilege{SchemaPrivilegeUsage},
+			},
+			on: &AccountRoleGrantOn{
+				Schema: &GrantOnSchema{
+					FutureSchemasInDatabase: Pointer(NewAccountObjectIdentifier("db")),
+				},
+			},
+			accountRole: role,
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `GRANT USAGE ON FUTURE SCHEMAS IN DATABASE "db" TO ROLE "role"`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("on schema object", func(t *testing.T) {
+		opts := &GrantPrivilegesToAccountRoleOptions{
+			privileges: &AccountRoleGrantPrivileges{
+				SchemaObjectPrivileges: []SchemaObjectPrivilege{SchemaObjectPrivilegeSelect, SchemaObjectPrivilegeInsert},
+			},
+			on: &AccountRoleGrantOn{
+				SchemaObject: &GrantOnSchemaObject{
+					SchemaObject: &Object{
+						ObjectType: ObjectTypeTable,
+						Name:       NewSchemaObjectIdentifier("db", "schema", "table"),
+					},
+				},
+			},
+			accountRole: role,
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `GRANT SELECT, INSERT ON TABLE "db"."schema"."table" TO ROLE "role"`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("on all schema objects in schema", func(t *testing.T) {
+		opts := &GrantPrivilegesToAccountRoleOptions{
+			privileges: &AccountRoleGrantPrivileges{
+				SchemaObjectPrivileges: []SchemaObjectPrivilege{SchemaObjectPrivilegeSelect},
+			},
+			on: &AccountRoleGrantOn{
+				SchemaObject: &GrantOnSchemaObject{
+					All: &GrantOnSchemaObjectIn{
+						PluralObjectType: PluralObjectTypeTables,
+						InSchema:         Pointer(NewSchemaIdentifier("db", "schema")),
+					},
+				},
+			},
+			accountRole: role,
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `GRANT SELECT ON ALL TABLES IN SCHEMA "db"."schema" TO ROLE "role"`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("on future schema objects in database", func(t *testing.T) {
+		opts := &GrantPrivilegesToAccountRoleOptions{
+			privileges: &AccountRoleGrantPrivileges{
+				SchemaObjectPrivileges: []SchemaObjectPrivilege{SchemaObjectPrivilegeSelect},
+			},
+			on: &AccountRoleGrantOn{
+				SchemaObject: &GrantOnSchemaObject{
+					Future: &GrantOnSchemaObjectIn{
+						PluralObjectType: PluralObjectTypeViews,
+						InDatabase:       Pointer(NewAccountObjectIdentifier("db")),
+					},
+				},
+			},
+			accountRole: role,
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `GRANT SELECT ON FUTURE VIEWS IN DATABASE "db" TO ROLE "role"`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: privileges do not match target", func(t *testing.T) {
+		opts := &GrantPrivilegesToAccountRoleOptions{
+			privileges: &AccountRoleGrantPrivileges{
+				SchemaPrivileges: []SchemaPrivilege{SchemaPrivilegeUsage},
+			},
+			on: &AccountRoleGrantOn{
+				Account: Bool(true),
+			},
+			accountRole: role,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: more than one target", func(t *testing.T) {
+		opts := &GrantPrivilegesToAccountRoleOptions{
+			privileges: &AccountRoleGrantPrivileges{
+				AllPrivileges: Bool(true),
+			},
+			on: &AccountRoleGrantOn{
+				Account: Bool(true),
+				AccountObject: &GrantOnAccountObject{
+					Database: Pointer(NewAccountObjectIdentifier("db")),
+				},
+			},
+			accountRole: role,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: all without in", func(t *testing.T) {
+		opts := &GrantPrivilegesToAccountRoleOptions{
+			privileges: &AccountRoleGrantPrivileges{
+				AllPrivileges: Bool(true),
+			},
+			on: &AccountRoleGrantOn{
+				SchemaObject: &GrantOnSchemaObject{
+					All: &GrantOnSchemaObjectIn{
+						PluralObjectType: PluralObjectTypeTables,
+					},
+				},
+			},
+			accountRole: role,
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestRevokePrivilegesFromAccountRole(t *testing.T) {
+	t.Run("grant option for with cascade", func(t *testing.T) {
+		opts := &RevokePrivilegesFromAccountRoleOptions{
+			GrantOptionFor: Bool(true),
+			privileges: &AccountRoleGrantPrivileges{
+				AccountObjectPrivileges: []AccountObjectPrivilege{AccountObjectPrivilegeUsage},
+			},
+			on: &AccountRoleGrantOn{
+				AccountObject: &GrantOnAccountObject{
+					Database: Pointer(NewAccountObjectIdentifier("db")),
+				},
+			},
+			accountRole: NewAccountObjectIdentifier("role"),
+			Cascade:     Bool(true),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `REVOKE GRANT OPTION FOR USAGE ON DATABASE "db" FROM ROLE "role" CASCADE`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: restrict and cascade", func(t *testing.T) {
+		opts := &RevokePrivilegesFromAccountRoleOptions{
+			privileges: &AccountRoleGrantPrivileges{
+				AllPrivileges: Bool(true),
+			},
+			on: &AccountRoleGrantOn{
+				Account: Bool(true),
+			},
+			accountRole: NewAccountObjectIdentifier("role"),
+			Restrict:    Bool(true),
+			Cascade:     Bool(true),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestGrantPrivilegesToDatabaseRole(t *testing.T) {
+	role := NewDatabaseObjectIdentifier("db", "role")
+
+	t.Run("on database", func(t *testing.T) {
+		opts := &GrantPrivilegesToDatabaseRoleOptions{
+			privileges: &DatabaseRoleGrantPrivileges{
+				DatabasePrivileges: []AccountObjectPrivilege{AccountObjectPrivilegeCreateSchema},
+			},
+			on: &DatabaseRoleGrantOn{
+				Database: Pointer(NewAccountObjectIdentifier("db")),
+			},
+			databaseRole:    role,
+			WithGrantOption: Bool(true),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `GRANT CREATE SCHEMA ON DATABASE "db" TO DATABASE ROLE "db"."role" WITH GRANT OPTION`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("on all schemas in database", func(t *testing.T) {
+		opts := &GrantPrivilegesToDatabaseRoleOptions{
+			privileges: &DatabaseRoleGrantPrivileges{
+				SchemaPrivileges: []SchemaPrivilege{SchemaPrivilegeUsage},
+			},
+			on: &DatabaseRoleGrantOn{
+				Schema: &GrantOnSchema{
+					AllSchemasInDatabase: Pointer(NewAccountObjectIdentifier("db")),
+				},
+			},
+			databaseRole: role,
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `GRANT USAGE ON ALL SCHEMAS IN DATABASE "db" TO DATABASE ROLE "db"."role"`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: invalid role", func(t *testing.T) {
+		opts := &GrantPrivilegesToDatabaseRoleOptions{
+			privileges: &DatabaseRoleGrantPrivileges{
+				AllPrivileges: Bool(true),
+			},
+			on: &DatabaseRoleGrantOn{
+				Database: Pointer(NewAccountObjectIdentifier("db")),
+			},
+		}
+		assert.ErrorIs(t, opts.validate(), ErrInvalidObjectIdentifier)
+	})
+}
+
+func TestRevokePrivilegesFromDatabaseRole(t *testing.T) {
+	opts := &RevokePrivilegesFromDatabaseRoleOptions{
+		privileges: &DatabaseRoleGrantPrivileges{
+			SchemaObjectPrivileges: []SchemaObjectPrivilege{SchemaObjectPrivilegeSelect},
+		},
+		on: &DatabaseRoleGrantOn{
+			SchemaObject: &GrantOnSchemaObject{
+				Future: &GrantOnSchemaObjectIn{
+					PluralObjectType: PluralObjectTypeTables,
+					InSchema:         Pointer(NewSchemaIdentifier("db", "schema")),
+				},
+			},
+		},
+		databaseRole: NewDatabaseObjectIdentifier("db", "role"),
+		Restrict:     Bool(true),
+	}
+	require.NoError(t, opts.validate())
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	expected := `REVOKE SELECT ON FUTURE TABLES IN SCHEMA "db"."schema" FROM DATABASE ROLE "db"."role" RESTRICT`
+	assert.Equal(t, expected, actual)
+}
+
+func TestGrantOwnership(t *testing.T) {
+	t.Run("on object to role with copy current grants", func(t *testing.T) {
+		opts := &GrantOwnershipOptions{
+			on: OwnershipGrantOn{
+				Object: &Object{
+					ObjectType: ObjectTypeDatabase,
+					Name:       NewAccountObjectIdentifier("db"),
+				},
+			},
+			to: OwnershipGrantTo{
+				AccountRoleName: Pointer(NewAccountObjectIdentifier("role")),
+			},
+			CurrentGrants: &OwnershipCurrentGrants{
+				OutboundPrivileges: Copy,
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `GRANT OWNERSHIP ON DATABASE "db" TO ROLE "role" COPY CURRENT GRANTS`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("on all in schema to database role", func(t *testing.T) {
+		opts := &GrantOwnershipOptions{
+			on: OwnershipGrantOn{
+				All: &GrantOnSchemaObjectIn{
+					PluralObjectType: PluralObjectTypeTables,
+					InSchema:         Pointer(NewSchemaIdentifier("db", "schema")),
+				},
+			},
+			to: OwnershipGrantTo{
+				DatabaseRoleName: Pointer(NewDatabaseObjectIdentifier("db", "role")),
+			},
+			CurrentGrants: &OwnershipCurrentGrants{
+				OutboundPrivileges: Revoke,
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `GRANT OWNERSHIP ON ALL TABLES IN SCHEMA "db"."schema" TO DATABASE ROLE "db"."role" REVOKE CURRENT GRANTS`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: no grantee", func(t *testing.T) {
+		opts := &GrantOwnershipOptions{
+			on: OwnershipGrantOn{
+				Object: &Object{
+					ObjectType: ObjectTypeDatabase,
+					Name:       NewAccountObjectIdentifier("db"),
+				},
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
 func TestGrantShow(t *testing.T) {
 	t.Run("no options", func(t *testing.T) {
 		opts := &ShowGrantOptions{}
@@ -292,4 +655,41 @@ func TestGrantShow(t *testing.T) {
 		expected := fmt.Sprintf("SHOW GRANTS OF SHARE %s", shareID.FullyQualifiedName())
 		assert.Equal(t, expected, actual)
 	})
+
+	t.Run("to database role", func(t *testing.T) {
+		id := NewDatabaseObjectIdentifier("db", "role")
+		opts := &ShowGrantOptions{
+			To: &ShowGrantsTo{
+				DatabaseRole: id,
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `SHOW GRANTS TO DATABASE ROLE "db"."role"`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("future in schema", func(t *testing.T) {
+		id := NewSchemaIdentifier("db", "schema")
+		opts := &ShowGrantOptions{
+			Future: Bool(true),
+			In: &ShowGrantsIn{
+				Schema: &id,
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `SHOW FUTURE GRANTS IN SCHEMA "db"."schema"`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: future on object", func(t *testing.T) {
+		opts := &ShowGrantOptions{
+			Future: Bool(true),
+			On: &ShowGrantsOn{
+				Account: Bool(true),
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
 }
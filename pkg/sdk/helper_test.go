@@ -278,6 +278,56 @@ func createWarehouseWithOptions(t *testing.T, client *Client, opts *CreateWareho
 		}
 }
 
+func createUser(t *testing.T, client *Client) (*User, func()) {
+	t.Helper()
+	return createUserWithOptions(t, client, &CreateUserOptions{})
+}
+
+func createUserWithOptions(t *testing.T, client *Client, opts *CreateUserOptions) (*User, func()) {
+	t.Helper()
+	id := randomAccountObjectIdentifier(t)
+	ctx := context.Background()
+	err := client.Users.Create(ctx, id, opts)
+	require.NoError(t, err)
+	user, err := client.Users.ShowByID(ctx, id)
+	require.NoError(t, err)
+	return user, func() {
+		err := client.Users.Drop(ctx, id, nil)
+		require.NoError(t, err)
+	}
+}
+
+func createRole(t *testing.T, client *Client) (*Role, func()) {
+	t.Helper()
+	return createRoleWithOptions(t, client, &RoleCreateOptions{})
+}
+
+func createRoleWithOptions(t *testing.T, client *Client, opts *RoleCreateOptions) (*Role, func()) {
+	t.Helper()
+	id := randomAccountObjectIdentifier(t)
+	ctx := context.Background()
+	err := client.Roles.Create(ctx, id, opts)
+	require.NoError(t, err)
+	role, err := client.Roles.ShowByID(ctx, id)
+	require.NoError(t, err)
+	return role, func() {
+		err := client.Roles.Drop(ctx, id, nil)
+		require.NoError(t, err)
+	}
+}
+
+func createDatabaseRole(t *testing.T, client *Client, database *Database) (DatabaseObjectIdentifier, func()) {
+	t.Helper()
+	id := NewDatabaseObjectIdentifier(database.Name, randomStringN(t, 12))
+	ctx := context.Background()
+	err := client.DatabaseRoles.Create(ctx, id, nil)
+	require.NoError(t, err)
+	return id, func() {
+		err := client.DatabaseRoles.Drop(ctx, id, &DropDatabaseRoleOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	}
+}
+
 func createDatabase(t *testing.T, client *Client) (*Database, func()) {
 	t.Helper()
 	return createDatabaseWithOptions(t, client, &CreateDatabaseOptions{})
@@ -299,17 +349,128 @@ func createDatabaseWithOptions(t *testing.T, client *Client, _ *CreateDatabaseOp
 
 func createSchema(t *testing.T, client *Client, database *Database) (*Schema, func()) {
 	t.Helper()
-	name := randomStringRange(t, 8, 28)
+	return createSchemaWithOptions(t, client, database, nil)
+}
+
+func createSchemaWithOptions(t *testing.T, client *Client, database *Database, opts *CreateSchemaOptions) (*Schema, func()) {
+	t.Helper()
 	ctx := context.Background()
-	_, err := client.exec(ctx, fmt.Sprintf("CREATE SCHEMA \"%s\".\"%s\"", database.Name, name))
+	id := NewSchemaIdentifier(database.Name, randomStringRange(t, 8, 28))
+	err := client.Schemas.Create(ctx, id, opts)
 	require.NoError(t, err)
-	return &Schema{
-			DatabaseName: database.Name,
-			Name:         name,
-		}, func() {
-			_, err := client.exec(ctx, fmt.Sprintf("DROP SCHEMA \"%s\".\"%s\"", database.Name, name))
-			require.NoError(t, err)
-		}
+	schema, err := client.Schemas.ShowByID(ctx, id)
+	require.NoError(t, err)
+	return schema, func() {
+		err := client.Schemas.Drop(ctx, id, nil)
+		require.NoError(t, err)
+	}
+}
+
+// createTable creates a table with an ID and a NAME column. The SDK does not support tables yet.
+func createTable(t *testing.T, client *Client, database *Database, schema *Schema) (SchemaObjectIdentifier, func()) {
+	t.Helper()
+	ctx := context.Background()
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringRange(t, 8, 28))
+	_, err := client.exec(ctx, fmt.Sprintf("CREATE TABLE %s (ID NUMBER, NAME VARCHAR)", id.FullyQualifiedName()))
+	require.NoError(t, err)
+	return id, func() {
+		_, err := client.exec(ctx, fmt.Sprintf("DROP TABLE %s", id.FullyQualifiedName()))
+		require.NoError(t, err)
+	}
+}
+
+func createDynamicTable(t *testing.T, client *Client, warehouse *Warehouse, table SchemaObjectIdentifier) (*DynamicTable, func()) {
+	t.Helper()
+	return createDynamicTableWithOptions(t, client, warehouse, table, nil)
+}
+
+func createDynamicTableWithOptions(t *testing.T, client *Client, warehouse *Warehouse, table SchemaObjectIdentifier, opts *CreateDynamicTableOptions) (*DynamicTable, func()) {
+	t.Helper()
+	ctx := context.Background()
+	id := NewSchemaObjectIdentifier(table.DatabaseName(), table.SchemaName(), randomStringRange(t, 8, 28))
+	targetLag := TargetLag{Lagtime: String("2 minutes")}
+	query := fmt.Sprintf("SELECT ID, NAME FROM %s", table.FullyQualifiedName())
+	err := client.DynamicTables.Create(ctx, id, NewAccountObjectIdentifier(warehouse.Name), targetLag, query, opts)
+	require.NoError(t, err)
+	dynamicTable, err := client.DynamicTables.ShowByID(ctx, id)
+	require.NoError(t, err)
+	return dynamicTable, func() {
+		err := client.DynamicTables.Drop(ctx, id, nil)
+		require.NoError(t, err)
+	}
+}
+
+func createEventTable(t *testing.T, client *Client, database *Database, schema *Schema) (*EventTable, func()) {
+	t.Helper()
+	return createEventTableWithOptions(t, client, database, schema, nil)
+}
+
+func createEventTableWithOptions(t *testing.T, client *Client, database *Database, schema *Schema, opts *CreateEventTableOptions) (*EventTable, func()) {
+	t.Helper()
+	ctx := context.Background()
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringRange(t, 8, 28))
+	err := client.EventTables.Create(ctx, id, opts)
+	require.NoError(t, err)
+	eventTable, err := client.EventTables.ShowByID(ctx, id)
+	require.NoError(t, err)
+	return eventTable, func() {
+		err := client.EventTables.Drop(ctx, id, &DropEventTableOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	}
+}
+
+func createApiAuthenticationIntegration(t *testing.T, client *Client) (AccountObjectIdentifier, func()) {
+	t.Helper()
+	ctx := context.Background()
+	id := randomAccountObjectIdentifier(t)
+	_, err := client.exec(ctx, fmt.Sprintf("CREATE SECURITY INTEGRATION %s TYPE = API_AUTHENTICATION AUTH_TYPE = OAUTH2 OAUTH_CLIENT_ID = 'client' OAUTH_CLIENT_SECRET = 'secret' OAUTH_TOKEN_ENDPOINT = 'https://example.com/oauth/token' OAUTH_ALLOWED_SCOPES = ('read', 'write') ENABLED = TRUE", id.FullyQualifiedName()))
+	require.NoError(t, err)
+	return id, func() {
+		_, err := client.exec(ctx, fmt.Sprintf("DROP SECURITY INTEGRATION %s", id.FullyQualifiedName()))
+		require.NoError(t, err)
+	}
+}
+
+func createSecret(t *testing.T, client *Client, database *Database, schema *Schema) (*Secret, func()) {
+	t.Helper()
+	return createSecretWithOptions(t, client, database, schema, SecretTypeGenericString, &CreateSecretOptions{
+		SecretString: String(randomString(t)),
+	})
+}
+
+func createSecretWithOptions(t *testing.T, client *Client, database *Database, schema *Schema, secretType SecretType, opts *CreateSecretOptions) (*Secret, func()) {
+	t.Helper()
+	ctx := context.Background()
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringRange(t, 8, 28))
+	err := client.Secrets.Create(ctx, id, secretType, opts)
+	require.NoError(t, err)
+	secret, err := client.Secrets.ShowByID(ctx, id)
+	require.NoError(t, err)
+	return secret, func() {
+		err := client.Secrets.Drop(ctx, id, &DropSecretOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	}
+}
+
+func createNetworkRule(t *testing.T, client *Client, database *Database, schema *Schema) (*NetworkRule, func()) {
+	t.Helper()
+	return createNetworkRuleWithOptions(t, client, database, schema, NetworkRuleTypeIPv4, NetworkRuleModeIngress, &CreateNetworkRuleOptions{
+		ValueList: []NetworkRuleValue{{Value: "192.168.0.0/24"}},
+	})
+}
+
+func createNetworkRuleWithOptions(t *testing.T, client *Client, database *Database, schema *Schema, ruleType NetworkRuleType, mode NetworkRuleMode, opts *CreateNetworkRuleOptions) (*NetworkRule, func()) {
+	t.Helper()
+	ctx := context.Background()
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringRange(t, 8, 28))
+	err := client.NetworkRules.Create(ctx, id, ruleType, mode, opts)
+	require.NoError(t, err)
+	networkRule, err := client.NetworkRules.ShowByID(ctx, id)
+	require.NoError(t, err)
+	return networkRule, func() {
+		err := client.NetworkRules.Drop(ctx, id, &DropNetworkRuleOptions{IfExists: Bool(true)})
+		require.NoError(t, err)
+	}
 }
 
 func createTag(t *testing.T, client *Client, database *Database, schema *Schema) (*Tag, func()) {
@@ -317,20 +478,18 @@ func createTag(t *testing.T, client *Client, database *Database, schema *Schema)
 	return createTagWithOptions(t, client, database, schema, &TagCreateOptions{})
 }
 
-func createTagWithOptions(t *testing.T, client *Client, database *Database, schema *Schema, _ *TagCreateOptions) (*Tag, func()) {
+func createTagWithOptions(t *testing.T, client *Client, database *Database, schema *Schema, opts *TagCreateOptions) (*Tag, func()) {
 	t.Helper()
-	name := randomStringRange(t, 8, 28)
+	id := NewSchemaObjectIdentifier(database.Name, schema.Name, randomStringRange(t, 8, 28))
 	ctx := context.Background()
-	_, err := client.exec(ctx, fmt.Sprintf("CREATE TAG \"%s\".\"%s\".\"%s\"", database.Name, schema.Name, name))
+	err := client.Tags.Create(ctx, id, opts)
 	require.NoError(t, err)
-	return &Tag{
-			Name:         name,
-			DatabaseName: database.Name,
-			SchemaName:   schema.Name,
-		}, func() {
-			_, err := client.exec(ctx, fmt.Sprintf("DROP TAG \"%s\".\"%s\".\"%s\"", database.Name, schema.Name, name))
-			require.NoError(t, err)
-		}
+	tag, err := client.Tags.ShowByID(ctx, id)
+	require.NoError(t, err)
+	return tag, func() {
+		err := client.Tags.Drop(ctx, id, nil)
+		require.NoError(t, err)
+	}
 }
 
 func createPasswordPolicyWithOptions(t *testing.T, client *Client, database *Database, schema *Schema, options *CreatePasswordPolicyOptions) (*PasswordPolicy, func()) {
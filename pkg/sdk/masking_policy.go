@@ -28,6 +28,8 @@ type MaskingPolicies interface {
 	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*MaskingPolicy, error)
 	// Describe returns the details of a masking policy.
 	Describe(ctx context.Context, id SchemaObjectIdentifier) (*MaskingPolicyDetails, error)
+	// ShowReferences returns the objects (e.g. tags and columns) the masking policy is attached to.
+	ShowReferences(ctx context.Context, id SchemaObjectIdentifier) ([]*PolicyReference, error)
 }
 
 // maskingPolicies implements MaskingPolicies.
@@ -374,3 +376,7 @@ func (v *maskingPolicies) Describe(ctx context.Context, id SchemaObjectIdentifie
 
 	return dest.toMaskingPolicyDetails(), nil
 }
+
+func (v *maskingPolicies) ShowReferences(ctx context.Context, id SchemaObjectIdentifier) ([]*PolicyReference, error) {
+	return policyReferences(ctx, v.client, id)
+}
@@ -0,0 +1,396 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ NetworkRules = (*networkRules)(nil)
+
+// NetworkRules describes all the network rule related methods that the
+// Snowflake API supports.
+type NetworkRules interface {
+	// Create creates a network rule.
+	Create(ctx context.Context, id SchemaObjectIdentifier, ruleType NetworkRuleType, mode NetworkRuleMode, opts *CreateNetworkRuleOptions) error
+	// Alter modifies an existing network rule.
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterNetworkRuleOptions) error
+	// Drop removes a network rule.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropNetworkRuleOptions) error
+	// Show returns a list of network rules.
+	Show(ctx context.Context, opts *ShowNetworkRuleOptions) ([]*NetworkRule, error)
+	// ShowByID returns a network rule by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*NetworkRule, error)
+	// Describe returns the details of a network rule, including its value list.
+	Describe(ctx context.Context, id SchemaObjectIdentifier) (*NetworkRuleDetails, error)
+}
+
+// networkRules implements NetworkRules.
+type networkRules struct {
+	client *Client
+}
+
+type NetworkRuleType string
+
+var (
+	NetworkRuleTypeIPv4             NetworkRuleType = "IPV4"
+	NetworkRuleTypeHostPort         NetworkRuleType = "HOST_PORT"
+	NetworkRuleTypeAwsVpcEndpointID NetworkRuleType = "AWSVPCEID"
+	NetworkRuleTypeAzureLinkID      NetworkRuleType = "AZURELINKID"
+)
+
+type NetworkRuleMode string
+
+var (
+	NetworkRuleModeIngress       NetworkRuleMode = "INGRESS"
+	NetworkRuleModeEgress        NetworkRuleMode = "EGRESS"
+	NetworkRuleModeInternalStage NetworkRuleMode = "INTERNAL_STAGE"
+)
+
+type NetworkRuleValue struct {
+	Value string `ddl:"keyword,single_quotes"`
+}
+
+// CreateNetworkRuleOptions contains options for creating a network rule.
+type CreateNetworkRuleOptions struct {
+	create      bool                   `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace   *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	networkRule bool                   `ddl:"static" sql:"NETWORK RULE"` //lint:ignore U1000 This is used in the ddl tag
+	name        SchemaObjectIdentifier `ddl:"identifier"`
+
+	// required
+	ruleType  NetworkRuleType    `ddl:"parameter" sql:"TYPE"`
+	ValueList []NetworkRuleValue `ddl:"parameter,parentheses" sql:"VALUE_LIST"`
+	mode      NetworkRuleMode    `ddl:"parameter" sql:"MODE"`
+
+	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateNetworkRuleOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if opts.ruleType == "" {
+		return errors.New("type must be set")
+	}
+	if opts.mode == "" {
+		return errors.New("mode must be set")
+	}
+	if !valueSet(opts.ValueList) {
+		return errors.New("ValueList must contain at least one value")
+	}
+	return nil
+}
+
+func (v *networkRules) Create(ctx context.Context, id SchemaObjectIdentifier, ruleType NetworkRuleType, mode NetworkRuleMode, opts *CreateNetworkRuleOptions) error {
+	if opts == nil {
+		opts = &CreateNetworkRuleOptions{}
+	}
+	opts.name = id
+	opts.ruleType = ruleType
+	opts.mode = mode
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// AlterNetworkRuleOptions contains options for altering a network rule.
+type AlterNetworkRuleOptions struct {
+	alter       bool                   `ddl:"static" sql:"ALTER"`        //lint:ignore U1000 This is used in the ddl tag
+	networkRule bool                   `ddl:"static" sql:"NETWORK RULE"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists    *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name        SchemaObjectIdentifier `ddl:"identifier"`
+	Set         *NetworkRuleSet        `ddl:"keyword" sql:"SET"`
+	Unset       *NetworkRuleUnset      `ddl:"list,no_parentheses" sql:"UNSET"`
+}
+
+func (opts *AlterNetworkRuleOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset) {
+		return errors.New("exactly one of Set or Unset must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type NetworkRuleSet struct {
+	ValueList []NetworkRuleValue `ddl:"parameter,parentheses" sql:"VALUE_LIST"`
+	Comment   *string            `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (v *NetworkRuleSet) validate() error {
+	if everyValueNil(v.ValueList, v.Comment) {
+		return errors.New("must set at least one parameter")
+	}
+	return nil
+}
+
+type NetworkRuleUnset struct {
+	ValueList *bool `ddl:"keyword" sql:"VALUE_LIST"`
+	Comment   *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *NetworkRuleUnset) validate() error {
+	if !anyValueSet(v.ValueList, v.Comment) {
+		return errors.New("must unset at least one parameter")
+	}
+	return nil
+}
+
+func (v *networkRules) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterNetworkRuleOptions) error {
+	if opts == nil {
+		opts = &AlterNetworkRuleOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// DropNetworkRuleOptions contains options for dropping a network rule.
+type DropNetworkRuleOptions struct {
+	drop        bool                   `ddl:"static" sql:"DROP"`         //lint:ignore U1000 This is used in the ddl tag
+	networkRule bool                   `ddl:"static" sql:"NETWORK RULE"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists    *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name        SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropNetworkRuleOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *networkRules) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropNetworkRuleOptions) error {
+	if opts == nil {
+		opts = &DropNetworkRuleOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return fmt.Errorf("validate drop options: %w", err)
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ShowNetworkRuleOptions contains options for listing network rules.
+type ShowNetworkRuleOptions struct {
+	show         bool  `ddl:"static" sql:"SHOW"`          //lint:ignore U1000 This is used in the ddl tag
+	networkRules bool  `ddl:"static" sql:"NETWORK RULES"` //lint:ignore U1000 This is used in the ddl tag
+	Like         *Like `ddl:"keyword" sql:"LIKE"`
+	In           *In   `ddl:"keyword" sql:"IN"`
+}
+
+func (opts *ShowNetworkRuleOptions) validate() error {
+	return nil
+}
+
+// NetworkRule is a user friendly result for a SHOW NETWORK RULES query.
+type NetworkRule struct {
+	CreatedOn          time.Time
+	Name               string
+	DatabaseName       string
+	SchemaName         string
+	Owner              string
+	Comment            string
+	Type               NetworkRuleType
+	Mode               NetworkRuleMode
+	EntriesInValueList int
+	OwnerRoleType      string
+}
+
+func (v *NetworkRule) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *NetworkRule) ObjectType() ObjectType {
+	return ObjectTypeNetworkRule
+}
+
+// networkRuleDBRow is used to decode the result of a SHOW NETWORK RULES query.
+type networkRuleDBRow struct {
+	CreatedOn          time.Time      `db:"created_on"`
+	Name               string         `db:"name"`
+	DatabaseName       string         `db:"database_name"`
+	SchemaName         string         `db:"schema_name"`
+	Owner              sql.NullString `db:"owner"`
+	Comment            sql.NullString `db:"comment"`
+	Type               string         `db:"type"`
+	Mode               string         `db:"mode"`
+	EntriesInValueList int            `db:"entries_in_valuelist"`
+	OwnerRoleType      sql.NullString `db:"owner_role_type"`
+}
+
+func (row networkRuleDBRow) toNetworkRule() *NetworkRule {
+	return &NetworkRule{
+		CreatedOn:          row.CreatedOn,
+		Name:               row.Name,
+		DatabaseName:       row.DatabaseName,
+		SchemaName:         row.SchemaName,
+		Owner:              row.Owner.String,
+		Comment:            row.Comment.String,
+		Type:               NetworkRuleType(row.Type),
+		Mode:               NetworkRuleMode(row.Mode),
+		EntriesInValueList: row.EntriesInValueList,
+		OwnerRoleType:      row.OwnerRoleType.String,
+	}
+}
+
+// Show lists all the network rules matching the options.
+func (v *networkRules) Show(ctx context.Context, opts *ShowNetworkRuleOptions) ([]*NetworkRule, error) {
+	if opts == nil {
+		opts = &ShowNetworkRuleOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []networkRuleDBRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*NetworkRule, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toNetworkRule()
+	}
+	return resultList, nil
+}
+
+func (v *networkRules) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*NetworkRule, error) {
+	networkRules, err := v.Show(ctx, &ShowNetworkRuleOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, networkRule := range networkRules {
+		if networkRule.Name == id.Name() {
+			return networkRule, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type describeNetworkRuleOptions struct {
+	describe    bool                   `ddl:"static" sql:"DESCRIBE"`     //lint:ignore U1000 This is used in the ddl tag
+	networkRule bool                   `ddl:"static" sql:"NETWORK RULE"` //lint:ignore U1000 This is used in the ddl tag
+	name        SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeNetworkRuleOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// NetworkRuleDetails is a user friendly result for a DESCRIBE NETWORK RULE query.
+type NetworkRuleDetails struct {
+	CreatedOn    time.Time
+	Name         string
+	DatabaseName string
+	SchemaName   string
+	Owner        string
+	Comment      string
+	Type         NetworkRuleType
+	Mode         NetworkRuleMode
+	ValueList    []string
+}
+
+type networkRuleDetailsRow struct {
+	CreatedOn    time.Time      `db:"created_on"`
+	Name         string         `db:"name"`
+	DatabaseName string         `db:"database_name"`
+	SchemaName   string         `db:"schema_name"`
+	Owner        sql.NullString `db:"owner"`
+	Comment      sql.NullString `db:"comment"`
+	Type         string         `db:"type"`
+	Mode         string         `db:"mode"`
+	ValueList    sql.NullString `db:"value_list"`
+}
+
+func (row networkRuleDetailsRow) toNetworkRuleDetails() *NetworkRuleDetails {
+	return &NetworkRuleDetails{
+		CreatedOn:    row.CreatedOn,
+		Name:         row.Name,
+		DatabaseName: row.DatabaseName,
+		SchemaName:   row.SchemaName,
+		Owner:        row.Owner.String,
+		Comment:      row.Comment.String,
+		Type:         NetworkRuleType(row.Type),
+		Mode:         NetworkRuleMode(row.Mode),
+		ValueList:    parseNetworkRuleValueList(row.ValueList.String),
+	}
+}
+
+// parseNetworkRuleValueList parses the value list the way Snowflake reports it, e.g. 192.168.0.1,10.0.0.0/8.
+func parseNetworkRuleValueList(s string) []string {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	values := []string{}
+	for _, value := range strings.Split(s, ",") {
+		values = append(values, strings.TrimSpace(value))
+	}
+	return values
+}
+
+func (v *networkRules) Describe(ctx context.Context, id SchemaObjectIdentifier) (*NetworkRuleDetails, error) {
+	opts := &describeNetworkRuleOptions{
+		name: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := networkRuleDetailsRow{}
+	err = v.client.queryOne(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	return dest.toNetworkRuleDetails(), nil
+}
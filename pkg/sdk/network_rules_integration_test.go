@@ -0,0 +1,130 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_NetworkRulesShow(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+
+	networkRuleTest, networkRuleCleanup := createNetworkRule(t, client, databaseTest, schemaTest)
+	t.Cleanup(networkRuleCleanup)
+	_, networkRule2Cleanup := createNetworkRule(t, client, databaseTest, schemaTest)
+	t.Cleanup(networkRule2Cleanup)
+
+	t.Run("in schema", func(t *testing.T) {
+		networkRules, err := client.NetworkRules.Show(ctx, &ShowNetworkRuleOptions{
+			In: &In{
+				Schema: schemaTest.ID(),
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, len(networkRules))
+	})
+
+	t.Run("with like", func(t *testing.T) {
+		networkRules, err := client.NetworkRules.Show(ctx, &ShowNetworkRuleOptions{
+			Like: &Like{
+				Pattern: String(networkRuleTest.Name),
+			},
+			In: &In{
+				Database: databaseTest.ID(),
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(networkRules))
+		assert.Equal(t, networkRuleTest.ID(), networkRules[0].ID())
+		assert.Equal(t, NetworkRuleTypeIPv4, networkRules[0].Type)
+		assert.Equal(t, NetworkRuleModeIngress, networkRules[0].Mode)
+		assert.Equal(t, 1, networkRules[0].EntriesInValueList)
+	})
+}
+
+func TestInt_NetworkRuleCreate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+
+	networkRule, networkRuleCleanup := createNetworkRuleWithOptions(t, client, databaseTest, schemaTest, NetworkRuleTypeHostPort, NetworkRuleModeEgress, &CreateNetworkRuleOptions{
+		ValueList: []NetworkRuleValue{{Value: "example.com"}, {Value: "example.org:443"}},
+		Comment:   String("test comment"),
+	})
+	t.Cleanup(networkRuleCleanup)
+
+	assert.Equal(t, NetworkRuleTypeHostPort, networkRule.Type)
+	assert.Equal(t, NetworkRuleModeEgress, networkRule.Mode)
+	assert.Equal(t, "test comment", networkRule.Comment)
+
+	details, err := client.NetworkRules.Describe(ctx, networkRule.ID())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"example.com", "example.org:443"}, details.ValueList)
+}
+
+func TestInt_NetworkRuleAlter(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+
+	networkRule, networkRuleCleanup := createNetworkRule(t, client, databaseTest, schemaTest)
+	t.Cleanup(networkRuleCleanup)
+	id := networkRule.ID()
+
+	t.Run("set value list and comment", func(t *testing.T) {
+		err := client.NetworkRules.Alter(ctx, id, &AlterNetworkRuleOptions{
+			Set: &NetworkRuleSet{
+				ValueList: []NetworkRuleValue{{Value: "10.0.0.0/8"}, {Value: "172.16.0.0/12"}},
+				Comment:   String("new comment"),
+			},
+		})
+		require.NoError(t, err)
+		details, err := client.NetworkRules.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"10.0.0.0/8", "172.16.0.0/12"}, details.ValueList)
+		assert.Equal(t, "new comment", details.Comment)
+	})
+
+	t.Run("unset value list and comment", func(t *testing.T) {
+		err := client.NetworkRules.Alter(ctx, id, &AlterNetworkRuleOptions{
+			Unset: &NetworkRuleUnset{
+				ValueList: Bool(true),
+				Comment:   Bool(true),
+			},
+		})
+		require.NoError(t, err)
+		details, err := client.NetworkRules.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, details.ValueList)
+		assert.Empty(t, details.Comment)
+	})
+}
+
+func TestInt_NetworkRuleDrop(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+
+	networkRule, _ := createNetworkRule(t, client, databaseTest, schemaTest)
+	id := networkRule.ID()
+	err := client.NetworkRules.Drop(ctx, id, nil)
+	require.NoError(t, err)
+	_, err = client.NetworkRules.ShowByID(ctx, id)
+	assert.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+}
@@ -0,0 +1,137 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkRuleCreate(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &CreateNetworkRuleOptions{
+			OrReplace: Bool(true),
+			name:      id,
+			ruleType:  NetworkRuleTypeHostPort,
+			ValueList: []NetworkRuleValue{{Value: "example.com"}, {Value: "example.org:443"}},
+			mode:      NetworkRuleModeEgress,
+			Comment:   String("test comment"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE OR REPLACE NETWORK RULE %s TYPE = HOST_PORT VALUE_LIST = ('example.com', 'example.org:443') MODE = EGRESS COMMENT = 'test comment'`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: empty value list", func(t *testing.T) {
+		opts := &CreateNetworkRuleOptions{
+			name:     id,
+			ruleType: NetworkRuleTypeIPv4,
+			mode:     NetworkRuleModeIngress,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: missing mode", func(t *testing.T) {
+		opts := &CreateNetworkRuleOptions{
+			name:      id,
+			ruleType:  NetworkRuleTypeIPv4,
+			ValueList: []NetworkRuleValue{{Value: "0.0.0.0/0"}},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestNetworkRuleAlter(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("set value list and comment", func(t *testing.T) {
+		opts := &AlterNetworkRuleOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Set: &NetworkRuleSet{
+				ValueList: []NetworkRuleValue{{Value: "10.0.0.0/8"}},
+				Comment:   String("new comment"),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER NETWORK RULE IF EXISTS %s SET VALUE_LIST = ('10.0.0.0/8') COMMENT = 'new comment'`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("unset value list and comment", func(t *testing.T) {
+		opts := &AlterNetworkRuleOptions{
+			name: id,
+			Unset: &NetworkRuleUnset{
+				ValueList: Bool(true),
+				Comment:   Bool(true),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER NETWORK RULE %s UNSET VALUE_LIST, COMMENT`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: set and unset", func(t *testing.T) {
+		opts := &AlterNetworkRuleOptions{
+			name:  id,
+			Set:   &NetworkRuleSet{Comment: String("comment")},
+			Unset: &NetworkRuleUnset{Comment: Bool(true)},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestNetworkRuleDrop(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	opts := &DropNetworkRuleOptions{
+		IfExists: Bool(true),
+		name:     id,
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("DROP NETWORK RULE IF EXISTS %s", id.FullyQualifiedName()), actual)
+}
+
+func TestNetworkRuleShow(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	opts := &ShowNetworkRuleOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	expected := fmt.Sprintf(`SHOW NETWORK RULES LIKE '%s' IN SCHEMA "%s"."%s"`, id.Name(), id.DatabaseName(), id.SchemaName())
+	assert.Equal(t, expected, actual)
+}
+
+func TestNetworkRuleDescribe(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	opts := &describeNetworkRuleOptions{
+		name: id,
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("DESCRIBE NETWORK RULE %s", id.FullyQualifiedName()), actual)
+}
+
+func TestParseNetworkRuleValueList(t *testing.T) {
+	assert.Nil(t, parseNetworkRuleValueList(""))
+	assert.Equal(t, []string{"10.0.0.0/8"}, parseNetworkRuleValueList("10.0.0.0/8"))
+	assert.Equal(t, []string{"example.com", "example.org:443"}, parseNetworkRuleValueList("example.com, example.org:443"))
+}
@@ -18,21 +18,40 @@ type ObjectType string
 const (
 	ObjectTypeAccount          ObjectType = "ACCOUNT"
 	ObjectTypeAccountParameter ObjectType = "ACCOUNT PARAMETER"
+	ObjectTypeAlert            ObjectType = "ALERT"
+	ObjectTypeColumn           ObjectType = "COLUMN"
 	ObjectTypeDatabase         ObjectType = "DATABASE"
+	ObjectTypeDatabaseRole     ObjectType = "DATABASE ROLE"
+	ObjectTypeDynamicTable     ObjectType = "DYNAMIC TABLE"
+	ObjectTypeEventTable       ObjectType = "EVENT TABLE"
+	ObjectTypeExternalTable    ObjectType = "EXTERNAL TABLE"
 	ObjectTypeFailoverGroup    ObjectType = "FAILOVER GROUP"
+	ObjectTypeFileFormat       ObjectType = "FILE FORMAT"
+	ObjectTypeFunction         ObjectType = "FUNCTION"
 	ObjectTypeIntegration      ObjectType = "INTEGRATION"
 	ObjectTypeMaskingPolicy    ObjectType = "MASKING POLICY"
+	ObjectTypeMaterializedView ObjectType = "MATERIALIZED VIEW"
 	ObjectTypeNetworkPolicy    ObjectType = "NETWORK POLICY"
+	ObjectTypeNetworkRule      ObjectType = "NETWORK RULE"
 	ObjectTypePasswordPolicy   ObjectType = "PASSWORD POLICY"
+	ObjectTypePipe             ObjectType = "PIPE"
+	ObjectTypeProcedure        ObjectType = "PROCEDURE"
+	ObjectTypeReplicationGroup ObjectType = "REPLICATION GROUP"
 	ObjectTypeResourceMonitor  ObjectType = "RESOURCE MONITOR"
 	ObjectTypeRole             ObjectType = "ROLE"
+	ObjectTypeRowAccessPolicy  ObjectType = "ROW ACCESS POLICY"
 	ObjectTypeSchema           ObjectType = "SCHEMA"
+	ObjectTypeSecret           ObjectType = "SECRET"
+	ObjectTypeSequence         ObjectType = "SEQUENCE"
 	ObjectTypeSessionPolicy    ObjectType = "SESSION POLICY"
 	ObjectTypeShare            ObjectType = "SHARE"
+	ObjectTypeStage            ObjectType = "STAGE"
+	ObjectTypeStream           ObjectType = "STREAM"
 	ObjectTypeTable            ObjectType = "TABLE"
 	ObjectTypeTag              ObjectType = "TAG"
 	ObjectTypeTask             ObjectType = "TASK"
 	ObjectTypeUser             ObjectType = "USER"
+	ObjectTypeView             ObjectType = "VIEW"
 	ObjectTypeWarehouse        ObjectType = "WAREHOUSE"
 )
 
@@ -43,21 +62,39 @@ func (o ObjectType) String() string {
 func objectTypeSingularToPluralMap() map[ObjectType]PluralObjectType {
 	return map[ObjectType]PluralObjectType{
 		ObjectTypeAccountParameter: PluralObjectTypeAccountParameters,
+		ObjectTypeAlert:            PluralObjectTypeAlerts,
 		ObjectTypeDatabase:         PluralObjectTypeDatabases,
+		ObjectTypeDatabaseRole:     PluralObjectTypeDatabaseRoles,
+		ObjectTypeDynamicTable:     PluralObjectTypeDynamicTables,
+		ObjectTypeEventTable:       PluralObjectTypeEventTables,
+		ObjectTypeExternalTable:    PluralObjectTypeExternalTables,
 		ObjectTypeFailoverGroup:    PluralObjectTypeTypeFailoverGroups,
+		ObjectTypeFileFormat:       PluralObjectTypeFileFormats,
+		ObjectTypeFunction:         PluralObjectTypeFunctions,
 		ObjectTypeIntegration:      PluralObjectTypeIntegrations,
 		ObjectTypeMaskingPolicy:    PluralObjectTypeMaskingPolicies,
+		ObjectTypeMaterializedView: PluralObjectTypeMaterializedViews,
 		ObjectTypeNetworkPolicy:    PluralObjectTypeNetworkPolicies,
+		ObjectTypeNetworkRule:      PluralObjectTypeNetworkRules,
 		ObjectTypePasswordPolicy:   PluralObjectTypePasswordPolicies,
+		ObjectTypePipe:             PluralObjectTypePipes,
+		ObjectTypeProcedure:        PluralObjectTypeProcedures,
+		ObjectTypeReplicationGroup: PluralObjectTypeReplicationGroups,
 		ObjectTypeResourceMonitor:  PluralObjectTypeResourceMonitors,
 		ObjectTypeRole:             PluralObjectTypeRoles,
+		ObjectTypeRowAccessPolicy:  PluralObjectTypeRowAccessPolicies,
 		ObjectTypeSchema:           PluralObjectTypeSchemas,
+		ObjectTypeSecret:           PluralObjectTypeSecrets,
+		ObjectTypeSequence:         PluralObjectTypeSequences,
 		ObjectTypeSessionPolicy:    PluralObjectTypeSessionPolicies,
 		ObjectTypeShare:            PluralObjectTypeShares,
+		ObjectTypeStage:            PluralObjectTypeStages,
+		ObjectTypeStream:           PluralObjectTypeStreams,
 		ObjectTypeTable:            PluralObjectTypeTables,
 		ObjectTypeTag:              PluralObjectTypeTags,
 		ObjectTypeTask:             PluralObjectTypeTasks,
 		ObjectTypeUser:             PluralObjectTypeUsers,
+		ObjectTypeView:             PluralObjectTypeViews,
 		ObjectTypeWarehouse:        PluralObjectTypeWarehouses,
 	}
 }
@@ -84,6 +121,7 @@ func (o ObjectType) GetObjectIdentifier(fullyQualifiedName string) ObjectIdentif
 		ObjectTypeDatabase,
 		ObjectTypeFailoverGroup,
 		ObjectTypeIntegration,
+		ObjectTypeReplicationGroup,
 		ObjectTypeResourceMonitor,
 		ObjectTypeRole,
 		ObjectTypeShare,
@@ -95,6 +133,9 @@ func (o ObjectType) GetObjectIdentifier(fullyQualifiedName string) ObjectIdentif
 	}
 	parts := strings.Split(fullyQualifiedName, ".")
 	dbName := parts[0]
+	if o == ObjectTypeDatabaseRole {
+		return NewDatabaseObjectIdentifier(dbName, strings.Join(parts[1:], "."))
+	}
 	if o == ObjectTypeSchema {
 		schemaName := strings.Join(parts[1:], ".")
 		return NewSchemaIdentifier(dbName, schemaName)
@@ -108,21 +149,39 @@ type PluralObjectType string
 
 const (
 	PluralObjectTypeAccountParameters  PluralObjectType = "ACCOUNT PARAMETERS"
+	PluralObjectTypeAlerts             PluralObjectType = "ALERTS"
+	PluralObjectTypeDatabaseRoles      PluralObjectType = "DATABASE ROLES"
 	PluralObjectTypeDatabases          PluralObjectType = "DATABASES"
-	PluralObjectTypeTypeFailoverGroups PluralObjectType = "FAILOVER GROUPS"
+	PluralObjectTypeDynamicTables      PluralObjectType = "DYNAMIC TABLES"
+	PluralObjectTypeEventTables        PluralObjectType = "EVENT TABLES"
+	PluralObjectTypeExternalTables     PluralObjectType = "EXTERNAL TABLES"
+	PluralObjectTypeFileFormats        PluralObjectType = "FILE FORMATS"
+	PluralObjectTypeFunctions          PluralObjectType = "FUNCTIONS"
 	PluralObjectTypeIntegrations       PluralObjectType = "INTEGRATIONS"
 	PluralObjectTypeMaskingPolicies    PluralObjectType = "MASKING POLICIES"
+	PluralObjectTypeMaterializedViews  PluralObjectType = "MATERIALIZED VIEWS"
 	PluralObjectTypeNetworkPolicies    PluralObjectType = "NETWORK POLICIES"
+	PluralObjectTypeNetworkRules       PluralObjectType = "NETWORK RULES"
 	PluralObjectTypePasswordPolicies   PluralObjectType = "PASSWORD POLICIES"
+	PluralObjectTypePipes              PluralObjectType = "PIPES"
+	PluralObjectTypeProcedures         PluralObjectType = "PROCEDURES"
+	PluralObjectTypeReplicationGroups  PluralObjectType = "REPLICATION GROUPS"
 	PluralObjectTypeResourceMonitors   PluralObjectType = "RESOURCE MONITORS"
 	PluralObjectTypeRoles              PluralObjectType = "ROLES"
+	PluralObjectTypeRowAccessPolicies  PluralObjectType = "ROW ACCESS POLICIES"
 	PluralObjectTypeSchemas            PluralObjectType = "SCHEMAS"
+	PluralObjectTypeSecrets            PluralObjectType = "SECRETS"
+	PluralObjectTypeSequences          PluralObjectType = "SEQUENCES"
 	PluralObjectTypeSessionPolicies    PluralObjectType = "SESSION POLICIES"
 	PluralObjectTypeShares             PluralObjectType = "SHARES"
+	PluralObjectTypeStages             PluralObjectType = "STAGES"
+	PluralObjectTypeStreams            PluralObjectType = "STREAMS"
 	PluralObjectTypeTables             PluralObjectType = "TABLES"
 	PluralObjectTypeTags               PluralObjectType = "TAGS"
 	PluralObjectTypeTasks              PluralObjectType = "TASKS"
+	PluralObjectTypeTypeFailoverGroups PluralObjectType = "FAILOVER GROUPS"
 	PluralObjectTypeUsers              PluralObjectType = "USERS"
+	PluralObjectTypeViews              PluralObjectType = "VIEWS"
 	PluralObjectTypeWarehouses         PluralObjectType = "WAREHOUSES"
 )
 
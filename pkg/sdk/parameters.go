@@ -1,6 +1,11 @@
 package sdk
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
 
 type AccountParameter string
 
@@ -120,6 +125,7 @@ const (
 	// Object Parameters
 	ObjectParameterDataRetentionTimeInDays             ObjectParameter = "DATA_RETENTION_TIME_IN_DAYS"
 	ObjectParameterDefaultDDLCollation                 ObjectParameter = "DEFAULT_DDL_COLLATION"
+	ObjectParameterEventTable                          ObjectParameter = "EVENT_TABLE" // also an account param
 	ObjectParameterLogLevel                            ObjectParameter = "LOG_LEVEL"
 	ObjectParameterMaxConcurrencyLevel                 ObjectParameter = "MAX_CONCURRENCY_LEVEL"
 	ObjectParameterMaxDataExtensionTimeInDays          ObjectParameter = "MAX_DATA_EXTENSION_TIME_IN_DAYS"
@@ -142,6 +148,7 @@ type UserParameter string
 const (
 	// User Parameters
 	UserParameterEnableUnredactedQuerySyntaxError UserParameter = "ENABLE_UNREDACTED_QUERY_SYNTAX_ERROR"
+	UserParameterNetworkPolicy                    UserParameter = "NETWORK_POLICY"
 
 	// Session Parameters (inherited)
 	UserParameterAbortDetachedQuery               UserParameter = "ABORT_DETACHED_QUERY"
@@ -203,6 +210,11 @@ func (v *AccountParameters) validate() error {
 			return fmt.Errorf("CLIENT_ENCRYPTION_KEY_SIZE must be either 128 or 256")
 		}
 	}
+	if valueSet(v.EventTable) {
+		if !validEventTable(*v.EventTable) {
+			return fmt.Errorf("EVENT_TABLE must be a fully qualified table name (database.schema.table), got %s", *v.EventTable)
+		}
+	}
 	if valueSet(v.InitialReplicationSizeLimitInTB) {
 		l := *v.InitialReplicationSizeLimitInTB
 		if l < 0.0 || (l < 0.0 && l < 1.0) {
@@ -402,6 +414,36 @@ const (
 	TraceLevelOff     TraceLevel = "OFF"
 )
 
+var AllLogLevels = []LogLevel{
+	LogLevelTrace,
+	LogLevelDebug,
+	LogLevelInfo,
+	LogLevelWarn,
+	LogLevelError,
+	LogLevelFatal,
+	LogLevelOff,
+}
+
+var AllTraceLevels = []TraceLevel{
+	TraceLevelAlways,
+	TraceLevelOnEvent,
+	TraceLevelOff,
+}
+
+// validEventTable reports whether value names a table as database.schema.table, which is the only form EVENT_TABLE accepts.
+func validEventTable(value string) bool {
+	parts := strings.Split(value, ".")
+	if len(parts) != 3 {
+		return false
+	}
+	for _, part := range parts {
+		if strings.Trim(part, `"`) == "" {
+			return false
+		}
+	}
+	return true
+}
+
 type ObjectParameters struct {
 	DataRetentionTimeInDays             *int           `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
 	DefaultDDLCollation                 *string        `ddl:"parameter,single_quotes" sql:"DEFAULT_DDL_COLLATION"`
@@ -454,6 +496,18 @@ func (v *ObjectParameters) validate() error {
 			return fmt.Errorf("USER_TASK_TIMEOUT_MS must be between 0 and 86400000")
 		}
 	}
+
+	if valueSet(v.LogLevel) {
+		if !slices.Contains(AllLogLevels, *v.LogLevel) {
+			return fmt.Errorf("LOG_LEVEL must be one of %v", AllLogLevels)
+		}
+	}
+
+	if valueSet(v.TraceLevel) {
+		if !slices.Contains(AllTraceLevels, *v.TraceLevel) {
+			return fmt.Errorf("TRACE_LEVEL must be one of %v", AllTraceLevels)
+		}
+	}
 	return nil
 }
 
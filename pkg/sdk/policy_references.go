@@ -0,0 +1,82 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+type PolicyEntityDomain string
+
+const (
+	PolicyEntityDomainAccount PolicyEntityDomain = "ACCOUNT"
+	PolicyEntityDomainUser    PolicyEntityDomain = "USER"
+	PolicyEntityDomainTable   PolicyEntityDomain = "TABLE"
+	PolicyEntityDomainView    PolicyEntityDomain = "VIEW"
+	PolicyEntityDomainTag     PolicyEntityDomain = "TAG"
+)
+
+// PolicyReference is a user friendly result for a POLICY_REFERENCES table function query.
+type PolicyReference struct {
+	PolicyDatabase    string
+	PolicySchema      string
+	PolicyName        string
+	PolicyKind        string
+	RefDatabaseName   string
+	RefSchemaName     string
+	RefEntityName     string
+	RefEntityDomain   PolicyEntityDomain
+	RefColumnName     string
+	RefArgColumnNames string
+	PolicyStatus      string
+}
+
+type policyReferenceDBRow struct {
+	PolicyDatabase    string         `db:"POLICY_DB"`
+	PolicySchema      string         `db:"POLICY_SCHEMA"`
+	PolicyName        string         `db:"POLICY_NAME"`
+	PolicyKind        string         `db:"POLICY_KIND"`
+	RefDatabaseName   sql.NullString `db:"REF_DATABASE_NAME"`
+	RefSchemaName     sql.NullString `db:"REF_SCHEMA_NAME"`
+	RefEntityName     string         `db:"REF_ENTITY_NAME"`
+	RefEntityDomain   string         `db:"REF_ENTITY_DOMAIN"`
+	RefColumnName     sql.NullString `db:"REF_COLUMN_NAME"`
+	RefArgColumnNames sql.NullString `db:"REF_ARG_COLUMN_NAMES"`
+	PolicyStatus      sql.NullString `db:"POLICY_STATUS"`
+}
+
+func (row policyReferenceDBRow) toPolicyReference() *PolicyReference {
+	return &PolicyReference{
+		PolicyDatabase:    row.PolicyDatabase,
+		PolicySchema:      row.PolicySchema,
+		PolicyName:        row.PolicyName,
+		PolicyKind:        row.PolicyKind,
+		RefDatabaseName:   row.RefDatabaseName.String,
+		RefSchemaName:     row.RefSchemaName.String,
+		RefEntityName:     row.RefEntityName,
+		RefEntityDomain:   PolicyEntityDomain(row.RefEntityDomain),
+		RefColumnName:     row.RefColumnName.String,
+		RefArgColumnNames: row.RefArgColumnNames.String,
+		PolicyStatus:      row.PolicyStatus.String,
+	}
+}
+
+// policyReferences lists every object the given policy is attached to, using the
+// INFORMATION_SCHEMA.POLICY_REFERENCES table function of the policy's database.
+func policyReferences(ctx context.Context, client *Client, id SchemaObjectIdentifier) ([]*PolicyReference, error) {
+	if !validObjectidentifier(id) {
+		return nil, ErrInvalidObjectIdentifier
+	}
+	database := NewAccountObjectIdentifier(id.DatabaseName())
+	sql := fmt.Sprintf(`SELECT POLICY_DB, POLICY_SCHEMA, POLICY_NAME, POLICY_KIND, REF_DATABASE_NAME, REF_SCHEMA_NAME, REF_ENTITY_NAME, REF_ENTITY_DOMAIN, REF_COLUMN_NAME, REF_ARG_COLUMN_NAMES, POLICY_STATUS FROM TABLE(%s.INFORMATION_SCHEMA.POLICY_REFERENCES(POLICY_NAME => '%s'))`, database.FullyQualifiedName(), id.FullyQualifiedName())
+	dest := []policyReferenceDBRow{}
+	err := client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*PolicyReference, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toPolicyReference()
+	}
+	return resultList, nil
+}
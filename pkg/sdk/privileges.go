@@ -11,3 +11,113 @@ const (
 func (p Privilege) String() string {
 	return string(p)
 }
+
+// GlobalPrivilege is a privilege granted ON ACCOUNT.
+type GlobalPrivilege string
+
+const (
+	GlobalPrivilegeCreateAccount             GlobalPrivilege = "CREATE ACCOUNT"
+	GlobalPrivilegeCreateDataExchange        GlobalPrivilege = "CREATE DATA EXCHANGE LISTING"
+	GlobalPrivilegeCreateDatabase            GlobalPrivilege = "CREATE DATABASE"
+	GlobalPrivilegeCreateFailoverGroup       GlobalPrivilege = "CREATE FAILOVER GROUP"
+	GlobalPrivilegeCreateIntegration         GlobalPrivilege = "CREATE INTEGRATION"
+	GlobalPrivilegeCreateNetworkPolicy       GlobalPrivilege = "CREATE NETWORK POLICY"
+	GlobalPrivilegeCreateReplicationGroup    GlobalPrivilege = "CREATE REPLICATION GROUP"
+	GlobalPrivilegeCreateRole                GlobalPrivilege = "CREATE ROLE"
+	GlobalPrivilegeCreateShare               GlobalPrivilege = "CREATE SHARE"
+	GlobalPrivilegeCreateUser                GlobalPrivilege = "CREATE USER"
+	GlobalPrivilegeCreateWarehouse           GlobalPrivilege = "CREATE WAREHOUSE"
+	GlobalPrivilegeApplyMaskingPolicy        GlobalPrivilege = "APPLY MASKING POLICY"
+	GlobalPrivilegeApplyPasswordPolicy       GlobalPrivilege = "APPLY PASSWORD POLICY"
+	GlobalPrivilegeApplyRowAccessPolicy      GlobalPrivilege = "APPLY ROW ACCESS POLICY"
+	GlobalPrivilegeApplySessionPolicy        GlobalPrivilege = "APPLY SESSION POLICY"
+	GlobalPrivilegeApplyTag                  GlobalPrivilege = "APPLY TAG"
+	GlobalPrivilegeAttachPolicy              GlobalPrivilege = "ATTACH POLICY"
+	GlobalPrivilegeExecuteAlert              GlobalPrivilege = "EXECUTE ALERT"
+	GlobalPrivilegeExecuteTask               GlobalPrivilege = "EXECUTE TASK"
+	GlobalPrivilegeExecuteManagedTask        GlobalPrivilege = "EXECUTE MANAGED TASK"
+	GlobalPrivilegeImportShare               GlobalPrivilege = "IMPORT SHARE"
+	GlobalPrivilegeManageGrants              GlobalPrivilege = "MANAGE GRANTS"
+	GlobalPrivilegeMonitorExecution          GlobalPrivilege = "MONITOR EXECUTION"
+	GlobalPrivilegeMonitorUsage              GlobalPrivilege = "MONITOR USAGE"
+	GlobalPrivilegeOverrideShareRestrictions GlobalPrivilege = "OVERRIDE SHARE RESTRICTIONS"
+)
+
+func (p GlobalPrivilege) String() string {
+	return string(p)
+}
+
+// AccountObjectPrivilege is a privilege granted on an account object such as a database or a warehouse.
+type AccountObjectPrivilege string
+
+const (
+	AccountObjectPrivilegeApplyBudget        AccountObjectPrivilege = "APPLYBUDGET"
+	AccountObjectPrivilegeCreateDatabaseRole AccountObjectPrivilege = "CREATE DATABASE ROLE"
+	AccountObjectPrivilegeCreateSchema       AccountObjectPrivilege = "CREATE SCHEMA"
+	AccountObjectPrivilegeFailover           AccountObjectPrivilege = "FAILOVER"
+	AccountObjectPrivilegeImportedPrivileges AccountObjectPrivilege = "IMPORTED PRIVILEGES"
+	AccountObjectPrivilegeModify             AccountObjectPrivilege = "MODIFY"
+	AccountObjectPrivilegeMonitor            AccountObjectPrivilege = "MONITOR"
+	AccountObjectPrivilegeOperate            AccountObjectPrivilege = "OPERATE"
+	AccountObjectPrivilegeReplicate          AccountObjectPrivilege = "REPLICATE"
+	AccountObjectPrivilegeUsage              AccountObjectPrivilege = "USAGE"
+)
+
+func (p AccountObjectPrivilege) String() string {
+	return string(p)
+}
+
+// SchemaPrivilege is a privilege granted on a schema.
+type SchemaPrivilege string
+
+const (
+	SchemaPrivilegeAddSearchOptimization  SchemaPrivilege = "ADD SEARCH OPTIMIZATION"
+	SchemaPrivilegeCreateAlert            SchemaPrivilege = "CREATE ALERT"
+	SchemaPrivilegeCreateDynamicTable     SchemaPrivilege = "CREATE DYNAMIC TABLE"
+	SchemaPrivilegeCreateExternalTable    SchemaPrivilege = "CREATE EXTERNAL TABLE"
+	SchemaPrivilegeCreateFileFormat       SchemaPrivilege = "CREATE FILE FORMAT"
+	SchemaPrivilegeCreateFunction         SchemaPrivilege = "CREATE FUNCTION"
+	SchemaPrivilegeCreateMaskingPolicy    SchemaPrivilege = "CREATE MASKING POLICY"
+	SchemaPrivilegeCreateMaterializedView SchemaPrivilege = "CREATE MATERIALIZED VIEW"
+	SchemaPrivilegeCreatePasswordPolicy   SchemaPrivilege = "CREATE PASSWORD POLICY"
+	SchemaPrivilegeCreatePipe             SchemaPrivilege = "CREATE PIPE"
+	SchemaPrivilegeCreateProcedure        SchemaPrivilege = "CREATE PROCEDURE"
+	SchemaPrivilegeCreateRowAccessPolicy  SchemaPrivilege = "CREATE ROW ACCESS POLICY"
+	SchemaPrivilegeCreateSequence         SchemaPrivilege = "CREATE SEQUENCE"
+	SchemaPrivilegeCreateSessionPolicy    SchemaPrivilege = "CREATE SESSION POLICY"
+	SchemaPrivilegeCreateStage            SchemaPrivilege = "CREATE STAGE"
+	SchemaPrivilegeCreateStream           SchemaPrivilege = "CREATE STREAM"
+	SchemaPrivilegeCreateTable            SchemaPrivilege = "CREATE TABLE"
+	SchemaPrivilegeCreateTag              SchemaPrivilege = "CREATE TAG"
+	SchemaPrivilegeCreateTask             SchemaPrivilege = "CREATE TASK"
+	SchemaPrivilegeCreateView             SchemaPrivilege = "CREATE VIEW"
+	SchemaPrivilegeModify                 SchemaPrivilege = "MODIFY"
+	SchemaPrivilegeMonitor                SchemaPrivilege = "MONITOR"
+	SchemaPrivilegeUsage                  SchemaPrivilege = "USAGE"
+)
+
+func (p SchemaPrivilege) String() string {
+	return string(p)
+}
+
+// SchemaObjectPrivilege is a privilege granted on an object that lives in a schema, e.g. a table or a view.
+type SchemaObjectPrivilege string
+
+const (
+	SchemaObjectPrivilegeApply      SchemaObjectPrivilege = "APPLY"
+	SchemaObjectPrivilegeDelete     SchemaObjectPrivilege = "DELETE"
+	SchemaObjectPrivilegeInsert     SchemaObjectPrivilege = "INSERT"
+	SchemaObjectPrivilegeMonitor    SchemaObjectPrivilege = "MONITOR"
+	SchemaObjectPrivilegeOperate    SchemaObjectPrivilege = "OPERATE"
+	SchemaObjectPrivilegeRead       SchemaObjectPrivilege = "READ"
+	SchemaObjectPrivilegeReferences SchemaObjectPrivilege = "REFERENCES"
+	SchemaObjectPrivilegeSelect     SchemaObjectPrivilege = "SELECT"
+	SchemaObjectPrivilegeTruncate   SchemaObjectPrivilege = "TRUNCATE"
+	SchemaObjectPrivilegeUpdate     SchemaObjectPrivilege = "UPDATE"
+	SchemaObjectPrivilegeUsage      SchemaObjectPrivilege = "USAGE"
+	SchemaObjectPrivilegeWrite      SchemaObjectPrivilege = "WRITE"
+)
+
+func (p SchemaObjectPrivilege) String() string {
+	return string(p)
+}
@@ -0,0 +1,79 @@
+package sdk
+
+import (
+	"context"
+	"log"
+	"math"
+	"math/rand"
+	"regexp"
+	"time"
+)
+
+// RetryPolicy describes how the client repeats statements failing on transient errors. Read-only statements such as
+// SHOW and DESCRIBE are repeated after every retryable error. Other statements are only repeated when the error
+// shows that Snowflake did not execute them, e.g. a lock wait or a throttled request, but not after a timeout.
+type RetryPolicy struct {
+	// MaxAttempts is the number of times a statement is run at most. Values below 2 disable retries.
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry. It doubles with every further retry, up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Jitter is the fraction of the backoff, between 0 and 1, that is randomized to spread out concurrent retries.
+	Jitter float64
+}
+
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: time.Second,
+		MaxBackoff:     30 * time.Second,
+		Jitter:         0.5,
+	}
+}
+
+var readOnlyStatementRegexp = regexp.MustCompile(`(?i)^\s*(SHOW|DESC|DESCRIBE|SELECT)\s`)
+
+// shouldRetry reports whether the statement can be repeated after failing with err.
+func (p *RetryPolicy) shouldRetry(sql string, err error) bool {
+	if readOnlyStatementRegexp.MatchString(sql) {
+		return IsRetryable(err)
+	}
+	return isTransient(err)
+}
+
+// backoff returns the wait before the given retry, starting with 1.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	d := float64(p.InitialBackoff) * math.Pow(2, float64(retry-1))
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		d -= d * math.Min(p.Jitter, 1) * rand.Float64() //nolint:gosec // the jitter does not need a secure source
+	}
+	return time.Duration(d)
+}
+
+// retry runs f until it succeeds, fails with an error that does not allow repeating sql, or the attempts run out.
+func (c *Client) retry(ctx context.Context, sql string, f func() error) error {
+	policy := c.retryPolicy
+	if policy == nil {
+		return f()
+	}
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = f()
+		if err == nil || attempt >= policy.MaxAttempts || !policy.shouldRetry(sql, err) {
+			if attempt > 1 {
+				log.Printf("[DEBUG] statement finished after retries=%d err = %v\n", attempt-1, err)
+			}
+			return err
+		}
+		wait := policy.backoff(attempt)
+		log.Printf("[DEBUG] retrying statement in %v, attempt %d of %d, err = %v\n", wait, attempt+1, policy.MaxAttempts, err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(wait):
+		}
+	}
+}
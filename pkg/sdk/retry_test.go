@@ -0,0 +1,118 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/snowflakedb/gosnowflake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockClient(t *testing.T) (*Client, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+	client := NewClientFromDB(db)
+	client.SetRetryPolicy(&RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond})
+	return client, mock
+}
+
+var (
+	errLocked  = &gosnowflake.SnowflakeError{Number: 625, SQLState: "57014", Message: "Statement '01ab' has locked table 'T' in transaction 1234 and this lock has not yet been released."}
+	errTimeout = &gosnowflake.SnowflakeError{Number: 630, SQLState: "57014", Message: "Statement reached its statement or warehouse timeout of 10 second(s) and was canceled."}
+)
+
+func TestRetryPolicy_shouldRetry(t *testing.T) {
+	policy := DefaultRetryPolicy()
+	throttled := &gosnowflake.SnowflakeError{Number: gosnowflake.ErrFailedToPostQuery, Message: "failed to POST. HTTP: %v, URL: %v", MessageArgs: []interface{}{429, "https://example.com"}}
+	notFound := &gosnowflake.SnowflakeError{Number: 2003, Message: "Database 'DB' does not exist or not authorized."}
+
+	assert.True(t, policy.shouldRetry("SHOW DATABASES", errLocked))
+	assert.True(t, policy.shouldRetry("show databases", errTimeout))
+	assert.True(t, policy.shouldRetry("DESCRIBE DATABASE DB", throttled))
+	assert.True(t, policy.shouldRetry("GRANT USAGE ON DATABASE DB TO ROLE R", errLocked))
+	assert.True(t, policy.shouldRetry("CREATE DATABASE DB", throttled))
+	assert.True(t, policy.shouldRetry("CREATE DATABASE DB", errors.New("Object 'DB' was modified concurrently.")))
+	// the statement may have been applied before timing out
+	assert.False(t, policy.shouldRetry("CREATE DATABASE DB CLONE SRC", errTimeout))
+	assert.False(t, policy.shouldRetry("SHOW DATABASES", notFound))
+	assert.False(t, policy.shouldRetry("DROP DATABASE DB", notFound))
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	policy := &RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 5 * time.Second}
+	assert.Equal(t, time.Second, policy.backoff(1))
+	assert.Equal(t, 2*time.Second, policy.backoff(2))
+	assert.Equal(t, 4*time.Second, policy.backoff(3))
+	assert.Equal(t, 5*time.Second, policy.backoff(4))
+
+	policy.Jitter = 0.5
+	for retry := 1; retry <= 4; retry++ {
+		d := policy.backoff(retry)
+		assert.LessOrEqual(t, d, 5*time.Second)
+		assert.GreaterOrEqual(t, d, 500*time.Millisecond)
+	}
+}
+
+func TestClient_retry(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("transient error", func(t *testing.T) {
+		client, mock := mockClient(t)
+		mock.ExpectExec(`^GRANT USAGE ON DATABASE "DB" TO ROLE "R"$`).WillReturnError(errLocked)
+		mock.ExpectExec(`^GRANT USAGE ON DATABASE "DB" TO ROLE "R"$`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		_, err := client.exec(ctx, `GRANT USAGE ON DATABASE "DB" TO ROLE "R"`)
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("attempts run out", func(t *testing.T) {
+		client, mock := mockClient(t)
+		for i := 0; i < 3; i++ {
+			mock.ExpectQuery(`^SHOW DATABASES$`).WillReturnError(errTimeout)
+		}
+
+		var dest []databaseRow
+		err := client.query(ctx, &dest, "SHOW DATABASES")
+		require.ErrorIs(t, err, ErrStatementTimeout)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ddl is not repeated after a timeout", func(t *testing.T) {
+		client, mock := mockClient(t)
+		mock.ExpectExec(`^CREATE DATABASE "DB" CLONE "SRC"$`).WillReturnError(errTimeout)
+
+		_, err := client.exec(ctx, `CREATE DATABASE "DB" CLONE "SRC"`)
+		require.ErrorIs(t, err, ErrStatementTimeout)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		client, mock := mockClient(t)
+		client.SetRetryPolicy(nil)
+		mock.ExpectExec(`^GRANT USAGE ON DATABASE "DB" TO ROLE "R"$`).WillReturnError(errLocked)
+
+		_, err := client.exec(ctx, `GRANT USAGE ON DATABASE "DB" TO ROLE "R"`)
+		require.ErrorIs(t, err, ErrObjectLocked)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("context done while waiting", func(t *testing.T) {
+		client, mock := mockClient(t)
+		client.SetRetryPolicy(&RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Hour})
+		mock.ExpectExec(`^GRANT USAGE ON DATABASE "DB" TO ROLE "R"$`).WillReturnError(errLocked)
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+
+		_, err := client.exec(ctx, `GRANT USAGE ON DATABASE "DB" TO ROLE "R"`)
+		require.ErrorIs(t, err, ErrObjectLocked)
+	})
+}
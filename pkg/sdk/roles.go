@@ -2,7 +2,10 @@ package sdk
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"time"
 )
 
 type Roles interface {
@@ -14,16 +17,18 @@ type Roles interface {
 	Drop(ctx context.Context, id AccountObjectIdentifier, opts *RoleDropOptions) error
 	// Show returns a list of roles.
 	Show(ctx context.Context, opts *RoleShowOptions) ([]*Role, error)
-	// ShowByID returns a user by ID
+	// ShowByID returns a role by ID
 	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Role, error)
-	// Grant grants privileges on a role.
-	// Grant(ctx context.Context, id AccountObjectIdentifier, opts *RoleGrantOptions) error
-	// Revoke revokes privileges on a role.
-	// Revoke(ctx context.Context, id AccountObjectIdentifier, opts *RoleRevokeOptions) error
+	// Grant grants a role to another role or to a user.
+	Grant(ctx context.Context, id AccountObjectIdentifier, opts *RoleGrantOptions) error
+	// Revoke revokes a role from another role or from a user.
+	Revoke(ctx context.Context, id AccountObjectIdentifier, opts *RoleRevokeOptions) error
+	// GrantStatement returns the statement granting a role for ExecBatch, compensated by revoking the role.
+	GrantStatement(id AccountObjectIdentifier, opts *RoleGrantOptions) (BatchStatement, error)
 	// Use sets the active role for the current session.
-	// Use(ctx context.Context, id AccountObjectIdentifier) error
+	Use(ctx context.Context, id AccountObjectIdentifier) error
 	// UseSecondary specifies the active/current secondary roles for the session.
-	// UseSecondary(ctx context.Context, opts *RolesUseSecondaryOptions) error
+	UseSecondary(ctx context.Context, option SecondaryRoleOption) error
 }
 
 var _ Roles = (*roles)(nil)
@@ -33,7 +38,16 @@ type roles struct {
 }
 
 type Role struct {
-	Name string `db:"name"`
+	CreatedOn       time.Time
+	Name            string
+	IsDefault       bool
+	IsCurrent       bool
+	IsInherited     bool
+	AssignedToUsers int
+	GrantedToRoles  int
+	GrantedRoles    int
+	Owner           string
+	Comment         string
 }
 
 func (v *Role) ID() AccountObjectIdentifier {
@@ -44,51 +58,365 @@ func (v *Role) ObjectType() ObjectType {
 	return ObjectTypeRole
 }
 
+type roleDBRow struct {
+	CreatedOn       time.Time      `db:"created_on"`
+	Name            string         `db:"name"`
+	IsDefault       sql.NullString `db:"is_default"`
+	IsCurrent       sql.NullString `db:"is_current"`
+	IsInherited     sql.NullString `db:"is_inherited"`
+	AssignedToUsers sql.NullInt64  `db:"assigned_to_users"`
+	GrantedToRoles  sql.NullInt64  `db:"granted_to_roles"`
+	GrantedRoles    sql.NullInt64  `db:"granted_roles"`
+	Owner           sql.NullString `db:"owner"`
+	Comment         sql.NullString `db:"comment"`
+}
+
+func (row *roleDBRow) toRole() *Role {
+	return &Role{
+		CreatedOn:       row.CreatedOn,
+		Name:            row.Name,
+		IsDefault:       row.IsDefault.String == "Y",
+		IsCurrent:       row.IsCurrent.String == "Y",
+		IsInherited:     row.IsInherited.String == "Y",
+		AssignedToUsers: int(row.AssignedToUsers.Int64),
+		GrantedToRoles:  int(row.GrantedToRoles.Int64),
+		GrantedRoles:    int(row.GrantedRoles.Int64),
+		Owner:           row.Owner.String,
+		Comment:         row.Comment.String,
+	}
+}
+
 // RoleCreateOptions contains options for creating a role.
-type RoleCreateOptions struct{}
+type RoleCreateOptions struct {
+	create      bool                    `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace   *bool                   `ddl:"keyword" sql:"OR REPLACE"`
+	role        bool                    `ddl:"static" sql:"ROLE"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name        AccountObjectIdentifier `ddl:"identifier"`
+	Comment     *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Tag         []TagAssociation        `ddl:"keyword,parentheses" sql:"TAG"`
+}
+
+func (opts *RoleCreateOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible.")
+	}
+	return nil
+}
 
 func (v *roles) Create(ctx context.Context, id AccountObjectIdentifier, opts *RoleCreateOptions) error {
-	sql := fmt.Sprintf(`CREATE ROLE %v`, id)
-	_, err := v.client.exec(ctx, sql)
+	if opts == nil {
+		opts = &RoleCreateOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
 	return err
 }
 
-// RoleAlterOptions contains options for altering a user.
-type RoleAlterOptions struct{}
+// RoleAlterOptions contains options for altering a role.
+type RoleAlterOptions struct {
+	alter        bool                    `ddl:"static" sql:"ALTER"` //lint:ignore U1000 This is used in the ddl tag
+	role         bool                    `ddl:"static" sql:"ROLE"`  //lint:ignore U1000 This is used in the ddl tag
+	IfExists     *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name         AccountObjectIdentifier `ddl:"identifier"`
+	NewName      AccountObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	SetComment   *string                 `ddl:"parameter,single_quotes" sql:"SET COMMENT"`
+	SetTag       []TagAssociation        `ddl:"keyword" sql:"SET TAG"`
+	UnsetComment *bool                   `ddl:"keyword" sql:"UNSET COMMENT"`
+	UnsetTag     []ObjectIdentifier      `ddl:"keyword" sql:"UNSET TAG"`
+}
 
-func (v *roles) Alter(ctx context.Context, id AccountObjectIdentifier, opts *RoleAlterOptions) error {
+func (opts *RoleAlterOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if ok := exactlyOneValueSet(opts.NewName, opts.SetComment, opts.SetTag, opts.UnsetComment, opts.UnsetTag); !ok {
+		return fmt.Errorf("exactly one of NewName, SetComment, SetTag, UnsetComment, UnsetTag must be set")
+	}
 	return nil
 }
 
+func (v *roles) Alter(ctx context.Context, id AccountObjectIdentifier, opts *RoleAlterOptions) error {
+	if opts == nil {
+		opts = &RoleAlterOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
 // RoleDropOptions contains options for dropping a role.
-type RoleDropOptions struct{}
+type RoleDropOptions struct {
+	drop     bool                    `ddl:"static" sql:"DROP"` //lint:ignore U1000 This is used in the ddl tag
+	role     bool                    `ddl:"static" sql:"ROLE"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name     AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *RoleDropOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
 
 func (v *roles) Drop(ctx context.Context, id AccountObjectIdentifier, opts *RoleDropOptions) error {
-	sql := fmt.Sprintf(`DROP ROLE %v`, id.FullyQualifiedName())
-	_, err := v.client.exec(ctx, sql)
+	if opts == nil {
+		opts = &RoleDropOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
 	return err
 }
 
 // RoleShowOptions contains options for listing roles.
-type RoleShowOptions struct{}
+type RoleShowOptions struct {
+	show  bool  `ddl:"static" sql:"SHOW"`  //lint:ignore U1000 This is used in the ddl tag
+	roles bool  `ddl:"static" sql:"ROLES"` //lint:ignore U1000 This is used in the ddl tag
+	Like  *Like `ddl:"keyword" sql:"LIKE"`
+}
+
+func (opts *RoleShowOptions) validate() error {
+	return nil
+}
 
 func (v *roles) Show(ctx context.Context, opts *RoleShowOptions) ([]*Role, error) {
-	var rows []Role
-	sql := `SHOW ROLES`
-	err := v.client.query(ctx, &rows, sql)
+	if opts == nil {
+		opts = &RoleShowOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []roleDBRow{}
+	err = v.client.query(ctx, &dest, sql)
 	if err != nil {
 		return nil, err
 	}
+	resultList := make([]*Role, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toRole()
+	}
+	return resultList, nil
+}
 
-	roles := make([]*Role, len(rows))
-	for i, row := range rows {
-		var role Role = row
-		roles[i] = &role
+func (v *roles) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Role, error) {
+	roles, err := v.Show(ctx, &RoleShowOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range roles {
+		if role.ID().name == id.Name() {
+			return role, nil
+		}
 	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
 
-	return roles, nil
+// RoleGrantOptions contains options for granting a role to another role or to a user.
+type RoleGrantOptions struct {
+	grant bool                    `ddl:"static" sql:"GRANT"` //lint:ignore U1000 This is used in the ddl tag
+	role  bool                    `ddl:"static" sql:"ROLE"`  //lint:ignore U1000 This is used in the ddl tag
+	name  AccountObjectIdentifier `ddl:"identifier"`
+	Grant GrantRole               `ddl:"keyword,no_parentheses" sql:"TO"`
 }
 
-func (v *roles) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*Role, error) {
-	return nil, nil
+type GrantRole struct {
+	// Role is the parent role the role is granted to.
+	Role AccountObjectIdentifier `ddl:"identifier" sql:"ROLE"`
+	// User is the user the role is granted to.
+	User AccountObjectIdentifier `ddl:"identifier" sql:"USER"`
+}
+
+func (v *GrantRole) validate() error {
+	if !exactlyOneValueSet(v.Role, v.User) {
+		return fmt.Errorf("exactly one of role or user must be set")
+	}
+	return nil
+}
+
+func (opts *RoleGrantOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return opts.Grant.validate()
+}
+
+func (v *roles) Grant(ctx context.Context, id AccountObjectIdentifier, opts *RoleGrantOptions) error {
+	if opts == nil {
+		opts = &RoleGrantOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+func (v *roles) GrantStatement(id AccountObjectIdentifier, opts *RoleGrantOptions) (BatchStatement, error) {
+	if opts == nil {
+		opts = &RoleGrantOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return BatchStatement{}, err
+	}
+	grantSQL, err := structToSQL(opts)
+	if err != nil {
+		return BatchStatement{}, err
+	}
+	revokeSQL, err := structToSQL(&RoleRevokeOptions{
+		name:   id,
+		Revoke: RevokeRole{Role: opts.Grant.Role, User: opts.Grant.User},
+	})
+	if err != nil {
+		return BatchStatement{}, err
+	}
+	return BatchStatement{SQL: grantSQL, Compensate: []string{revokeSQL}}, nil
+}
+
+// RoleRevokeOptions contains options for revoking a role from another role or from a user.
+type RoleRevokeOptions struct {
+	revoke bool                    `ddl:"static" sql:"REVOKE"` //lint:ignore U1000 This is used in the ddl tag
+	role   bool                    `ddl:"static" sql:"ROLE"`   //lint:ignore U1000 This is used in the ddl tag
+	name   AccountObjectIdentifier `ddl:"identifier"`
+	Revoke RevokeRole              `ddl:"keyword,no_parentheses" sql:"FROM"`
+}
+
+type RevokeRole struct {
+	// Role is the parent role the role is revoked from.
+	Role AccountObjectIdentifier `ddl:"identifier" sql:"ROLE"`
+	// User is the user the role is revoked from.
+	User AccountObjectIdentifier `ddl:"identifier" sql:"USER"`
+}
+
+func (v *RevokeRole) validate() error {
+	if !exactlyOneValueSet(v.Role, v.User) {
+		return fmt.Errorf("exactly one of role or user must be set")
+	}
+	return nil
+}
+
+func (opts *RoleRevokeOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return opts.Revoke.validate()
+}
+
+func (v *roles) Revoke(ctx context.Context, id AccountObjectIdentifier, opts *RoleRevokeOptions) error {
+	if opts == nil {
+		opts = &RoleRevokeOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type useRoleOptions struct {
+	use  bool                    `ddl:"static" sql:"USE"`  //lint:ignore U1000 This is used in the ddl tag
+	role bool                    `ddl:"static" sql:"ROLE"` //lint:ignore U1000 This is used in the ddl tag
+	name AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *useRoleOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *roles) Use(ctx context.Context, id AccountObjectIdentifier) error {
+	opts := &useRoleOptions{
+		name: id,
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type SecondaryRoleOption string
+
+const (
+	SecondaryRoleOptionAll  SecondaryRoleOption = "ALL"
+	SecondaryRoleOptionNone SecondaryRoleOption = "NONE"
+)
+
+type useSecondaryRolesOptions struct {
+	use            bool                `ddl:"static" sql:"USE"`             //lint:ignore U1000 This is used in the ddl tag
+	secondaryRoles bool                `ddl:"static" sql:"SECONDARY ROLES"` //lint:ignore U1000 This is used in the ddl tag
+	option         SecondaryRoleOption `ddl:"keyword"`
+}
+
+func (opts *useSecondaryRolesOptions) validate() error {
+	switch opts.option {
+	case SecondaryRoleOptionAll, SecondaryRoleOptionNone:
+		return nil
+	default:
+		return fmt.Errorf("secondary role option must be one of %s or %s", SecondaryRoleOptionAll, SecondaryRoleOptionNone)
+	}
+}
+
+func (v *roles) UseSecondary(ctx context.Context, option SecondaryRoleOption) error {
+	opts := &useSecondaryRolesOptions{
+		option: option,
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
 }
@@ -0,0 +1,169 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_RolesCreate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+	tag, tagCleanup := createTag(t, client, databaseTest, schemaTest)
+	t.Cleanup(tagCleanup)
+
+	t.Run("with complete options", func(t *testing.T) {
+		id := randomAccountObjectIdentifier(t)
+		err := client.Roles.Create(ctx, id, &RoleCreateOptions{
+			OrReplace: Bool(true),
+			Comment:   String("test comment"),
+			Tag: []TagAssociation{
+				{
+					Name:  tag.ID(),
+					Value: "v1",
+				},
+			},
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.Roles.Drop(ctx, id, nil)
+			require.NoError(t, err)
+		})
+
+		role, err := client.Roles.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), role.Name)
+		assert.Equal(t, "test comment", role.Comment)
+	})
+
+	t.Run("if not exists", func(t *testing.T) {
+		role, roleCleanup := createRole(t, client)
+		t.Cleanup(roleCleanup)
+		err := client.Roles.Create(ctx, role.ID(), &RoleCreateOptions{
+			IfNotExists: Bool(true),
+		})
+		require.NoError(t, err)
+	})
+}
+
+func TestInt_RolesAlter(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	t.Run("rename", func(t *testing.T) {
+		role, roleCleanup := createRole(t, client)
+		newID := randomAccountObjectIdentifier(t)
+		err := client.Roles.Alter(ctx, role.ID(), &RoleAlterOptions{
+			NewName: newID,
+		})
+		if err != nil {
+			t.Cleanup(roleCleanup)
+		} else {
+			t.Cleanup(func() {
+				err := client.Roles.Drop(ctx, newID, nil)
+				require.NoError(t, err)
+			})
+		}
+		require.NoError(t, err)
+		_, err = client.Roles.ShowByID(ctx, newID)
+		require.NoError(t, err)
+	})
+
+	t.Run("set and unset comment", func(t *testing.T) {
+		role, roleCleanup := createRole(t, client)
+		t.Cleanup(roleCleanup)
+		err := client.Roles.Alter(ctx, role.ID(), &RoleAlterOptions{
+			SetComment: String("new comment"),
+		})
+		require.NoError(t, err)
+		r, err := client.Roles.ShowByID(ctx, role.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "new comment", r.Comment)
+
+		err = client.Roles.Alter(ctx, role.ID(), &RoleAlterOptions{
+			UnsetComment: Bool(true),
+		})
+		require.NoError(t, err)
+		r, err = client.Roles.ShowByID(ctx, role.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "", r.Comment)
+	})
+}
+
+func TestInt_RolesDrop(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	role, _ := createRole(t, client)
+	err := client.Roles.Drop(ctx, role.ID(), nil)
+	require.NoError(t, err)
+	_, err = client.Roles.ShowByID(ctx, role.ID())
+	assert.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+}
+
+func TestInt_RolesGrantAndRevoke(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	role, roleCleanup := createRole(t, client)
+	t.Cleanup(roleCleanup)
+
+	t.Run("to role", func(t *testing.T) {
+		parentRole, parentRoleCleanup := createRole(t, client)
+		t.Cleanup(parentRoleCleanup)
+		err := client.Roles.Grant(ctx, role.ID(), &RoleGrantOptions{
+			Grant: GrantRole{
+				Role: parentRole.ID(),
+			},
+		})
+		require.NoError(t, err)
+		grants, err := client.Grants.Show(ctx, &ShowGrantOptions{
+			Of: &ShowGrantsOf{
+				Role: role.ID(),
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, len(grants))
+
+		err = client.Roles.Revoke(ctx, role.ID(), &RoleRevokeOptions{
+			Revoke: RevokeRole{
+				Role: parentRole.ID(),
+			},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("to user", func(t *testing.T) {
+		user, userCleanup := createUser(t, client)
+		t.Cleanup(userCleanup)
+		err := client.Roles.Grant(ctx, role.ID(), &RoleGrantOptions{
+			Grant: GrantRole{
+				User: user.ID(),
+			},
+		})
+		require.NoError(t, err)
+		err = client.Roles.Revoke(ctx, role.ID(), &RoleRevokeOptions{
+			Revoke: RevokeRole{
+				User: user.ID(),
+			},
+		})
+		require.NoError(t, err)
+	})
+}
+
+func TestInt_RolesUseSecondary(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	err := client.Roles.UseSecondary(ctx, SecondaryRoleOptionAll)
+	require.NoError(t, err)
+	err = client.Roles.UseSecondary(ctx, SecondaryRoleOptionNone)
+	require.NoError(t, err)
+}
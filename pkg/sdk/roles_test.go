@@ -0,0 +1,302 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleCreate(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("only name", func(t *testing.T) {
+		opts := &RoleCreateOptions{
+			name: id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("CREATE ROLE %s", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with complete options", func(t *testing.T) {
+		tagID := randomSchemaObjectIdentifier(t)
+		opts := &RoleCreateOptions{
+			OrReplace: Bool(true),
+			name:      id,
+			Comment:   String("some comment"),
+			Tag: []TagAssociation{
+				{
+					Name:  tagID,
+					Value: "v1",
+				},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE OR REPLACE ROLE %s COMMENT = 'some comment' TAG (%s = 'v1')`, id.FullyQualifiedName(), tagID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with if not exists", func(t *testing.T) {
+		opts := &RoleCreateOptions{
+			IfNotExists: Bool(true),
+			name:        id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE ROLE IF NOT EXISTS %s`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: or replace and if not exists", func(t *testing.T) {
+		opts := &RoleCreateOptions{
+			OrReplace:   Bool(true),
+			IfNotExists: Bool(true),
+			name:        id,
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestRoleAlter(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("validation: no action", func(t *testing.T) {
+		opts := &RoleAlterOptions{
+			name: id,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		newID := randomAccountObjectIdentifier(t)
+		opts := &RoleAlterOptions{
+			IfExists: Bool(true),
+			name:     id,
+			NewName:  newID,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER ROLE IF EXISTS %s RENAME TO %s", id.FullyQualifiedName(), newID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("set comment", func(t *testing.T) {
+		opts := &RoleAlterOptions{
+			name:       id,
+			SetComment: String("new comment"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER ROLE %s SET COMMENT = 'new comment'", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("unset comment", func(t *testing.T) {
+		opts := &RoleAlterOptions{
+			name:         id,
+			UnsetComment: Bool(true),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER ROLE %s UNSET COMMENT", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("set and unset tags", func(t *testing.T) {
+		tagID := randomSchemaObjectIdentifier(t)
+		opts := &RoleAlterOptions{
+			name: id,
+			SetTag: []TagAssociation{
+				{Name: tagID, Value: "v1"},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER ROLE %s SET TAG %s = 'v1'", id.FullyQualifiedName(), tagID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+
+		opts = &RoleAlterOptions{
+			name:     id,
+			UnsetTag: []ObjectIdentifier{tagID},
+		}
+		actual, err = structToSQL(opts)
+		require.NoError(t, err)
+		expected = fmt.Sprintf("ALTER ROLE %s UNSET TAG %s", id.FullyQualifiedName(), tagID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestRoleDrop(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+	opts := &RoleDropOptions{
+		IfExists: Bool(true),
+		name:     id,
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	expected := fmt.Sprintf("DROP ROLE IF EXISTS %s", id.FullyQualifiedName())
+	assert.Equal(t, expected, actual)
+}
+
+func TestRoleShow(t *testing.T) {
+	t.Run("empty options", func(t *testing.T) {
+		opts := &RoleShowOptions{}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, "SHOW ROLES", actual)
+	})
+
+	t.Run("with like", func(t *testing.T) {
+		opts := &RoleShowOptions{
+			Like: &Like{
+				Pattern: String("role%"),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, "SHOW ROLES LIKE 'role%'", actual)
+	})
+}
+
+func TestRoleGrant(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("to role", func(t *testing.T) {
+		parentID := randomAccountObjectIdentifier(t)
+		opts := &RoleGrantOptions{
+			name: id,
+			Grant: GrantRole{
+				Role: parentID,
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("GRANT ROLE %s TO ROLE %s", id.FullyQualifiedName(), parentID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("to user", func(t *testing.T) {
+		userID := randomAccountObjectIdentifier(t)
+		opts := &RoleGrantOptions{
+			name: id,
+			Grant: GrantRole{
+				User: userID,
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("GRANT ROLE %s TO USER %s", id.FullyQualifiedName(), userID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: both role and user", func(t *testing.T) {
+		opts := &RoleGrantOptions{
+			name: id,
+			Grant: GrantRole{
+				Role: randomAccountObjectIdentifier(t),
+				User: randomAccountObjectIdentifier(t),
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestRoleGrantStatement(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+	userID := randomAccountObjectIdentifier(t)
+	client := &Client{}
+	client.initialize()
+
+	statement, err := client.Roles.GrantStatement(id, &RoleGrantOptions{Grant: GrantRole{User: userID}})
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("GRANT ROLE %s TO USER %s", id.FullyQualifiedName(), userID.FullyQualifiedName()), statement.SQL)
+	assert.Equal(t, []string{fmt.Sprintf("REVOKE ROLE %s FROM USER %s", id.FullyQualifiedName(), userID.FullyQualifiedName())}, statement.Compensate)
+
+	_, err = client.Roles.GrantStatement(id, nil)
+	assert.Error(t, err)
+}
+
+func TestRoleRevoke(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("from role", func(t *testing.T) {
+		parentID := randomAccountObjectIdentifier(t)
+		opts := &RoleRevokeOptions{
+			name: id,
+			Revoke: RevokeRole{
+				Role: parentID,
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("REVOKE ROLE %s FROM ROLE %s", id.FullyQualifiedName(), parentID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("from user", func(t *testing.T) {
+		userID := randomAccountObjectIdentifier(t)
+		opts := &RoleRevokeOptions{
+			name: id,
+			Revoke: RevokeRole{
+				User: userID,
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("REVOKE ROLE %s FROM USER %s", id.FullyQualifiedName(), userID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: neither role nor user", func(t *testing.T) {
+		opts := &RoleRevokeOptions{
+			name: id,
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestRoleUse(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+	opts := &useRoleOptions{
+		name: id,
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	expected := fmt.Sprintf("USE ROLE %s", id.FullyQualifiedName())
+	assert.Equal(t, expected, actual)
+}
+
+func TestRoleUseSecondary(t *testing.T) {
+	t.Run("all", func(t *testing.T) {
+		opts := &useSecondaryRolesOptions{
+			option: SecondaryRoleOptionAll,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, "USE SECONDARY ROLES ALL", actual)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		opts := &useSecondaryRolesOptions{
+			option: SecondaryRoleOptionNone,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, "USE SECONDARY ROLES NONE", actual)
+	})
+
+	t.Run("validation: invalid option", func(t *testing.T) {
+		opts := &useSecondaryRolesOptions{
+			option: SecondaryRoleOption("SOME"),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
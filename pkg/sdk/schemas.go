@@ -1,9 +1,55 @@
 package sdk
 
-// placeholder for the real implementation.
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Schemas = (*schemas)(nil)
+
+// Schemas describes all the schema related methods that the Snowflake API supports.
+type Schemas interface {
+	// Create creates a schema.
+	Create(ctx context.Context, id SchemaIdentifier, opts *CreateSchemaOptions) error
+	// Alter modifies an existing schema.
+	Alter(ctx context.Context, id SchemaIdentifier, opts *AlterSchemaOptions) error
+	// Drop removes a schema.
+	Drop(ctx context.Context, id SchemaIdentifier, opts *DropSchemaOptions) error
+	// Undrop restores the most recent version of a dropped schema.
+	Undrop(ctx context.Context, id SchemaIdentifier) error
+	// Describe lists the objects in a schema.
+	Describe(ctx context.Context, id SchemaIdentifier) ([]SchemaDetails, error)
+	// Show returns a list of schemas.
+	Show(ctx context.Context, opts *ShowSchemaOptions) ([]*Schema, error)
+	// ShowByID returns a schema by ID.
+	ShowByID(ctx context.Context, id SchemaIdentifier) (*Schema, error)
+	// Use sets the active schema for the current session.
+	Use(ctx context.Context, id SchemaIdentifier) error
+}
+
+// schemas implements Schemas.
+type schemas struct {
+	client *Client
+}
+
+// Schema is a user friendly result for a SHOW SCHEMAS query.
 type Schema struct {
-	DatabaseName string
-	Name         string
+	CreatedOn     time.Time
+	Name          string
+	IsDefault     bool
+	IsCurrent     bool
+	DatabaseName  string
+	Owner         string
+	Comment       string
+	Options       string
+	RetentionTime int
+	OwnerRoleType string
+	DroppedOn     time.Time
 }
 
 func (v *Schema) ID() SchemaIdentifier {
@@ -13,3 +59,382 @@ func (v *Schema) ID() SchemaIdentifier {
 func (v *Schema) ObjectType() ObjectType {
 	return ObjectTypeSchema
 }
+
+// IsTransient reports whether the schema was created as a transient schema.
+func (v *Schema) IsTransient() bool {
+	return v.hasOption("TRANSIENT")
+}
+
+// IsManagedAccess reports whether the schema is a managed access schema.
+func (v *Schema) IsManagedAccess() bool {
+	return v.hasOption("MANAGED ACCESS")
+}
+
+func (v *Schema) hasOption(option string) bool {
+	if v.Options == "" {
+		return false
+	}
+	for _, part := range strings.Split(v.Options, ", ") {
+		if part == option {
+			return true
+		}
+	}
+	return false
+}
+
+// schemaDBRow is used to decode the result of a SHOW SCHEMAS query.
+type schemaDBRow struct {
+	CreatedOn     time.Time      `db:"created_on"`
+	Name          string         `db:"name"`
+	IsDefault     sql.NullString `db:"is_default"`
+	IsCurrent     sql.NullString `db:"is_current"`
+	DatabaseName  string         `db:"database_name"`
+	Owner         sql.NullString `db:"owner"`
+	Comment       sql.NullString `db:"comment"`
+	Options       sql.NullString `db:"options"`
+	RetentionTime sql.NullString `db:"retention_time"`
+	OwnerRoleType sql.NullString `db:"owner_role_type"`
+	DroppedOn     sql.NullTime   `db:"dropped_on"`
+}
+
+func (row schemaDBRow) toSchema() *Schema {
+	schema := &Schema{
+		CreatedOn:     row.CreatedOn,
+		Name:          row.Name,
+		IsDefault:     row.IsDefault.Valid && row.IsDefault.String == "Y",
+		IsCurrent:     row.IsCurrent.Valid && row.IsCurrent.String == "Y",
+		DatabaseName:  row.DatabaseName,
+		Owner:         row.Owner.String,
+		Comment:       row.Comment.String,
+		Options:       row.Options.String,
+		OwnerRoleType: row.OwnerRoleType.String,
+	}
+	// retention_time may be an empty string instead of an integer
+	if row.RetentionTime.Valid {
+		if retentionTime, err := strconv.Atoi(row.RetentionTime.String); err == nil {
+			schema.RetentionTime = retentionTime
+		}
+	}
+	if row.DroppedOn.Valid {
+		schema.DroppedOn = row.DroppedOn.Time
+	}
+	return schema
+}
+
+// CreateSchemaOptions contains options for creating a schema.
+type CreateSchemaOptions struct {
+	create                     bool             `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace                  *bool            `ddl:"keyword" sql:"OR REPLACE"`
+	Transient                  *bool            `ddl:"keyword" sql:"TRANSIENT"`
+	schema                     bool             `ddl:"static" sql:"SCHEMA"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists                *bool            `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                       SchemaIdentifier `ddl:"identifier"`
+	Clone                      *Clone           `ddl:"-"`
+	WithManagedAccess          *bool            `ddl:"keyword" sql:"WITH MANAGED ACCESS"`
+	DataRetentionTimeInDays    *int             `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *int             `ddl:"parameter" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	DefaultDDLCollation        *string          `ddl:"parameter,single_quotes" sql:"DEFAULT_DDL_COLLATION"`
+	Tag                        []TagAssociation `ddl:"keyword,parentheses" sql:"TAG"`
+	Comment                    *string          `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateSchemaOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible")
+	}
+	if valueSet(opts.Clone) {
+		if err := opts.Clone.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.DataRetentionTimeInDays) && !validateIntInRange(*opts.DataRetentionTimeInDays, 0, 90) {
+		return errors.New("DataRetentionTimeInDays must be between 0 and 90")
+	}
+	return nil
+}
+
+func (v *schemas) Create(ctx context.Context, id SchemaIdentifier, opts *CreateSchemaOptions) error {
+	if opts == nil {
+		opts = &CreateSchemaOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// AlterSchemaOptions contains options for altering a schema.
+type AlterSchemaOptions struct {
+	alter                bool               `ddl:"static" sql:"ALTER"`  //lint:ignore U1000 This is used in the ddl tag
+	schema               bool               `ddl:"static" sql:"SCHEMA"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists             *bool              `ddl:"keyword" sql:"IF EXISTS"`
+	name                 SchemaIdentifier   `ddl:"identifier"`
+	NewName              SchemaIdentifier   `ddl:"identifier" sql:"RENAME TO"`
+	SwapWith             SchemaIdentifier   `ddl:"identifier" sql:"SWAP WITH"`
+	Set                  *SchemaSet         `ddl:"list,no_parentheses" sql:"SET"`
+	Unset                *SchemaUnset       `ddl:"list,no_parentheses" sql:"UNSET"`
+	SetTag               []TagAssociation   `ddl:"keyword" sql:"SET TAG"`
+	UnsetTag             []ObjectIdentifier `ddl:"keyword" sql:"UNSET TAG"`
+	EnableManagedAccess  *bool              `ddl:"keyword" sql:"ENABLE MANAGED ACCESS"`
+	DisableManagedAccess *bool              `ddl:"keyword" sql:"DISABLE MANAGED ACCESS"`
+}
+
+func (opts *AlterSchemaOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.NewName, opts.SwapWith, opts.Set, opts.Unset, opts.SetTag, opts.UnsetTag, opts.EnableManagedAccess, opts.DisableManagedAccess) {
+		return errors.New("exactly one of NewName, SwapWith, Set, Unset, SetTag, UnsetTag, EnableManagedAccess or DisableManagedAccess must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type SchemaSet struct {
+	DataRetentionTimeInDays    *int    `ddl:"parameter" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *int    `ddl:"parameter" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	DefaultDDLCollation        *string `ddl:"parameter,single_quotes" sql:"DEFAULT_DDL_COLLATION"`
+	Comment                    *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (v *SchemaSet) validate() error {
+	if !anyValueSet(v.DataRetentionTimeInDays, v.MaxDataExtensionTimeInDays, v.DefaultDDLCollation, v.Comment) {
+		return errors.New("at least one property must be set")
+	}
+	if valueSet(v.DataRetentionTimeInDays) && !validateIntInRange(*v.DataRetentionTimeInDays, 0, 90) {
+		return errors.New("DataRetentionTimeInDays must be between 0 and 90")
+	}
+	return nil
+}
+
+type SchemaUnset struct {
+	DataRetentionTimeInDays    *bool `ddl:"keyword" sql:"DATA_RETENTION_TIME_IN_DAYS"`
+	MaxDataExtensionTimeInDays *bool `ddl:"keyword" sql:"MAX_DATA_EXTENSION_TIME_IN_DAYS"`
+	DefaultDDLCollation        *bool `ddl:"keyword" sql:"DEFAULT_DDL_COLLATION"`
+	Comment                    *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *SchemaUnset) validate() error {
+	if !anyValueSet(v.DataRetentionTimeInDays, v.MaxDataExtensionTimeInDays, v.DefaultDDLCollation, v.Comment) {
+		return errors.New("at least one property must be unset")
+	}
+	return nil
+}
+
+func (v *schemas) Alter(ctx context.Context, id SchemaIdentifier, opts *AlterSchemaOptions) error {
+	if opts == nil {
+		opts = &AlterSchemaOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// DropSchemaOptions contains options for dropping a schema.
+type DropSchemaOptions struct {
+	drop     bool             `ddl:"static" sql:"DROP"`   //lint:ignore U1000 This is used in the ddl tag
+	schema   bool             `ddl:"static" sql:"SCHEMA"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool            `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaIdentifier `ddl:"identifier"`
+	Cascade  *bool            `ddl:"keyword" sql:"CASCADE"`
+	Restrict *bool            `ddl:"keyword" sql:"RESTRICT"`
+}
+
+func (opts *DropSchemaOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.Cascade, opts.Restrict) {
+		return errors.New("only one of CASCADE or RESTRICT can be set")
+	}
+	return nil
+}
+
+func (v *schemas) Drop(ctx context.Context, id SchemaIdentifier, opts *DropSchemaOptions) error {
+	if opts == nil {
+		opts = &DropSchemaOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type undropSchemaOptions struct {
+	undrop bool             `ddl:"static" sql:"UNDROP"` //lint:ignore U1000 This is used in the ddl tag
+	schema bool             `ddl:"static" sql:"SCHEMA"` //lint:ignore U1000 This is used in the ddl tag
+	name   SchemaIdentifier `ddl:"identifier"`
+}
+
+func (opts *undropSchemaOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *schemas) Undrop(ctx context.Context, id SchemaIdentifier) error {
+	opts := &undropSchemaOptions{
+		name: id,
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// SchemaDetails is a single row of a DESCRIBE SCHEMA query, i.e. an object in the schema.
+type SchemaDetails struct {
+	CreatedOn time.Time `db:"created_on"`
+	Name      string    `db:"name"`
+	Kind      string    `db:"kind"`
+}
+
+type describeSchemaOptions struct {
+	describe bool             `ddl:"static" sql:"DESCRIBE"` //lint:ignore U1000 This is used in the ddl tag
+	schema   bool             `ddl:"static" sql:"SCHEMA"`   //lint:ignore U1000 This is used in the ddl tag
+	name     SchemaIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeSchemaOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *schemas) Describe(ctx context.Context, id SchemaIdentifier) ([]SchemaDetails, error) {
+	opts := &describeSchemaOptions{
+		name: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []SchemaDetails{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	return dest, nil
+}
+
+// SchemaIn narrows down SHOW SCHEMAS to the whole account or a single database.
+// Setting Database without Name targets the current database.
+type SchemaIn struct {
+	Account  *bool                   `ddl:"keyword" sql:"ACCOUNT"`
+	Database *bool                   `ddl:"keyword" sql:"DATABASE"`
+	Name     AccountObjectIdentifier `ddl:"identifier"`
+}
+
+// ShowSchemaOptions contains options for listing schemas.
+type ShowSchemaOptions struct {
+	show       bool       `ddl:"static" sql:"SHOW"` //lint:ignore U1000 This is used in the ddl tag
+	Terse      *bool      `ddl:"keyword" sql:"TERSE"`
+	schemas    bool       `ddl:"static" sql:"SCHEMAS"` //lint:ignore U1000 This is used in the ddl tag
+	History    *bool      `ddl:"keyword" sql:"HISTORY"`
+	Like       *Like      `ddl:"keyword" sql:"LIKE"`
+	In         *SchemaIn  `ddl:"keyword" sql:"IN"`
+	StartsWith *string    `ddl:"parameter,single_quotes,no_equals" sql:"STARTS WITH"`
+	LimitFrom  *LimitFrom `ddl:"keyword" sql:"LIMIT"`
+}
+
+func (opts *ShowSchemaOptions) validate() error {
+	if valueSet(opts.In) {
+		if !exactlyOneValueSet(opts.In.Account, opts.In.Database) {
+			return errors.New("exactly one of Account or Database must be set in IN")
+		}
+		if valueSet(opts.In.Account) && valueSet(opts.In.Name) {
+			return errors.New("Name can only be used together with Database in IN")
+		}
+	}
+	return nil
+}
+
+func (v *schemas) Show(ctx context.Context, opts *ShowSchemaOptions) ([]*Schema, error) {
+	if opts == nil {
+		opts = &ShowSchemaOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []schemaDBRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*Schema, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toSchema()
+	}
+	return resultList, nil
+}
+
+func (v *schemas) ShowByID(ctx context.Context, id SchemaIdentifier) (*Schema, error) {
+	schemas, err := v.Show(ctx, &ShowSchemaOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+		In: &SchemaIn{
+			Database: Bool(true),
+			Name:     NewAccountObjectIdentifier(id.DatabaseName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, schema := range schemas {
+		if schema.ID() == id {
+			return schema, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+func (v *schemas) Use(ctx context.Context, id SchemaIdentifier) error {
+	// proxy to sessions
+	return v.client.Sessions.UseSchema(ctx, id)
+}
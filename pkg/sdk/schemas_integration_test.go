@@ -0,0 +1,214 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_SchemasCreate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	db, cleanupDb := createDatabase(t, client)
+	t.Cleanup(cleanupDb)
+
+	t.Run("transient with managed access", func(t *testing.T) {
+		comment := randomComment(t)
+		schema, cleanupSchema := createSchemaWithOptions(t, client, db, &CreateSchemaOptions{
+			Transient:               Bool(true),
+			WithManagedAccess:       Bool(true),
+			DataRetentionTimeInDays: Int(0),
+			Comment:                 String(comment),
+		})
+		t.Cleanup(cleanupSchema)
+		assert.True(t, schema.IsTransient())
+		assert.True(t, schema.IsManagedAccess())
+		assert.Equal(t, comment, schema.Comment)
+		assert.Equal(t, 0, schema.RetentionTime)
+	})
+
+	t.Run("clone", func(t *testing.T) {
+		source, cleanupSource := createSchema(t, client, db)
+		t.Cleanup(cleanupSource)
+		id := NewSchemaIdentifier(db.Name, randomStringN(t, 12))
+		err := client.Schemas.Create(ctx, id, &CreateSchemaOptions{
+			Clone: &Clone{
+				SourceObject: source.ID(),
+			},
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.Schemas.Drop(ctx, id, nil)
+			require.NoError(t, err)
+		})
+		clone, err := client.Schemas.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), clone.Name)
+	})
+
+	t.Run("with tag", func(t *testing.T) {
+		tagSchema, cleanupTagSchema := createSchema(t, client, db)
+		t.Cleanup(cleanupTagSchema)
+		tag, cleanupTag := createTag(t, client, db, tagSchema)
+		t.Cleanup(cleanupTag)
+
+		schema, cleanupSchema := createSchemaWithOptions(t, client, db, &CreateSchemaOptions{
+			Tag: []TagAssociation{{Name: tag.ID(), Value: "v1"}},
+		})
+		t.Cleanup(cleanupSchema)
+		value, err := client.SystemFunctions.GetTag(ctx, tag.ID(), schema.ID(), ObjectTypeSchema)
+		require.NoError(t, err)
+		assert.Equal(t, "v1", value)
+	})
+}
+
+func TestInt_SchemasAlter(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	db, cleanupDb := createDatabase(t, client)
+	t.Cleanup(cleanupDb)
+
+	t.Run("rename to", func(t *testing.T) {
+		schema, _ := createSchema(t, client, db)
+		newID := NewSchemaIdentifier(db.Name, randomStringN(t, 12))
+		err := client.Schemas.Alter(ctx, schema.ID(), &AlterSchemaOptions{
+			NewName: newID,
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.Schemas.Drop(ctx, newID, nil)
+			require.NoError(t, err)
+		})
+		_, err = client.Schemas.ShowByID(ctx, schema.ID())
+		assert.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+		_, err = client.Schemas.ShowByID(ctx, newID)
+		require.NoError(t, err)
+	})
+
+	t.Run("swap with", func(t *testing.T) {
+		schema, cleanupSchema := createSchemaWithOptions(t, client, db, &CreateSchemaOptions{
+			Comment: String("first"),
+		})
+		t.Cleanup(cleanupSchema)
+		other, cleanupOther := createSchemaWithOptions(t, client, db, &CreateSchemaOptions{
+			Comment: String("second"),
+		})
+		t.Cleanup(cleanupOther)
+
+		err := client.Schemas.Alter(ctx, schema.ID(), &AlterSchemaOptions{
+			SwapWith: other.ID(),
+		})
+		require.NoError(t, err)
+		swapped, err := client.Schemas.ShowByID(ctx, schema.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "second", swapped.Comment)
+	})
+
+	t.Run("set and unset", func(t *testing.T) {
+		schema, cleanupSchema := createSchema(t, client, db)
+		t.Cleanup(cleanupSchema)
+
+		err := client.Schemas.Alter(ctx, schema.ID(), &AlterSchemaOptions{
+			Set: &SchemaSet{
+				DataRetentionTimeInDays: Int(3),
+				Comment:                 String("comment"),
+			},
+		})
+		require.NoError(t, err)
+		altered, err := client.Schemas.ShowByID(ctx, schema.ID())
+		require.NoError(t, err)
+		assert.Equal(t, 3, altered.RetentionTime)
+		assert.Equal(t, "comment", altered.Comment)
+
+		err = client.Schemas.Alter(ctx, schema.ID(), &AlterSchemaOptions{
+			Unset: &SchemaUnset{
+				DataRetentionTimeInDays: Bool(true),
+				Comment:                 Bool(true),
+			},
+		})
+		require.NoError(t, err)
+		altered, err = client.Schemas.ShowByID(ctx, schema.ID())
+		require.NoError(t, err)
+		assert.Equal(t, db.RetentionTime, altered.RetentionTime)
+		assert.Equal(t, "", altered.Comment)
+	})
+
+	t.Run("enable and disable managed access", func(t *testing.T) {
+		schema, cleanupSchema := createSchema(t, client, db)
+		t.Cleanup(cleanupSchema)
+
+		err := client.Schemas.Alter(ctx, schema.ID(), &AlterSchemaOptions{
+			EnableManagedAccess: Bool(true),
+		})
+		require.NoError(t, err)
+		altered, err := client.Schemas.ShowByID(ctx, schema.ID())
+		require.NoError(t, err)
+		assert.True(t, altered.IsManagedAccess())
+
+		err = client.Schemas.Alter(ctx, schema.ID(), &AlterSchemaOptions{
+			DisableManagedAccess: Bool(true),
+		})
+		require.NoError(t, err)
+		altered, err = client.Schemas.ShowByID(ctx, schema.ID())
+		require.NoError(t, err)
+		assert.False(t, altered.IsManagedAccess())
+	})
+}
+
+func TestInt_SchemasDropAndUndrop(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	db, cleanupDb := createDatabase(t, client)
+	t.Cleanup(cleanupDb)
+
+	schema, cleanupSchema := createSchema(t, client, db)
+	t.Cleanup(cleanupSchema)
+
+	err := client.Schemas.Drop(ctx, schema.ID(), &DropSchemaOptions{
+		Cascade: Bool(true),
+	})
+	require.NoError(t, err)
+	_, err = client.Schemas.ShowByID(ctx, schema.ID())
+	assert.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+
+	err = client.Schemas.Undrop(ctx, schema.ID())
+	require.NoError(t, err)
+	_, err = client.Schemas.ShowByID(ctx, schema.ID())
+	require.NoError(t, err)
+}
+
+func TestInt_SchemasShowAndDescribe(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	db, cleanupDb := createDatabase(t, client)
+	t.Cleanup(cleanupDb)
+	schema, cleanupSchema := createSchema(t, client, db)
+	t.Cleanup(cleanupSchema)
+	tag, cleanupTag := createTag(t, client, db, schema)
+	t.Cleanup(cleanupTag)
+
+	t.Run("show in database", func(t *testing.T) {
+		schemas, err := client.Schemas.Show(ctx, &ShowSchemaOptions{
+			In: &SchemaIn{
+				Database: Bool(true),
+				Name:     db.ID(),
+			},
+		})
+		require.NoError(t, err)
+		names := make([]string, len(schemas))
+		for i, s := range schemas {
+			names[i] = s.Name
+		}
+		assert.Contains(t, names, schema.Name)
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		details, err := client.Schemas.Describe(ctx, schema.ID())
+		require.NoError(t, err)
+		require.Len(t, details, 1)
+		assert.Equal(t, tag.Name, details[0].Name)
+		assert.Equal(t, "TAG", details[0].Kind)
+	})
+}
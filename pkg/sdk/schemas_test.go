@@ -0,0 +1,286 @@
+package sdk
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemasCreate(t *testing.T) {
+	id := NewSchemaIdentifier("db", "schema")
+
+	t.Run("clone", func(t *testing.T) {
+		opts := &CreateSchemaOptions{
+			name: id,
+			Clone: &Clone{
+				SourceObject: NewSchemaIdentifier("db", "source"),
+				Before: &TimeTravel{
+					Offset: Int(-3600),
+				},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE SCHEMA "db"."schema" CLONE "db"."source" BEFORE (OFFSET => -3600)`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("clone at timestamp", func(t *testing.T) {
+		opts := &CreateSchemaOptions{
+			name: id,
+			Clone: &Clone{
+				SourceObject: NewSchemaIdentifier("db", "source"),
+				At: &TimeTravel{
+					Timestamp: Pointer(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE SCHEMA "db"."schema" CLONE "db"."source" AT (TIMESTAMP => '2021-01-01 00:00:00 +0000 UTC')`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("complete", func(t *testing.T) {
+		opts := &CreateSchemaOptions{
+			name:                       id,
+			OrReplace:                  Bool(true),
+			Transient:                  Bool(true),
+			WithManagedAccess:          Bool(true),
+			DataRetentionTimeInDays:    Int(1),
+			MaxDataExtensionTimeInDays: Int(2),
+			DefaultDDLCollation:        String("en_US-trim"),
+			Tag: []TagAssociation{
+				{
+					Name:  NewSchemaObjectIdentifier("db", "schema", "tag"),
+					Value: "v1",
+				},
+			},
+			Comment: String("comment"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `CREATE OR REPLACE TRANSIENT SCHEMA "db"."schema" WITH MANAGED ACCESS DATA_RETENTION_TIME_IN_DAYS = 1 MAX_DATA_EXTENSION_TIME_IN_DAYS = 2 DEFAULT_DDL_COLLATION = 'en_US-trim' TAG ("db"."schema"."tag" = 'v1') COMMENT = 'comment'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: or replace and if not exists", func(t *testing.T) {
+		opts := &CreateSchemaOptions{
+			name:        id,
+			OrReplace:   Bool(true),
+			IfNotExists: Bool(true),
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: data retention out of range", func(t *testing.T) {
+		opts := &CreateSchemaOptions{
+			name:                    id,
+			DataRetentionTimeInDays: Int(91),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestSchemasAlter(t *testing.T) {
+	id := NewSchemaIdentifier("db", "schema")
+
+	t.Run("rename to", func(t *testing.T) {
+		opts := &AlterSchemaOptions{
+			IfExists: Bool(true),
+			name:     id,
+			NewName:  NewSchemaIdentifier("db", "new_schema"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER SCHEMA IF EXISTS "db"."schema" RENAME TO "db"."new_schema"`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("swap with", func(t *testing.T) {
+		opts := &AlterSchemaOptions{
+			name:     id,
+			SwapWith: NewSchemaIdentifier("db", "other"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER SCHEMA "db"."schema" SWAP WITH "db"."other"`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		opts := &AlterSchemaOptions{
+			name: id,
+			Set: &SchemaSet{
+				DataRetentionTimeInDays: Int(3),
+				Comment:                 String("comment"),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER SCHEMA "db"."schema" SET DATA_RETENTION_TIME_IN_DAYS = 3, COMMENT = 'comment'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := &AlterSchemaOptions{
+			name: id,
+			Unset: &SchemaUnset{
+				DataRetentionTimeInDays: Bool(true),
+				Comment:                 Bool(true),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER SCHEMA "db"."schema" UNSET DATA_RETENTION_TIME_IN_DAYS, COMMENT`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("set tag", func(t *testing.T) {
+		opts := &AlterSchemaOptions{
+			name: id,
+			SetTag: []TagAssociation{
+				{Name: NewSchemaObjectIdentifier("db", "schema", "tag1"), Value: "v1"},
+				{Name: NewSchemaObjectIdentifier("db", "schema", "tag2"), Value: "v2"},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER SCHEMA "db"."schema" SET TAG "db"."schema"."tag1" = 'v1', "db"."schema"."tag2" = 'v2'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("unset tag", func(t *testing.T) {
+		opts := &AlterSchemaOptions{
+			name:     id,
+			UnsetTag: []ObjectIdentifier{NewSchemaObjectIdentifier("db", "schema", "tag1")},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER SCHEMA "db"."schema" UNSET TAG "db"."schema"."tag1"`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("enable managed access", func(t *testing.T) {
+		opts := &AlterSchemaOptions{
+			name:                id,
+			EnableManagedAccess: Bool(true),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER SCHEMA "db"."schema" ENABLE MANAGED ACCESS`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("disable managed access", func(t *testing.T) {
+		opts := &AlterSchemaOptions{
+			name:                 id,
+			DisableManagedAccess: Bool(true),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `ALTER SCHEMA "db"."schema" DISABLE MANAGED ACCESS`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: more than one action", func(t *testing.T) {
+		opts := &AlterSchemaOptions{
+			name:                id,
+			NewName:             NewSchemaIdentifier("db", "new_schema"),
+			EnableManagedAccess: Bool(true),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestSchemasDrop(t *testing.T) {
+	t.Run("cascade", func(t *testing.T) {
+		opts := &DropSchemaOptions{
+			IfExists: Bool(true),
+			name:     NewSchemaIdentifier("db", "schema"),
+			Cascade:  Bool(true),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `DROP SCHEMA IF EXISTS "db"."schema" CASCADE`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: cascade and restrict", func(t *testing.T) {
+		opts := &DropSchemaOptions{
+			name:     NewSchemaIdentifier("db", "schema"),
+			Cascade:  Bool(true),
+			Restrict: Bool(true),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestSchemasUndrop(t *testing.T) {
+	opts := &undropSchemaOptions{
+		name: NewSchemaIdentifier("db", "schema"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	expected := `UNDROP SCHEMA "db"."schema"`
+	assert.Equal(t, expected, actual)
+}
+
+func TestSchemasDescribe(t *testing.T) {
+	opts := &describeSchemaOptions{
+		name: NewSchemaIdentifier("db", "schema"),
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	expected := `DESCRIBE SCHEMA "db"."schema"`
+	assert.Equal(t, expected, actual)
+}
+
+func TestSchemasShow(t *testing.T) {
+	t.Run("complete", func(t *testing.T) {
+		opts := &ShowSchemaOptions{
+			Terse:   Bool(true),
+			History: Bool(true),
+			Like: &Like{
+				Pattern: String("schema_pattern"),
+			},
+			In: &SchemaIn{
+				Database: Bool(true),
+				Name:     NewAccountObjectIdentifier("db"),
+			},
+			StartsWith: String("abc"),
+			LimitFrom: &LimitFrom{
+				Rows: Int(10),
+				From: String("xyz"),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `SHOW TERSE SCHEMAS HISTORY LIKE 'schema_pattern' IN DATABASE "db" STARTS WITH 'abc' LIMIT 10 FROM 'xyz'`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("in account", func(t *testing.T) {
+		opts := &ShowSchemaOptions{
+			In: &SchemaIn{
+				Account: Bool(true),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := `SHOW SCHEMAS IN ACCOUNT`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: account and database", func(t *testing.T) {
+		opts := &ShowSchemaOptions{
+			In: &SchemaIn{
+				Account:  Bool(true),
+				Database: Bool(true),
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
@@ -0,0 +1,445 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Secrets = (*secrets)(nil)
+
+// Secrets describes all the secret related methods that the
+// Snowflake API supports.
+type Secrets interface {
+	// Create creates a secret of the given type.
+	Create(ctx context.Context, id SchemaObjectIdentifier, secretType SecretType, opts *CreateSecretOptions) error
+	// Alter modifies an existing secret.
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterSecretOptions) error
+	// Drop removes a secret.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropSecretOptions) error
+	// Show returns a list of secrets.
+	Show(ctx context.Context, opts *ShowSecretOptions) ([]*Secret, error)
+	// ShowByID returns a secret by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Secret, error)
+	// Describe returns the metadata of a secret. Secret values are never returned.
+	Describe(ctx context.Context, id SchemaObjectIdentifier) (*SecretDetails, error)
+}
+
+// secrets implements Secrets.
+type secrets struct {
+	client *Client
+}
+
+type SecretType string
+
+var (
+	SecretTypePassword      SecretType = "PASSWORD"
+	SecretTypeGenericString SecretType = "GENERIC_STRING"
+	SecretTypeOAuth2        SecretType = "OAUTH2"
+)
+
+type SecretOAuthScope struct {
+	Scope string `ddl:"keyword,single_quotes"`
+}
+
+// CreateSecretOptions contains options for creating a secret. Which options apply depends on the secret type:
+// OAUTH2 secrets use ApiAuthentication with either OAuthScopes (client credentials flow) or OAuthRefreshToken
+// (authorization code flow), PASSWORD secrets use Username and Password and GENERIC_STRING secrets use SecretString.
+type CreateSecretOptions struct {
+	create      bool                   `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace   *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	secret      bool                   `ddl:"static" sql:"SECRET"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name        SchemaObjectIdentifier `ddl:"identifier"`
+
+	// required
+	secretType SecretType `ddl:"parameter" sql:"TYPE"`
+
+	// OAUTH2
+	ApiAuthentication           AccountObjectIdentifier `ddl:"identifier,equals" sql:"API_AUTHENTICATION"`
+	OAuthScopes                 []SecretOAuthScope      `ddl:"parameter,parentheses" sql:"OAUTH_SCOPES"`
+	OAuthRefreshToken           *string                 `ddl:"parameter,single_quotes" sql:"OAUTH_REFRESH_TOKEN"`
+	OAuthRefreshTokenExpiryTime *string                 `ddl:"parameter,single_quotes" sql:"OAUTH_REFRESH_TOKEN_EXPIRY_TIME"`
+
+	// PASSWORD
+	Username *string `ddl:"parameter,single_quotes" sql:"USERNAME"`
+	Password *string `ddl:"parameter,single_quotes" sql:"PASSWORD"`
+
+	// GENERIC_STRING
+	SecretString *string `ddl:"parameter,single_quotes" sql:"SECRET_STRING"`
+
+	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *CreateSecretOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("OR REPLACE and IF NOT EXISTS are incompatible")
+	}
+	oauth2Set := anyValueSet(opts.ApiAuthentication, opts.OAuthScopes, opts.OAuthRefreshToken, opts.OAuthRefreshTokenExpiryTime)
+	passwordSet := anyValueSet(opts.Username, opts.Password)
+	switch opts.secretType {
+	case SecretTypeOAuth2:
+		if passwordSet || valueSet(opts.SecretString) {
+			return errors.New("only ApiAuthentication, OAuthScopes, OAuthRefreshToken and OAuthRefreshTokenExpiryTime can be set for OAUTH2 secrets")
+		}
+		if !validObjectidentifier(opts.ApiAuthentication) {
+			return errors.New("ApiAuthentication must be set for OAUTH2 secrets")
+		}
+		if valueSet(opts.OAuthScopes) && anyValueSet(opts.OAuthRefreshToken, opts.OAuthRefreshTokenExpiryTime) {
+			return errors.New("OAuthScopes and OAuthRefreshToken are incompatible")
+		}
+		if valueSet(opts.OAuthRefreshToken) != valueSet(opts.OAuthRefreshTokenExpiryTime) {
+			return errors.New("OAuthRefreshToken and OAuthRefreshTokenExpiryTime must be set together")
+		}
+	case SecretTypePassword:
+		if oauth2Set || valueSet(opts.SecretString) {
+			return errors.New("only Username and Password can be set for PASSWORD secrets")
+		}
+		if !everyValueSet(opts.Username, opts.Password) {
+			return errors.New("Username and Password must be set for PASSWORD secrets")
+		}
+	case SecretTypeGenericString:
+		if oauth2Set || passwordSet {
+			return errors.New("only SecretString can be set for GENERIC_STRING secrets")
+		}
+		if !valueSet(opts.SecretString) {
+			return errors.New("SecretString must be set for GENERIC_STRING secrets")
+		}
+	default:
+		return fmt.Errorf("unsupported secret type %q", opts.secretType)
+	}
+	return nil
+}
+
+func (v *secrets) Create(ctx context.Context, id SchemaObjectIdentifier, secretType SecretType, opts *CreateSecretOptions) error {
+	if opts == nil {
+		opts = &CreateSecretOptions{}
+	}
+	opts.name = id
+	opts.secretType = secretType
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// AlterSecretOptions contains options for altering a secret.
+type AlterSecretOptions struct {
+	alter    bool                   `ddl:"static" sql:"ALTER"`  //lint:ignore U1000 This is used in the ddl tag
+	secret   bool                   `ddl:"static" sql:"SECRET"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+	Set      *SecretSet             `ddl:"keyword" sql:"SET"`
+	Unset    *SecretUnset           `ddl:"list,no_parentheses" sql:"UNSET"`
+}
+
+func (opts *AlterSecretOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.Set, opts.Unset) {
+		return errors.New("exactly one of Set or Unset must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type SecretSet struct {
+	OAuthScopes                 []SecretOAuthScope `ddl:"parameter,parentheses" sql:"OAUTH_SCOPES"`
+	OAuthRefreshToken           *string            `ddl:"parameter,single_quotes" sql:"OAUTH_REFRESH_TOKEN"`
+	OAuthRefreshTokenExpiryTime *string            `ddl:"parameter,single_quotes" sql:"OAUTH_REFRESH_TOKEN_EXPIRY_TIME"`
+	Username                    *string            `ddl:"parameter,single_quotes" sql:"USERNAME"`
+	Password                    *string            `ddl:"parameter,single_quotes" sql:"PASSWORD"`
+	SecretString                *string            `ddl:"parameter,single_quotes" sql:"SECRET_STRING"`
+	Comment                     *string            `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (v *SecretSet) validate() error {
+	if everyValueNil(v.OAuthScopes, v.OAuthRefreshToken, v.OAuthRefreshTokenExpiryTime, v.Username, v.Password, v.SecretString, v.Comment) {
+		return errors.New("must set at least one parameter")
+	}
+	oauth2Set := anyValueSet(v.OAuthScopes, v.OAuthRefreshToken, v.OAuthRefreshTokenExpiryTime)
+	passwordSet := anyValueSet(v.Username, v.Password)
+	secretStringSet := valueSet(v.SecretString)
+	if (oauth2Set && passwordSet) || (oauth2Set && secretStringSet) || (passwordSet && secretStringSet) {
+		return errors.New("OAUTH2, PASSWORD and GENERIC_STRING options cannot be set together")
+	}
+	return nil
+}
+
+type SecretUnset struct {
+	Comment *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *SecretUnset) validate() error {
+	if !valueSet(v.Comment) {
+		return errors.New("must unset at least one parameter")
+	}
+	return nil
+}
+
+func (v *secrets) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterSecretOptions) error {
+	if opts == nil {
+		opts = &AlterSecretOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// DropSecretOptions contains options for dropping a secret.
+type DropSecretOptions struct {
+	drop     bool                   `ddl:"static" sql:"DROP"`   //lint:ignore U1000 This is used in the ddl tag
+	secret   bool                   `ddl:"static" sql:"SECRET"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *DropSecretOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *secrets) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropSecretOptions) error {
+	if opts == nil {
+		opts = &DropSecretOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return fmt.Errorf("validate drop options: %w", err)
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// ShowSecretOptions contains options for listing secrets.
+type ShowSecretOptions struct {
+	show    bool  `ddl:"static" sql:"SHOW"`    //lint:ignore U1000 This is used in the ddl tag
+	secrets bool  `ddl:"static" sql:"SECRETS"` //lint:ignore U1000 This is used in the ddl tag
+	Like    *Like `ddl:"keyword" sql:"LIKE"`
+	In      *In   `ddl:"keyword" sql:"IN"`
+}
+
+func (opts *ShowSecretOptions) validate() error {
+	return nil
+}
+
+// Secret is a user friendly result for a SHOW SECRETS query.
+type Secret struct {
+	CreatedOn     time.Time
+	Name          string
+	SchemaName    string
+	DatabaseName  string
+	Owner         string
+	Comment       string
+	SecretType    SecretType
+	OAuthScopes   []string
+	OwnerRoleType string
+}
+
+func (v *Secret) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *Secret) ObjectType() ObjectType {
+	return ObjectTypeSecret
+}
+
+// secretDBRow is used to decode the result of a SHOW SECRETS query.
+type secretDBRow struct {
+	CreatedOn     time.Time      `db:"created_on"`
+	Name          string         `db:"name"`
+	SchemaName    string         `db:"schema_name"`
+	DatabaseName  string         `db:"database_name"`
+	Owner         sql.NullString `db:"owner"`
+	Comment       sql.NullString `db:"comment"`
+	SecretType    string         `db:"secret_type"`
+	OAuthScopes   sql.NullString `db:"oauth_scopes"`
+	OwnerRoleType sql.NullString `db:"owner_role_type"`
+}
+
+func (row secretDBRow) toSecret() *Secret {
+	return &Secret{
+		CreatedOn:     row.CreatedOn,
+		Name:          row.Name,
+		SchemaName:    row.SchemaName,
+		DatabaseName:  row.DatabaseName,
+		Owner:         row.Owner.String,
+		Comment:       row.Comment.String,
+		SecretType:    SecretType(row.SecretType),
+		OAuthScopes:   parseSecretOAuthScopes(row.OAuthScopes.String),
+		OwnerRoleType: row.OwnerRoleType.String,
+	}
+}
+
+// parseSecretOAuthScopes parses the OAuth scopes the way Snowflake reports them, e.g. [scope1, scope2].
+func parseSecretOAuthScopes(s string) []string {
+	s = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(s), "["), "]")
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	scopes := []string{}
+	for _, scope := range strings.Split(s, ",") {
+		scopes = append(scopes, strings.Trim(strings.TrimSpace(scope), `"'`))
+	}
+	return scopes
+}
+
+// Show lists all the secrets matching the options.
+func (v *secrets) Show(ctx context.Context, opts *ShowSecretOptions) ([]*Secret, error) {
+	if opts == nil {
+		opts = &ShowSecretOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []secretDBRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*Secret, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toSecret()
+	}
+	return resultList, nil
+}
+
+func (v *secrets) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Secret, error) {
+	secrets, err := v.Show(ctx, &ShowSecretOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, secret := range secrets {
+		if secret.Name == id.Name() {
+			return secret, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+type describeSecretOptions struct {
+	describe bool                   `ddl:"static" sql:"DESCRIBE"` //lint:ignore U1000 This is used in the ddl tag
+	secret   bool                   `ddl:"static" sql:"SECRET"`   //lint:ignore U1000 This is used in the ddl tag
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeSecretOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// SecretDetails is a user friendly result for a DESCRIBE SECRET query. It holds the metadata of the secret, its
+// password, secret string or tokens are never reported.
+type SecretDetails struct {
+	CreatedOn                   time.Time
+	Name                        string
+	SchemaName                  string
+	DatabaseName                string
+	Owner                       string
+	Comment                     string
+	SecretType                  SecretType
+	Username                    string
+	OAuthAccessTokenExpiryTime  string
+	OAuthRefreshTokenExpiryTime string
+	OAuthScopes                 []string
+	IntegrationName             string
+}
+
+type secretDetailsRow struct {
+	CreatedOn                   time.Time      `db:"created_on"`
+	Name                        string         `db:"name"`
+	SchemaName                  string         `db:"schema_name"`
+	DatabaseName                string         `db:"database_name"`
+	Owner                       sql.NullString `db:"owner"`
+	Comment                     sql.NullString `db:"comment"`
+	SecretType                  string         `db:"secret_type"`
+	Username                    sql.NullString `db:"username"`
+	OAuthAccessTokenExpiryTime  sql.NullString `db:"oauth_access_token_expiry_time"`
+	OAuthRefreshTokenExpiryTime sql.NullString `db:"oauth_refresh_token_expiry_time"`
+	OAuthScopes                 sql.NullString `db:"oauth_scopes"`
+	IntegrationName             sql.NullString `db:"integration_name"`
+}
+
+func (row secretDetailsRow) toSecretDetails() *SecretDetails {
+	return &SecretDetails{
+		CreatedOn:                   row.CreatedOn,
+		Name:                        row.Name,
+		SchemaName:                  row.SchemaName,
+		DatabaseName:                row.DatabaseName,
+		Owner:                       row.Owner.String,
+		Comment:                     row.Comment.String,
+		SecretType:                  SecretType(row.SecretType),
+		Username:                    row.Username.String,
+		OAuthAccessTokenExpiryTime:  row.OAuthAccessTokenExpiryTime.String,
+		OAuthRefreshTokenExpiryTime: row.OAuthRefreshTokenExpiryTime.String,
+		OAuthScopes:                 parseSecretOAuthScopes(row.OAuthScopes.String),
+		IntegrationName:             row.IntegrationName.String,
+	}
+}
+
+func (v *secrets) Describe(ctx context.Context, id SchemaObjectIdentifier) (*SecretDetails, error) {
+	opts := &describeSecretOptions{
+		name: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := secretDetailsRow{}
+	err = v.client.queryOne(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	return dest.toSecretDetails(), nil
+}
@@ -0,0 +1,156 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_SecretsShow(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+
+	secretTest, secretCleanup := createSecret(t, client, databaseTest, schemaTest)
+	t.Cleanup(secretCleanup)
+	secret2Test, secret2Cleanup := createSecret(t, client, databaseTest, schemaTest)
+	t.Cleanup(secret2Cleanup)
+
+	t.Run("in schema", func(t *testing.T) {
+		secrets, err := client.Secrets.Show(ctx, &ShowSecretOptions{
+			In: &In{
+				Schema: schemaTest.ID(),
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, len(secrets))
+	})
+
+	t.Run("with like", func(t *testing.T) {
+		secrets, err := client.Secrets.Show(ctx, &ShowSecretOptions{
+			Like: &Like{
+				Pattern: String(secretTest.Name),
+			},
+			In: &In{
+				Database: databaseTest.ID(),
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(secrets))
+		assert.Equal(t, secretTest.ID(), secrets[0].ID())
+		assert.NotEqual(t, secret2Test.Name, secrets[0].Name)
+	})
+}
+
+func TestInt_SecretCreate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+
+	t.Run("password", func(t *testing.T) {
+		secret, secretCleanup := createSecretWithOptions(t, client, databaseTest, schemaTest, SecretTypePassword, &CreateSecretOptions{
+			Username: String("user"),
+			Password: String("pass"),
+			Comment:  String("test comment"),
+		})
+		t.Cleanup(secretCleanup)
+
+		assert.Equal(t, SecretTypePassword, secret.SecretType)
+		assert.Equal(t, "test comment", secret.Comment)
+		details, err := client.Secrets.Describe(ctx, secret.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "user", details.Username)
+	})
+
+	t.Run("generic string", func(t *testing.T) {
+		secret, secretCleanup := createSecret(t, client, databaseTest, schemaTest)
+		t.Cleanup(secretCleanup)
+
+		assert.Equal(t, SecretTypeGenericString, secret.SecretType)
+	})
+
+	t.Run("oauth2 with scopes", func(t *testing.T) {
+		integration, integrationCleanup := createApiAuthenticationIntegration(t, client)
+		t.Cleanup(integrationCleanup)
+
+		secret, secretCleanup := createSecretWithOptions(t, client, databaseTest, schemaTest, SecretTypeOAuth2, &CreateSecretOptions{
+			ApiAuthentication: integration,
+			OAuthScopes:       []SecretOAuthScope{{Scope: "read"}},
+		})
+		t.Cleanup(secretCleanup)
+
+		assert.Equal(t, SecretTypeOAuth2, secret.SecretType)
+		details, err := client.Secrets.Describe(ctx, secret.ID())
+		require.NoError(t, err)
+		assert.Equal(t, integration.Name(), details.IntegrationName)
+		assert.Equal(t, []string{"read"}, details.OAuthScopes)
+	})
+}
+
+func TestInt_SecretAlter(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+
+	t.Run("set username and comment", func(t *testing.T) {
+		secret, secretCleanup := createSecretWithOptions(t, client, databaseTest, schemaTest, SecretTypePassword, &CreateSecretOptions{
+			Username: String("user"),
+			Password: String("pass"),
+		})
+		t.Cleanup(secretCleanup)
+		id := secret.ID()
+
+		err := client.Secrets.Alter(ctx, id, &AlterSecretOptions{
+			Set: &SecretSet{
+				Username: String("other_user"),
+				Password: String("other_pass"),
+				Comment:  String("new comment"),
+			},
+		})
+		require.NoError(t, err)
+		details, err := client.Secrets.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "other_user", details.Username)
+		assert.Equal(t, "new comment", details.Comment)
+
+		err = client.Secrets.Alter(ctx, id, &AlterSecretOptions{
+			Unset: &SecretUnset{
+				Comment: Bool(true),
+			},
+		})
+		require.NoError(t, err)
+		details, err = client.Secrets.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Empty(t, details.Comment)
+	})
+}
+
+func TestInt_SecretDrop(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+
+	secret, _ := createSecret(t, client, databaseTest, schemaTest)
+	id := secret.ID()
+	err := client.Secrets.Drop(ctx, id, nil)
+	require.NoError(t, err)
+	_, err = client.Secrets.ShowByID(ctx, id)
+	assert.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+
+	err = client.Secrets.Drop(ctx, id, &DropSecretOptions{IfExists: Bool(true)})
+	require.NoError(t, err)
+}
@@ -0,0 +1,241 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretCreate(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("password", func(t *testing.T) {
+		opts := &CreateSecretOptions{
+			OrReplace:  Bool(true),
+			name:       id,
+			secretType: SecretTypePassword,
+			Username:   String("user"),
+			Password:   String("pa'ss"),
+			Comment:    String("test comment"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE OR REPLACE SECRET %s TYPE = PASSWORD USERNAME = 'user' PASSWORD = 'pa\'ss' COMMENT = 'test comment'`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("generic string", func(t *testing.T) {
+		opts := &CreateSecretOptions{
+			IfNotExists:  Bool(true),
+			name:         id,
+			secretType:   SecretTypeGenericString,
+			SecretString: String("s3cr3t"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE SECRET IF NOT EXISTS %s TYPE = GENERIC_STRING SECRET_STRING = 's3cr3t'`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("oauth2 with scopes", func(t *testing.T) {
+		integration := randomAccountObjectIdentifier(t)
+		opts := &CreateSecretOptions{
+			name:              id,
+			secretType:        SecretTypeOAuth2,
+			ApiAuthentication: integration,
+			OAuthScopes:       []SecretOAuthScope{{Scope: "read"}, {Scope: "write"}},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE SECRET %s TYPE = OAUTH2 API_AUTHENTICATION = %s OAUTH_SCOPES = ('read', 'write')`, id.FullyQualifiedName(), integration.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("oauth2 with refresh token", func(t *testing.T) {
+		integration := randomAccountObjectIdentifier(t)
+		opts := &CreateSecretOptions{
+			name:                        id,
+			secretType:                  SecretTypeOAuth2,
+			ApiAuthentication:           integration,
+			OAuthRefreshToken:           String("token"),
+			OAuthRefreshTokenExpiryTime: String("2030-01-01 00:00:00"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE SECRET %s TYPE = OAUTH2 API_AUTHENTICATION = %s OAUTH_REFRESH_TOKEN = 'token' OAUTH_REFRESH_TOKEN_EXPIRY_TIME = '2030-01-01 00:00:00'`, id.FullyQualifiedName(), integration.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: password without username", func(t *testing.T) {
+		opts := &CreateSecretOptions{
+			name:       id,
+			secretType: SecretTypePassword,
+			Password:   String("pass"),
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: options of another type", func(t *testing.T) {
+		opts := &CreateSecretOptions{
+			name:         id,
+			secretType:   SecretTypeGenericString,
+			SecretString: String("s3cr3t"),
+			Username:     String("user"),
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: oauth2 without api authentication", func(t *testing.T) {
+		opts := &CreateSecretOptions{
+			name:        id,
+			secretType:  SecretTypeOAuth2,
+			OAuthScopes: []SecretOAuthScope{{Scope: "read"}},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: oauth2 with scopes and refresh token", func(t *testing.T) {
+		opts := &CreateSecretOptions{
+			name:                        id,
+			secretType:                  SecretTypeOAuth2,
+			ApiAuthentication:           randomAccountObjectIdentifier(t),
+			OAuthScopes:                 []SecretOAuthScope{{Scope: "read"}},
+			OAuthRefreshToken:           String("token"),
+			OAuthRefreshTokenExpiryTime: String("2030-01-01 00:00:00"),
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: unknown type", func(t *testing.T) {
+		opts := &CreateSecretOptions{
+			name:       id,
+			secretType: SecretType("CLOUD_PROVIDER_TOKEN"),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestSecretAlter(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("set password", func(t *testing.T) {
+		opts := &AlterSecretOptions{
+			IfExists: Bool(true),
+			name:     id,
+			Set: &SecretSet{
+				Username: String("user"),
+				Password: String("pass"),
+				Comment:  String("new comment"),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER SECRET IF EXISTS %s SET USERNAME = 'user' PASSWORD = 'pass' COMMENT = 'new comment'`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("set oauth scopes", func(t *testing.T) {
+		opts := &AlterSecretOptions{
+			name: id,
+			Set: &SecretSet{
+				OAuthScopes: []SecretOAuthScope{{Scope: "read"}},
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER SECRET %s SET OAUTH_SCOPES = ('read')`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("unset comment", func(t *testing.T) {
+		opts := &AlterSecretOptions{
+			name: id,
+			Unset: &SecretUnset{
+				Comment: Bool(true),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER SECRET %s UNSET COMMENT`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: options of different types", func(t *testing.T) {
+		opts := &AlterSecretOptions{
+			name: id,
+			Set: &SecretSet{
+				Password:     String("pass"),
+				SecretString: String("s3cr3t"),
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: no action", func(t *testing.T) {
+		opts := &AlterSecretOptions{
+			name: id,
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestSecretDrop(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("with if exists", func(t *testing.T) {
+		opts := &DropSecretOptions{
+			IfExists: Bool(true),
+			name:     id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("DROP SECRET IF EXISTS %s", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestSecretShow(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("with like and in schema", func(t *testing.T) {
+		opts := &ShowSecretOptions{
+			Like: &Like{
+				Pattern: String(id.Name()),
+			},
+			In: &In{
+				Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`SHOW SECRETS LIKE '%s' IN SCHEMA "%s"."%s"`, id.Name(), id.DatabaseName(), id.SchemaName())
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestSecretDescribe(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	opts := &describeSecretOptions{
+		name: id,
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("DESCRIBE SECRET %s", id.FullyQualifiedName()), actual)
+}
+
+func TestParseSecretOAuthScopes(t *testing.T) {
+	assert.Nil(t, parseSecretOAuthScopes(""))
+	assert.Nil(t, parseSecretOAuthScopes("[]"))
+	assert.Equal(t, []string{"read"}, parseSecretOAuthScopes("[read]"))
+	assert.Equal(t, []string{"read", "write"}, parseSecretOAuthScopes(`["read", "write"]`))
+}
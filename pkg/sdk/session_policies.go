@@ -2,68 +2,113 @@ package sdk
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 )
 
+// Compile-time proof of interface implementation.
+var _ SessionPolicies = (*sessionPolicies)(nil)
+
+// SessionPolicies describes all the session policy related methods that the
+// Snowflake API supports.
 type SessionPolicies interface {
 	// Create creates a session policy.
 	Create(ctx context.Context, id SchemaObjectIdentifier, opts *CreateSessionPolicyOptions) error
-	// Alter modifies an existing session policy
+	// Alter modifies an existing session policy.
 	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterSessionPolicyOptions) error
 	// Drop removes a session policy.
 	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *DropSessionPolicyOptions) error
-	// Show returns a list of session policy.
-	Show(ctx context.Context) ([]*SessionPolicy, error)
-	// ShowByID returns a session policy by ID
+	// Show returns a list of session policies.
+	Show(ctx context.Context, opts *SessionPolicyShowOptions) ([]*SessionPolicy, error)
+	// ShowByID returns a session policy by ID.
 	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*SessionPolicy, error)
 	// Describe returns the details of a session policy.
 	Describe(ctx context.Context, id SchemaObjectIdentifier) (*SessionPolicyDetails, error)
+	// ShowReferences returns the account and users the session policy is set on.
+	ShowReferences(ctx context.Context, id SchemaObjectIdentifier) ([]*PolicyReference, error)
 }
 
-var _ SessionPolicies = (*sessionPolicies)(nil)
-
+// sessionPolicies implements SessionPolicies.
 type sessionPolicies struct {
 	client *Client
 }
 
+// SessionPolicy is a user friendly result for a SHOW SESSION POLICIES query.
 type SessionPolicy struct {
+	CreatedOn    time.Time
 	Name         string
 	DatabaseName string
 	SchemaName   string
+	Kind         string
+	Owner        string
+	Comment      string
+}
+
+func (v *SessionPolicy) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
 }
 
-type sessionPolicyRow struct {
-	Name         string `db:"name"`
-	DatabaseName string `db:"database_name"`
-	SchemaName   string `db:"schema_name"`
+func (v *SessionPolicy) ObjectType() ObjectType {
+	return ObjectTypeSessionPolicy
 }
 
-func (row *sessionPolicyRow) toSessionPolicy() *SessionPolicy {
+// sessionPolicyDBRow is used to decode the result of a SHOW SESSION POLICIES query.
+type sessionPolicyDBRow struct {
+	CreatedOn     time.Time `db:"created_on"`
+	Name          string    `db:"name"`
+	DatabaseName  string    `db:"database_name"`
+	SchemaName    string    `db:"schema_name"`
+	Kind          string    `db:"kind"`
+	Owner         string    `db:"owner"`
+	Comment       string    `db:"comment"`
+	OwnerRoleType string    `db:"owner_role_type"`
+	Options       string    `db:"options"`
+}
+
+func (row sessionPolicyDBRow) toSessionPolicy() *SessionPolicy {
 	return &SessionPolicy{
+		CreatedOn:    row.CreatedOn,
 		Name:         row.Name,
 		DatabaseName: row.DatabaseName,
 		SchemaName:   row.SchemaName,
+		Kind:         row.Kind,
+		Owner:        row.Owner,
+		Comment:      row.Comment,
 	}
 }
 
-func (v *SessionPolicy) ID() SchemaObjectIdentifier {
-	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
-}
-
-func (v *SessionPolicy) ObjectType() ObjectType {
-	return ObjectTypeSessionPolicy
-}
-
 // CreateSessionPolicyOptions contains options for creating a session policy.
 type CreateSessionPolicyOptions struct {
-	create        bool                   `ddl:"static" sql:"CREATE"`         //lint:ignore U1000 This is used in the ddl tag
+	create        bool                   `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace     *bool                  `ddl:"keyword" sql:"OR REPLACE"`
 	sessionPolicy bool                   `ddl:"static" sql:"SESSION POLICY"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists   *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
 	name          SchemaObjectIdentifier `ddl:"identifier"`
+
+	SessionIdleTimeoutMins   *int `ddl:"parameter" sql:"SESSION_IDLE_TIMEOUT_MINS"`
+	SessionUIIdleTimeoutMins *int `ddl:"parameter" sql:"SESSION_UI_IDLE_TIMEOUT_MINS"`
+
+	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
 }
 
 func (opts *CreateSessionPolicyOptions) validate() error {
 	if !validObjectidentifier(opts.name) {
 		return ErrInvalidObjectIdentifier
 	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("OR REPLACE and IF NOT EXISTS are incompatible")
+	}
+	if valueSet(opts.SessionIdleTimeoutMins) {
+		if !validateIntInRange(*opts.SessionIdleTimeoutMins, 5, 240) {
+			return errors.New("SESSION_IDLE_TIMEOUT_MINS must be between 5 and 240")
+		}
+	}
+	if valueSet(opts.SessionUIIdleTimeoutMins) {
+		if !validateIntInRange(*opts.SessionUIIdleTimeoutMins, 5, 240) {
+			return errors.New("SESSION_UI_IDLE_TIMEOUT_MINS must be between 5 and 240")
+		}
+	}
 	return nil
 }
 
@@ -84,12 +129,91 @@ func (v *sessionPolicies) Create(ctx context.Context, id SchemaObjectIdentifier,
 }
 
 // AlterSessionPolicyOptions contains options for altering a session policy.
-type AlterSessionPolicyOptions struct{}
+type AlterSessionPolicyOptions struct {
+	alter         bool                   `ddl:"static" sql:"ALTER"`          //lint:ignore U1000 This is used in the ddl tag
+	sessionPolicy bool                   `ddl:"static" sql:"SESSION POLICY"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists      *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name          SchemaObjectIdentifier `ddl:"identifier"`
+	NewName       SchemaObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	Set           *SessionPolicySet      `ddl:"keyword" sql:"SET"`
+	Unset         *SessionPolicyUnset    `ddl:"list,no_parentheses" sql:"UNSET"`
+}
 
-func (v *sessionPolicies) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterSessionPolicyOptions) error {
+func (opts *AlterSessionPolicyOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.NewName, opts.Set, opts.Unset) {
+		return errors.New("exactly one of NewName, Set or Unset must be set")
+	}
+	if valueSet(opts.NewName) && !validObjectidentifier(opts.NewName) {
+		return ErrInvalidObjectIdentifier
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+type SessionPolicySet struct {
+	SessionIdleTimeoutMins   *int    `ddl:"parameter" sql:"SESSION_IDLE_TIMEOUT_MINS"`
+	SessionUIIdleTimeoutMins *int    `ddl:"parameter" sql:"SESSION_UI_IDLE_TIMEOUT_MINS"`
+	Comment                  *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (v *SessionPolicySet) validate() error {
+	if everyValueNil(v.SessionIdleTimeoutMins, v.SessionUIIdleTimeoutMins, v.Comment) {
+		return errors.New("must set at least one parameter")
+	}
+	if valueSet(v.SessionIdleTimeoutMins) {
+		if !validateIntInRange(*v.SessionIdleTimeoutMins, 5, 240) {
+			return errors.New("SESSION_IDLE_TIMEOUT_MINS must be between 5 and 240")
+		}
+	}
+	if valueSet(v.SessionUIIdleTimeoutMins) {
+		if !validateIntInRange(*v.SessionUIIdleTimeoutMins, 5, 240) {
+			return errors.New("SESSION_UI_IDLE_TIMEOUT_MINS must be between 5 and 240")
+		}
+	}
+	return nil
+}
+
+type SessionPolicyUnset struct {
+	SessionIdleTimeoutMins   *bool `ddl:"keyword" sql:"SESSION_IDLE_TIMEOUT_MINS"`
+	SessionUIIdleTimeoutMins *bool `ddl:"keyword" sql:"SESSION_UI_IDLE_TIMEOUT_MINS"`
+	Comment                  *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *SessionPolicyUnset) validate() error {
+	if everyValueNil(v.SessionIdleTimeoutMins, v.SessionUIIdleTimeoutMins, v.Comment) {
+		return errors.New("must unset at least one parameter")
+	}
+	return nil
+}
+
+func (v *sessionPolicies) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *AlterSessionPolicyOptions) error {
+	if opts == nil {
+		opts = &AlterSessionPolicyOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
 // DropSessionPolicyOptions contains options for dropping a session policy.
 type DropSessionPolicyOptions struct {
 	drop          bool                   `ddl:"static" sql:"DROP"`           //lint:ignore U1000 This is used in the ddl tag
@@ -111,7 +235,7 @@ func (v *sessionPolicies) Drop(ctx context.Context, id SchemaObjectIdentifier, o
 	}
 	opts.name = id
 	if err := opts.validate(); err != nil {
-		return err
+		return fmt.Errorf("validate drop options: %w", err)
 	}
 	sql, err := structToSQL(opts)
 	if err != nil {
@@ -121,18 +245,23 @@ func (v *sessionPolicies) Drop(ctx context.Context, id SchemaObjectIdentifier, o
 	return err
 }
 
-// sessionPolicyShowOptions contains options for listing session policies.
-type sessionPolicyShowOptions struct {
-	show            bool `ddl:"static" sql:"SHOW"`             //lint:ignore U1000 This is used in the ddl tag
-	sessionPolicies bool `ddl:"static" sql:"SESSION POLICIES"` //lint:ignore U1000 This is used in the ddl tag
+// SessionPolicyShowOptions contains options for listing session policies.
+type SessionPolicyShowOptions struct {
+	show            bool  `ddl:"static" sql:"SHOW"`             //lint:ignore U1000 This is used in the ddl tag
+	sessionPolicies bool  `ddl:"static" sql:"SESSION POLICIES"` //lint:ignore U1000 This is used in the ddl tag
+	Like            *Like `ddl:"keyword" sql:"LIKE"`
+	In              *In   `ddl:"keyword" sql:"IN"`
 }
 
-func (opts *sessionPolicyShowOptions) validate() error {
+func (opts *SessionPolicyShowOptions) validate() error {
 	return nil
 }
 
-func (v *sessionPolicies) Show(ctx context.Context) ([]*SessionPolicy, error) {
-	opts := &sessionPolicyShowOptions{}
+// Show lists all the session policies matching the options.
+func (v *sessionPolicies) Show(ctx context.Context, opts *SessionPolicyShowOptions) ([]*SessionPolicy, error) {
+	if opts == nil {
+		opts = &SessionPolicyShowOptions{}
+	}
 	if err := opts.validate(); err != nil {
 		return nil, err
 	}
@@ -140,20 +269,27 @@ func (v *sessionPolicies) Show(ctx context.Context) ([]*SessionPolicy, error) {
 	if err != nil {
 		return nil, err
 	}
-	var rows []*sessionPolicyRow
-	err = v.client.query(ctx, &rows, sql)
+	dest := []sessionPolicyDBRow{}
+	err = v.client.query(ctx, &dest, sql)
 	if err != nil {
 		return nil, err
 	}
-	sessionPolicies := make([]*SessionPolicy, 0, len(rows))
-	for _, row := range rows {
-		sessionPolicies = append(sessionPolicies, row.toSessionPolicy())
+	resultList := make([]*SessionPolicy, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toSessionPolicy()
 	}
-	return sessionPolicies, nil
+	return resultList, nil
 }
 
 func (v *sessionPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*SessionPolicy, error) {
-	sessionPolicies, err := v.Show(ctx)
+	sessionPolicies, err := v.Show(ctx, &SessionPolicyShowOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -165,8 +301,65 @@ func (v *sessionPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifie
 	return nil, ErrObjectNotExistOrAuthorized
 }
 
-type SessionPolicyDetails struct{}
+type describeSessionPolicyOptions struct {
+	describe      bool                   `ddl:"static" sql:"DESCRIBE"`       //lint:ignore U1000 This is used in the ddl tag
+	sessionPolicy bool                   `ddl:"static" sql:"SESSION POLICY"` //lint:ignore U1000 This is used in the ddl tag
+	name          SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeSessionPolicyOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+// SessionPolicyDetails is a user friendly result for a DESCRIBE SESSION POLICY query.
+type SessionPolicyDetails struct {
+	CreatedOn                string
+	Name                     string
+	SessionIdleTimeoutMins   int
+	SessionUIIdleTimeoutMins int
+	Comment                  string
+}
+
+type sessionPolicyDetailsRow struct {
+	CreatedOn                string `db:"created_on"`
+	Name                     string `db:"name"`
+	SessionIdleTimeoutMins   int    `db:"session_idle_timeout_mins"`
+	SessionUIIdleTimeoutMins int    `db:"session_ui_idle_timeout_mins"`
+	Comment                  string `db:"comment"`
+}
+
+func (row sessionPolicyDetailsRow) toSessionPolicyDetails() *SessionPolicyDetails {
+	return &SessionPolicyDetails{
+		CreatedOn:                row.CreatedOn,
+		Name:                     row.Name,
+		SessionIdleTimeoutMins:   row.SessionIdleTimeoutMins,
+		SessionUIIdleTimeoutMins: row.SessionUIIdleTimeoutMins,
+		Comment:                  row.Comment,
+	}
+}
 
 func (v *sessionPolicies) Describe(ctx context.Context, id SchemaObjectIdentifier) (*SessionPolicyDetails, error) {
-	return nil, nil
+	opts := &describeSessionPolicyOptions{
+		name: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := sessionPolicyDetailsRow{}
+	err = v.client.queryOne(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	return dest.toSessionPolicyDetails(), nil
+}
+
+func (v *sessionPolicies) ShowReferences(ctx context.Context, id SchemaObjectIdentifier) ([]*PolicyReference, error) {
+	return policyReferences(ctx, v.client, id)
 }
@@ -0,0 +1,168 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_SessionPoliciesShow(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+
+	sessionPolicyTest, sessionPolicyCleanup := createSessionPolicy(t, client, databaseTest, schemaTest)
+	t.Cleanup(sessionPolicyCleanup)
+	sessionPolicy2Test, sessionPolicy2Cleanup := createSessionPolicy(t, client, databaseTest, schemaTest)
+	t.Cleanup(sessionPolicy2Cleanup)
+
+	t.Run("in schema", func(t *testing.T) {
+		sessionPolicies, err := client.SessionPolicies.Show(ctx, &SessionPolicyShowOptions{
+			In: &In{
+				Schema: schemaTest.ID(),
+			},
+		})
+		require.NoError(t, err)
+		assert.Contains(t, sessionPolicies, sessionPolicyTest)
+		assert.Contains(t, sessionPolicies, sessionPolicy2Test)
+		assert.Equal(t, 2, len(sessionPolicies))
+	})
+
+	t.Run("with like", func(t *testing.T) {
+		sessionPolicies, err := client.SessionPolicies.Show(ctx, &SessionPolicyShowOptions{
+			Like: &Like{
+				Pattern: String(sessionPolicyTest.Name),
+			},
+			In: &In{
+				Database: databaseTest.ID(),
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, len(sessionPolicies))
+		assert.Contains(t, sessionPolicies, sessionPolicyTest)
+	})
+}
+
+func TestInt_SessionPolicyCreateAndDescribe(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+
+	id := NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, randomStringN(t, 12))
+	_, sessionPolicyCleanup := createSessionPolicyWithOptions(t, client, id, &CreateSessionPolicyOptions{
+		SessionIdleTimeoutMins:   Int(30),
+		SessionUIIdleTimeoutMins: Int(60),
+		Comment:                  String("test comment"),
+	})
+	t.Cleanup(sessionPolicyCleanup)
+
+	details, err := client.SessionPolicies.Describe(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, id.Name(), details.Name)
+	assert.Equal(t, 30, details.SessionIdleTimeoutMins)
+	assert.Equal(t, 60, details.SessionUIIdleTimeoutMins)
+	assert.Equal(t, "test comment", details.Comment)
+}
+
+func TestInt_SessionPolicyAlter(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+
+	t.Run("set and unset", func(t *testing.T) {
+		sessionPolicy, sessionPolicyCleanup := createSessionPolicy(t, client, databaseTest, schemaTest)
+		t.Cleanup(sessionPolicyCleanup)
+		id := sessionPolicy.ID()
+
+		err := client.SessionPolicies.Alter(ctx, id, &AlterSessionPolicyOptions{
+			Set: &SessionPolicySet{
+				SessionIdleTimeoutMins:   Int(10),
+				SessionUIIdleTimeoutMins: Int(20),
+				Comment:                  String("new comment"),
+			},
+		})
+		require.NoError(t, err)
+		details, err := client.SessionPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, 10, details.SessionIdleTimeoutMins)
+		assert.Equal(t, 20, details.SessionUIIdleTimeoutMins)
+		assert.Equal(t, "new comment", details.Comment)
+
+		err = client.SessionPolicies.Alter(ctx, id, &AlterSessionPolicyOptions{
+			Unset: &SessionPolicyUnset{
+				SessionIdleTimeoutMins:   Bool(true),
+				SessionUIIdleTimeoutMins: Bool(true),
+				Comment:                  Bool(true),
+			},
+		})
+		require.NoError(t, err)
+		details, err = client.SessionPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, 240, details.SessionIdleTimeoutMins)
+		assert.Equal(t, 240, details.SessionUIIdleTimeoutMins)
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		id := NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, randomStringN(t, 12))
+		err := client.SessionPolicies.Create(ctx, id, nil)
+		require.NoError(t, err)
+		newID := NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, randomStringN(t, 12))
+		err = client.SessionPolicies.Alter(ctx, id, &AlterSessionPolicyOptions{
+			NewName: newID,
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.SessionPolicies.Drop(ctx, newID, nil)
+			require.NoError(t, err)
+		})
+		_, err = client.SessionPolicies.ShowByID(ctx, newID)
+		require.NoError(t, err)
+		_, err = client.SessionPolicies.ShowByID(ctx, id)
+		assert.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+	})
+}
+
+func TestInt_SessionPolicyShowReferences(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+	sessionPolicy, sessionPolicyCleanup := createSessionPolicy(t, client, databaseTest, schemaTest)
+	t.Cleanup(sessionPolicyCleanup)
+	user, userCleanup := createUser(t, client)
+	t.Cleanup(userCleanup)
+
+	err := client.Users.Alter(ctx, user.ID(), &AlterUserOptions{
+		Set: &UserSet{
+			SessionPolicy: sessionPolicy.ID(),
+		},
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		err := client.Users.Alter(ctx, user.ID(), &AlterUserOptions{
+			Unset: &UserUnset{
+				SessionPolicy: Bool(true),
+			},
+		})
+		require.NoError(t, err)
+	})
+
+	references, err := client.SessionPolicies.ShowReferences(ctx, sessionPolicy.ID())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(references))
+	assert.Equal(t, PolicyEntityDomainUser, references[0].RefEntityDomain)
+	assert.Equal(t, user.Name, references[0].RefEntityName)
+}
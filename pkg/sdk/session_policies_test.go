@@ -0,0 +1,168 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionPolicyCreate(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("only name", func(t *testing.T) {
+		opts := &CreateSessionPolicyOptions{
+			name: id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("CREATE SESSION POLICY %s", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &CreateSessionPolicyOptions{
+			OrReplace:                Bool(true),
+			name:                     id,
+			SessionIdleTimeoutMins:   Int(30),
+			SessionUIIdleTimeoutMins: Int(60),
+			Comment:                  String("test comment"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE OR REPLACE SESSION POLICY %s SESSION_IDLE_TIMEOUT_MINS = 30 SESSION_UI_IDLE_TIMEOUT_MINS = 60 COMMENT = 'test comment'`, id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: timeout out of range", func(t *testing.T) {
+		opts := &CreateSessionPolicyOptions{
+			name:                   id,
+			SessionIdleTimeoutMins: Int(1),
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: or replace and if not exists", func(t *testing.T) {
+		opts := &CreateSessionPolicyOptions{
+			name:        id,
+			OrReplace:   Bool(true),
+			IfNotExists: Bool(true),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestSessionPolicyAlter(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("with set", func(t *testing.T) {
+		opts := &AlterSessionPolicyOptions{
+			name: id,
+			Set: &SessionPolicySet{
+				SessionIdleTimeoutMins:   Int(15),
+				SessionUIIdleTimeoutMins: Int(20),
+				Comment:                  String("new comment"),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER SESSION POLICY %s SET SESSION_IDLE_TIMEOUT_MINS = 15 SESSION_UI_IDLE_TIMEOUT_MINS = 20 COMMENT = 'new comment'", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with unset", func(t *testing.T) {
+		opts := &AlterSessionPolicyOptions{
+			name: id,
+			Unset: &SessionPolicyUnset{
+				SessionIdleTimeoutMins: Bool(true),
+				Comment:                Bool(true),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER SESSION POLICY %s UNSET SESSION_IDLE_TIMEOUT_MINS, COMMENT", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		newID := NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), randomUUID(t))
+		opts := &AlterSessionPolicyOptions{
+			name:    id,
+			NewName: newID,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER SESSION POLICY %s RENAME TO %s", id.FullyQualifiedName(), newID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: no action", func(t *testing.T) {
+		opts := &AlterSessionPolicyOptions{
+			name: id,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: set and unset", func(t *testing.T) {
+		opts := &AlterSessionPolicyOptions{
+			name:  id,
+			Set:   &SessionPolicySet{Comment: String("comment")},
+			Unset: &SessionPolicyUnset{SessionIdleTimeoutMins: Bool(true)},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestSessionPolicyDrop(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("with if exists", func(t *testing.T) {
+		opts := &DropSessionPolicyOptions{
+			name:     id,
+			IfExists: Bool(true),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("DROP SESSION POLICY IF EXISTS %s", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestSessionPolicyShow(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("empty options", func(t *testing.T) {
+		opts := &SessionPolicyShowOptions{}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		assert.Equal(t, "SHOW SESSION POLICIES", actual)
+	})
+
+	t.Run("with like and in schema", func(t *testing.T) {
+		opts := &SessionPolicyShowOptions{
+			Like: &Like{
+				Pattern: String(id.Name()),
+			},
+			In: &In{
+				Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("SHOW SESSION POLICIES LIKE '%s' IN SCHEMA %s", id.Name(), NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()).FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestSessionPolicyDescribe(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	opts := &describeSessionPolicyOptions{
+		name: id,
+	}
+	actual, err := structToSQL(opts)
+	require.NoError(t, err)
+	expected := fmt.Sprintf("DESCRIBE SESSION POLICY %s", id.FullyQualifiedName())
+	assert.Equal(t, expected, actual)
+}
@@ -3,6 +3,7 @@ package sdk
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -170,3 +171,26 @@ func TestInt_UseSchema(t *testing.T) {
 	expected := schemaTest.Name
 	assert.Equal(t, expected, actual)
 }
+
+func TestInt_StatementOptions(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	t.Run("query tag applies to tagged statements only", func(t *testing.T) {
+		tagged := WithQueryTag(ctx, "terraform:snowflake_database.db")
+		parameter, err := client.Sessions.ShowSessionParameter(tagged, SessionParameterQueryTag)
+		require.NoError(t, err)
+		assert.Equal(t, "terraform:snowflake_database.db", parameter.Value)
+
+		parameter, err = client.Sessions.ShowSessionParameter(ctx, SessionParameterQueryTag)
+		require.NoError(t, err)
+		assert.NotEqual(t, "terraform:snowflake_database.db", parameter.Value)
+	})
+
+	t.Run("statement timeout", func(t *testing.T) {
+		timed := WithStatementTimeout(ctx, 90*time.Second)
+		parameter, err := client.Sessions.ShowSessionParameter(timed, SessionParameterStatementTimeoutInSeconds)
+		require.NoError(t, err)
+		assert.Equal(t, "90", parameter.Value)
+	})
+}
@@ -245,6 +245,10 @@ func (b sqlBuilder) parseStruct(s interface{}) ([]sqlClause, error) {
 	for i := 0; i < t.NumField(); i++ {
 		field := t.Field(i)
 		value := v.Field(i)
+		// Skip nil interfaces, e.g. an ObjectIdentifier that was not set
+		if value.Kind() == reflect.Interface && value.IsNil() {
+			continue
+		}
 		// Derefence pointers as long as they are not nil
 		if value.Kind() == reflect.Ptr {
 			if value.IsNil() {
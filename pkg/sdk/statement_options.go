@@ -0,0 +1,287 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/snowflakedb/gosnowflake"
+)
+
+// StatementOptions override session settings for the statements run with a context, e.g. to attribute them to
+// a resource in QUERY_HISTORY. The driver only supports MULTI_STATEMENT_COUNT as a per-statement parameter, so
+// the statements run on a connection of their own, whose session settings are restored afterwards. This costs two
+// additional requests per statement, plus reading the session defaults once per client. The resources of the
+// provider only tag the statements running on a warehouse, the statement timeout and the warehouse are left to
+// other users of the SDK.
+type StatementOptions struct {
+	QueryTag         *string
+	StatementTimeout *time.Duration
+	Warehouse        *AccountObjectIdentifier
+}
+
+type statementOptionsContext string
+
+const statementOptionsContextKey statementOptionsContext = "snowflake_statement_options"
+
+func statementOptionsFromContext(ctx context.Context) *StatementOptions {
+	opts, _ := ctx.Value(statementOptionsContextKey).(*StatementOptions)
+	return opts
+}
+
+func withStatementOptions(ctx context.Context, f func(opts *StatementOptions)) context.Context {
+	opts := &StatementOptions{}
+	if existing := statementOptionsFromContext(ctx); existing != nil {
+		*opts = *existing
+	}
+	f(opts)
+	return context.WithValue(ctx, statementOptionsContextKey, opts)
+}
+
+// WithQueryTag sets QUERY_TAG for the statements run with the returned context, e.g. terraform:<resource address>.
+func WithQueryTag(ctx context.Context, tag string) context.Context {
+	return withStatementOptions(ctx, func(opts *StatementOptions) {
+		opts.QueryTag = String(tag)
+	})
+}
+
+// WithStatementTimeout sets STATEMENT_TIMEOUT_IN_SECONDS for the statements run with the returned context. The timeout
+// is rounded up to whole seconds.
+func WithStatementTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return withStatementOptions(ctx, func(opts *StatementOptions) {
+		opts.StatementTimeout = &timeout
+	})
+}
+
+// WithWarehouse runs the statements run with the returned context on the given warehouse.
+func WithWarehouse(ctx context.Context, warehouse AccountObjectIdentifier) context.Context {
+	return withStatementOptions(ctx, func(opts *StatementOptions) {
+		opts.Warehouse = &warehouse
+	})
+}
+
+// dbExecutor is implemented by both the connection pool and a single connection.
+type dbExecutor interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	QueryxContext(ctx context.Context, query string, args ...interface{}) (*sqlx.Rows, error)
+}
+
+// run runs the statement with the retry policy, on a connection with the statement options of ctx if there are any.
+func (c *Client) run(ctx context.Context, sql string, f func(db dbExecutor) error) error {
+	return c.useConnection(ctx, func(db dbExecutor) error {
+		return c.retry(ctx, sql, func() error {
+			return f(db)
+		})
+	})
+}
+
+// useConnection calls f with the connection pool, or with a connection of its own if ctx has statement options.
+func (c *Client) useConnection(ctx context.Context, f func(db dbExecutor) error) error {
+	if statementOptionsFromContext(ctx) == nil {
+		return f(c.db)
+	}
+	return c.useDedicatedConnection(ctx, f)
+}
+
+// useDedicatedConnection calls f with a connection of its own, e.g. to run statements depending on the session of
+// previous ones, with the statement options of ctx if there are any.
+func (c *Client) useDedicatedConnection(ctx context.Context, f func(db dbExecutor) error) error {
+	conn, err := c.db.Connx(ctx)
+	if err != nil {
+		return decodeDriverError(err)
+	}
+	defer conn.Close()
+	opts := statementOptionsFromContext(ctx)
+	if opts == nil {
+		return f(conn)
+	}
+	restore, err := opts.apply(ctx, conn, &c.sessionDefaults)
+	if err != nil {
+		return err
+	}
+	err = f(conn)
+	if restoreErr := restore(); restoreErr != nil {
+		log.Printf("[DEBUG] discarding connection, could not restore session err = %v\n", restoreErr)
+		discardConnection(conn)
+	}
+	return err
+}
+
+// discardConnection closes the connection instead of returning it to the pool, e.g. when its session settings
+// could not be restored.
+func discardConnection(conn *sqlx.Conn) {
+	_ = conn.Raw(func(interface{}) error {
+		return driver.ErrBadConn
+	})
+}
+
+// sessionDefaults are the session settings of the connections of the pool, which are restored after running
+// statements with statement options. As connections only return to the pool with these settings, they are read
+// once per client instead of before every statement.
+type sessionDefaults struct {
+	mu               sync.Mutex
+	parametersLoaded bool
+	queryTag         *string
+	statementTimeout *int
+	warehouseLoaded  bool
+	warehouse        *AccountObjectIdentifier
+}
+
+func (d *sessionDefaults) loadParameters(ctx context.Context, conn *sqlx.Conn) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.parametersLoaded {
+		return nil
+	}
+	rows := []parameterRow{}
+	if err := conn.SelectContext(ctx, &rows, "SHOW PARAMETERS IN SESSION"); err != nil {
+		return decodeDriverError(err)
+	}
+	for _, row := range rows {
+		p := row.toParameter()
+		if p.Level != ParameterTypeSession {
+			continue
+		}
+		switch p.Key {
+		case string(SessionParameterQueryTag):
+			d.queryTag = String(p.Value)
+		case string(SessionParameterStatementTimeoutInSeconds):
+			var timeout int
+			if _, err := fmt.Sscan(p.Value, &timeout); err == nil {
+				d.statementTimeout = Int(timeout)
+			}
+		}
+	}
+	d.parametersLoaded = true
+	return nil
+}
+
+func (d *sessionDefaults) loadWarehouse(ctx context.Context, conn *sqlx.Conn) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.warehouseLoaded {
+		return nil
+	}
+	current := &struct {
+		CurrentWarehouse sql.NullString `db:"CURRENT_WAREHOUSE"`
+	}{}
+	if err := conn.GetContext(ctx, current, "SELECT CURRENT_WAREHOUSE() as CURRENT_WAREHOUSE"); err != nil {
+		return decodeDriverError(err)
+	}
+	if current.CurrentWarehouse.Valid {
+		warehouse := NewAccountObjectIdentifier(current.CurrentWarehouse.String)
+		d.warehouse = &warehouse
+	}
+	d.warehouseLoaded = true
+	return nil
+}
+
+// apply overrides the session settings of the connection. The returned function restores the session defaults.
+// The overrides and the restore are each sent in a single request.
+func (opts *StatementOptions) apply(ctx context.Context, conn *sqlx.Conn, defaults *sessionDefaults) (func() error, error) {
+	set := &SessionParameters{}
+	restoreSet := &SessionParameters{}
+	restoreUnset := &SessionParametersUnset{}
+	if opts.QueryTag != nil || opts.StatementTimeout != nil {
+		if err := defaults.loadParameters(ctx, conn); err != nil {
+			return nil, err
+		}
+		if opts.QueryTag != nil {
+			set.QueryTag = opts.QueryTag
+			if defaults.queryTag != nil {
+				restoreSet.QueryTag = defaults.queryTag
+			} else {
+				restoreUnset.QueryTag = Bool(true)
+			}
+		}
+		if opts.StatementTimeout != nil {
+			set.StatementTimeoutInSeconds = Int(int(math.Ceil(opts.StatementTimeout.Seconds())))
+			if defaults.statementTimeout != nil {
+				restoreSet.StatementTimeoutInSeconds = defaults.statementTimeout
+			} else {
+				restoreUnset.StatementTimeoutInSeconds = Bool(true)
+			}
+		}
+	}
+
+	var statements, restoreStatements []string
+	if set.QueryTag != nil || set.StatementTimeoutInSeconds != nil {
+		alterOptions := &AlterSessionOptions{Set: &SessionSet{SessionParameters: set}}
+		if err := alterOptions.validate(); err != nil {
+			return nil, err
+		}
+		stmt, err := structToSQL(alterOptions)
+		if err != nil {
+			return nil, err
+		}
+		statements = append(statements, stmt)
+	}
+	if restoreSet.QueryTag != nil || restoreSet.StatementTimeoutInSeconds != nil {
+		stmt, err := structToSQL(&AlterSessionOptions{Set: &SessionSet{SessionParameters: restoreSet}})
+		if err != nil {
+			return nil, err
+		}
+		restoreStatements = append(restoreStatements, stmt)
+	}
+	if restoreUnset.QueryTag != nil || restoreUnset.StatementTimeoutInSeconds != nil {
+		stmt, err := structToSQL(&AlterSessionOptions{Unset: &SessionUnset{SessionParametersUnset: restoreUnset}})
+		if err != nil {
+			return nil, err
+		}
+		restoreStatements = append(restoreStatements, stmt)
+	}
+	var restoreErr error
+	if opts.Warehouse != nil {
+		if err := defaults.loadWarehouse(ctx, conn); err != nil {
+			return nil, err
+		}
+		statements = append(statements, fmt.Sprintf("USE WAREHOUSE %s", opts.Warehouse.FullyQualifiedName()))
+		if defaults.warehouse != nil {
+			restoreStatements = append(restoreStatements, fmt.Sprintf("USE WAREHOUSE %s", defaults.warehouse.FullyQualifiedName()))
+		} else {
+			// a session cannot go back to having no warehouse
+			restoreErr = errors.New("the session had no warehouse")
+		}
+	}
+
+	exec := func(statements []string) error {
+		if len(statements) == 0 {
+			return nil
+		}
+		execCtx := ctx
+		if len(statements) > 1 {
+			var err error
+			if execCtx, err = gosnowflake.WithMultiStatement(ctx, len(statements)); err != nil {
+				return err
+			}
+		}
+		if _, err := conn.ExecContext(execCtx, strings.Join(statements, "; ")); err != nil {
+			return decodeDriverError(err)
+		}
+		return nil
+	}
+	restore := func() error {
+		if err := exec(restoreStatements); err != nil {
+			return err
+		}
+		return restoreErr
+	}
+	if err := exec(statements); err != nil {
+		// the connection may have been changed partially
+		if err := restore(); err != nil {
+			discardConnection(conn)
+		}
+		return nil, err
+	}
+	return restore, nil
+}
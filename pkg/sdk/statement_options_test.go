@@ -0,0 +1,126 @@
+package sdk
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func expectSessionParameters(mock sqlmock.Sqlmock, parameters map[string]string) {
+	rows := sqlmock.NewRows([]string{"key", "value", "default", "level", "description"})
+	for key, value := range parameters {
+		rows.AddRow(key, value, "", "SESSION", "")
+	}
+	rows.AddRow("TIMEZONE", "America/Los_Angeles", "America/Los_Angeles", "", "")
+	mock.ExpectQuery(`^SHOW PARAMETERS IN SESSION$`).WillReturnRows(rows)
+}
+
+func expectExec(mock sqlmock.Sqlmock, sql string) {
+	mock.ExpectExec("^" + regexp.QuoteMeta(sql) + "$").WillReturnResult(sqlmock.NewResult(0, 0))
+}
+
+func TestStatementOptions(t *testing.T) {
+	t.Run("options are combined", func(t *testing.T) {
+		ctx := WithQueryTag(context.Background(), "terraform:snowflake_database.db")
+		ctx = WithStatementTimeout(ctx, 90*time.Second)
+		opts := statementOptionsFromContext(ctx)
+		require.NotNil(t, opts)
+		assert.Equal(t, "terraform:snowflake_database.db", *opts.QueryTag)
+		assert.Equal(t, 90*time.Second, *opts.StatementTimeout)
+		assert.Nil(t, opts.Warehouse)
+		// the parent context is not changed
+		assert.Nil(t, statementOptionsFromContext(context.Background()))
+	})
+
+	t.Run("query tag is restored", func(t *testing.T) {
+		client, mock := mockClient(t)
+		expectSessionParameters(mock, map[string]string{"QUERY_TAG": "provider"})
+		expectExec(mock, `ALTER SESSION SET QUERY_TAG = 'terraform:snowflake_database.db'`)
+		expectExec(mock, `DROP DATABASE "DB"`)
+		expectExec(mock, `ALTER SESSION SET QUERY_TAG = 'provider'`)
+
+		ctx := WithQueryTag(context.Background(), "terraform:snowflake_database.db")
+		_, err := client.exec(ctx, `DROP DATABASE "DB"`)
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("statement timeout is unset", func(t *testing.T) {
+		client, mock := mockClient(t)
+		expectSessionParameters(mock, nil)
+		expectExec(mock, `ALTER SESSION SET STATEMENT_TIMEOUT_IN_SECONDS = 2`)
+		expectExec(mock, `CREATE DATABASE "DB" CLONE "SRC"`)
+		expectExec(mock, `ALTER SESSION UNSET STATEMENT_TIMEOUT_IN_SECONDS`)
+
+		ctx := WithStatementTimeout(context.Background(), 1500*time.Millisecond)
+		_, err := client.exec(ctx, `CREATE DATABASE "DB" CLONE "SRC"`)
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("warehouse is restored", func(t *testing.T) {
+		client, mock := mockClient(t)
+		mock.ExpectQuery(`^SELECT CURRENT_WAREHOUSE\(\) as CURRENT_WAREHOUSE$`).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_WAREHOUSE"}).AddRow("DEFAULT_WH"))
+		expectExec(mock, `USE WAREHOUSE "LARGE_WH"`)
+		mock.ExpectQuery(`^SELECT 1 AS ONE$`).WillReturnRows(sqlmock.NewRows([]string{"ONE"}).AddRow(1))
+		expectExec(mock, `USE WAREHOUSE "DEFAULT_WH"`)
+
+		ctx := WithWarehouse(context.Background(), NewAccountObjectIdentifier("LARGE_WH"))
+		dest := &struct {
+			One int `db:"ONE"`
+		}{}
+		require.NoError(t, client.queryOne(ctx, dest, "SELECT 1 AS ONE"))
+		assert.Equal(t, 1, dest.One)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("session defaults are read once", func(t *testing.T) {
+		client, mock := mockClient(t)
+		expectSessionParameters(mock, map[string]string{"QUERY_TAG": "provider"})
+		for _, name := range []string{"A", "B"} {
+			expectExec(mock, `ALTER SESSION SET QUERY_TAG = 'terraform:snowflake_database.db'`)
+			expectExec(mock, `DROP DATABASE "`+name+`"`)
+			expectExec(mock, `ALTER SESSION SET QUERY_TAG = 'provider'`)
+		}
+
+		ctx := WithQueryTag(context.Background(), "terraform:snowflake_database.db")
+		_, err := client.exec(ctx, `DROP DATABASE "A"`)
+		require.NoError(t, err)
+		_, err = client.exec(ctx, `DROP DATABASE "B"`)
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("options are set in one request", func(t *testing.T) {
+		client, mock := mockClient(t)
+		expectSessionParameters(mock, nil)
+		mock.ExpectQuery(`^SELECT CURRENT_WAREHOUSE\(\) as CURRENT_WAREHOUSE$`).WillReturnRows(sqlmock.NewRows([]string{"CURRENT_WAREHOUSE"}).AddRow("DEFAULT_WH"))
+		expectExec(mock, `ALTER SESSION SET QUERY_TAG = 'terraform:snowflake_database.db'; USE WAREHOUSE "LARGE_WH"`)
+		expectExec(mock, `DROP DATABASE "DB"`)
+		expectExec(mock, `ALTER SESSION UNSET QUERY_TAG; USE WAREHOUSE "DEFAULT_WH"`)
+
+		ctx := WithQueryTag(context.Background(), "terraform:snowflake_database.db")
+		ctx = WithWarehouse(ctx, NewAccountObjectIdentifier("LARGE_WH"))
+		_, err := client.exec(ctx, `DROP DATABASE "DB"`)
+		require.NoError(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("invalid query tag", func(t *testing.T) {
+		client, mock := mockClient(t)
+		expectSessionParameters(mock, nil)
+
+		tag := make([]byte, 2001)
+		for i := range tag {
+			tag[i] = 'a'
+		}
+		_, err := client.exec(WithQueryTag(context.Background(), string(tag)), `DROP DATABASE "DB"`)
+		require.Error(t, err)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -2,6 +2,7 @@ package sdk
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 )
 
@@ -17,12 +18,15 @@ type systemFunctions struct {
 
 func (c *systemFunctions) GetTag(ctx context.Context, tagID ObjectIdentifier, objectID ObjectIdentifier, objectType ObjectType) (string, error) {
 	s := &struct {
-		Tag string `db:"TAG"`
+		Tag sql.NullString `db:"TAG"`
 	}{}
-	sql := fmt.Sprintf(`SELECT SYSTEM$GET_TAG('%s', '%s', '%v') AS "TAG"`, tagID.FullyQualifiedName(), objectID.FullyQualifiedName(), objectType)
-	err := c.client.queryOne(ctx, s, sql)
+	stmt := fmt.Sprintf(`SELECT SYSTEM$GET_TAG('%s', '%s', '%v') AS "TAG"`, tagID.FullyQualifiedName(), objectID.FullyQualifiedName(), objectType)
+	err := c.client.queryOne(ctx, s, stmt)
 	if err != nil {
 		return "", err
 	}
-	return s.Tag, nil
+	if !s.Tag.Valid {
+		return "", ErrTagNotSet
+	}
+	return s.Tag.String, nil
 }
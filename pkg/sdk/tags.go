@@ -1,12 +1,51 @@
 package sdk
 
-// placeholder for the real implementation.
-type TagCreateOptions struct{}
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+)
 
+// Compile-time proof of interface implementation.
+var _ Tags = (*tags)(nil)
+
+// Tags describes all the tag related methods that the Snowflake API supports.
+type Tags interface {
+	// Create creates a new tag.
+	Create(ctx context.Context, id SchemaObjectIdentifier, opts *TagCreateOptions) error
+	// Alter modifies an existing tag.
+	Alter(ctx context.Context, id SchemaObjectIdentifier, opts *TagAlterOptions) error
+	// Drop removes a tag.
+	Drop(ctx context.Context, id SchemaObjectIdentifier, opts *TagDropOptions) error
+	// Undrop restores the most recent version of a dropped tag.
+	Undrop(ctx context.Context, id SchemaObjectIdentifier) error
+	// Show returns a list of tags.
+	Show(ctx context.Context, opts *TagShowOptions) ([]*Tag, error)
+	// ShowByID returns a tag by ID.
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Tag, error)
+	// Set sets tags on any object.
+	Set(ctx context.Context, opts *SetTagOptions) error
+	// Unset unsets tags from any object.
+	Unset(ctx context.Context, opts *UnsetTagOptions) error
+}
+
+// tags implements Tags.
+type tags struct {
+	client *Client
+}
+
+// Tag is a user friendly result for a SHOW TAGS query.
 type Tag struct {
-	DatabaseName string
-	SchemaName   string
-	Name         string
+	CreatedOn     time.Time
+	Name          string
+	DatabaseName  string
+	SchemaName    string
+	Owner         string
+	Comment       string
+	AllowedValues []string
+	OwnerRoleType string
 }
 
 func (v *Tag) ID() SchemaObjectIdentifier {
@@ -16,3 +55,396 @@ func (v *Tag) ID() SchemaObjectIdentifier {
 func (v *Tag) ObjectType() ObjectType {
 	return ObjectTypeTag
 }
+
+// tagDBRow is used to decode the result of a SHOW TAGS query.
+type tagDBRow struct {
+	CreatedOn     time.Time      `db:"created_on"`
+	Name          string         `db:"name"`
+	DatabaseName  string         `db:"database_name"`
+	SchemaName    string         `db:"schema_name"`
+	Owner         string         `db:"owner"`
+	Comment       string         `db:"comment"`
+	AllowedValues sql.NullString `db:"allowed_values"`
+	OwnerRoleType string         `db:"owner_role_type"`
+}
+
+func (row tagDBRow) toTag() *Tag {
+	tag := &Tag{
+		CreatedOn:     row.CreatedOn,
+		Name:          row.Name,
+		DatabaseName:  row.DatabaseName,
+		SchemaName:    row.SchemaName,
+		Owner:         row.Owner,
+		Comment:       row.Comment,
+		OwnerRoleType: row.OwnerRoleType,
+	}
+	// allowed values are returned as a JSON array, e.g. ["a","b"]
+	if row.AllowedValues.Valid && row.AllowedValues.String != "" {
+		var values []string
+		if err := json.Unmarshal([]byte(row.AllowedValues.String), &values); err == nil {
+			tag.AllowedValues = values
+		}
+	}
+	return tag
+}
+
+type AllowedValue struct {
+	Value string `ddl:"keyword,single_quotes"`
+}
+
+// TagCreateOptions contains options for creating a tag.
+type TagCreateOptions struct {
+	create        bool                   `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace     *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	tag           bool                   `ddl:"static" sql:"TAG"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists   *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name          SchemaObjectIdentifier `ddl:"identifier"`
+	AllowedValues []AllowedValue         `ddl:"keyword" sql:"ALLOWED_VALUES"`
+	Comment       *string                `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (opts *TagCreateOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) && *opts.OrReplace && *opts.IfNotExists {
+		return errors.New("OR REPLACE and IF NOT EXISTS are incompatible")
+	}
+	if len(opts.AllowedValues) > 300 {
+		return errors.New("a tag can have at most 300 allowed values")
+	}
+	return nil
+}
+
+func (v *tags) Create(ctx context.Context, id SchemaObjectIdentifier, opts *TagCreateOptions) error {
+	if opts == nil {
+		opts = &TagCreateOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// TagAlterOptions contains options for altering a tag.
+type TagAlterOptions struct {
+	alter    bool                   `ddl:"static" sql:"ALTER"` //lint:ignore U1000 This is used in the ddl tag
+	tag      bool                   `ddl:"static" sql:"TAG"`   //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+	NewName  SchemaObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	Add      *TagAdd                `ddl:"keyword" sql:"ADD"`
+	Drop     *TagDrop               `ddl:"keyword" sql:"DROP"`
+	Set      *TagSet                `ddl:"keyword" sql:"SET"`
+	Unset    *TagUnset              `ddl:"keyword" sql:"UNSET"`
+}
+
+func (opts *TagAlterOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if !exactlyOneValueSet(opts.NewName, opts.Add, opts.Drop, opts.Set, opts.Unset) {
+		return errors.New("exactly one of NewName, Add, Drop, Set or Unset must be set")
+	}
+	if valueSet(opts.Add) && len(opts.Add.AllowedValues) == 0 {
+		return errors.New("at least one allowed value must be added")
+	}
+	if valueSet(opts.Drop) && len(opts.Drop.AllowedValues) == 0 {
+		return errors.New("at least one allowed value must be dropped")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type TagAdd struct {
+	AllowedValues []AllowedValue `ddl:"keyword" sql:"ALLOWED_VALUES"`
+}
+
+type TagDrop struct {
+	AllowedValues []AllowedValue `ddl:"keyword" sql:"ALLOWED_VALUES"`
+}
+
+type TagMaskingPolicy struct {
+	Name SchemaObjectIdentifier `ddl:"identifier" sql:"MASKING POLICY"`
+}
+
+type TagSet struct {
+	MaskingPolicies []TagMaskingPolicy `ddl:"list,no_parentheses"`
+	Force           *bool              `ddl:"keyword" sql:"FORCE"`
+	Comment         *string            `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (v *TagSet) validate() error {
+	if !exactlyOneValueSet(v.MaskingPolicies, v.Comment) {
+		return errors.New("exactly one of MaskingPolicies or Comment must be set")
+	}
+	if valueSet(v.Force) && !valueSet(v.MaskingPolicies) {
+		return errors.New("FORCE can only be used when setting masking policies")
+	}
+	return nil
+}
+
+type TagUnset struct {
+	MaskingPolicies []TagMaskingPolicy `ddl:"list,no_parentheses"`
+	AllowedValues   *bool              `ddl:"keyword" sql:"ALLOWED_VALUES"`
+	Comment         *bool              `ddl:"keyword" sql:"COMMENT"`
+}
+
+func (v *TagUnset) validate() error {
+	if !exactlyOneValueSet(v.MaskingPolicies, v.AllowedValues, v.Comment) {
+		return errors.New("exactly one of MaskingPolicies, AllowedValues or Comment must be unset")
+	}
+	return nil
+}
+
+func (v *tags) Alter(ctx context.Context, id SchemaObjectIdentifier, opts *TagAlterOptions) error {
+	if opts == nil {
+		opts = &TagAlterOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// TagDropOptions contains options for dropping a tag.
+type TagDropOptions struct {
+	drop     bool                   `ddl:"static" sql:"DROP"` //lint:ignore U1000 This is used in the ddl tag
+	tag      bool                   `ddl:"static" sql:"TAG"`  //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *TagDropOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *tags) Drop(ctx context.Context, id SchemaObjectIdentifier, opts *TagDropOptions) error {
+	if opts == nil {
+		opts = &TagDropOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+type undropTagOptions struct {
+	undrop bool                   `ddl:"static" sql:"UNDROP"` //lint:ignore U1000 This is used in the ddl tag
+	tag    bool                   `ddl:"static" sql:"TAG"`    //lint:ignore U1000 This is used in the ddl tag
+	name   SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *undropTagOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
+
+func (v *tags) Undrop(ctx context.Context, id SchemaObjectIdentifier) error {
+	opts := &undropTagOptions{
+		name: id,
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// TagShowOptions contains options for listing tags.
+type TagShowOptions struct {
+	show bool  `ddl:"static" sql:"SHOW"` //lint:ignore U1000 This is used in the ddl tag
+	tags bool  `ddl:"static" sql:"TAGS"` //lint:ignore U1000 This is used in the ddl tag
+	Like *Like `ddl:"keyword" sql:"LIKE"`
+	In   *In   `ddl:"keyword" sql:"IN"`
+}
+
+func (opts *TagShowOptions) validate() error {
+	if valueSet(opts.In) && !exactlyOneValueSet(opts.In.Account, opts.In.Database, opts.In.Schema) {
+		return errors.New("exactly one of Account, Database or Schema must be set in IN")
+	}
+	return nil
+}
+
+func (v *tags) Show(ctx context.Context, opts *TagShowOptions) ([]*Tag, error) {
+	if opts == nil {
+		opts = &TagShowOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []tagDBRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*Tag, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toTag()
+	}
+	return resultList, nil
+}
+
+func (v *tags) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Tag, error) {
+	tags, err := v.Show(ctx, &TagShowOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+		In: &In{
+			Schema: NewSchemaIdentifier(id.DatabaseName(), id.SchemaName()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range tags {
+		if tag.Name == id.Name() {
+			return tag, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
+}
+
+// SetTagOptions contains options for setting tags on an object, e.g.
+// ALTER <object_type> <object_name> SET TAG <tag_name> = '<tag_value>'. For
+// columns, ObjectName must be a TableColumnIdentifier.
+type SetTagOptions struct {
+	alter      bool             `ddl:"static" sql:"ALTER"` //lint:ignore U1000 This is used in the ddl tag
+	ObjectType ObjectType       `ddl:"keyword"`
+	IfExists   *bool            `ddl:"keyword" sql:"IF EXISTS"`
+	ObjectName ObjectIdentifier `ddl:"identifier"`
+	column     *string          `ddl:"parameter,no_equals,double_quotes" sql:"MODIFY COLUMN"`
+	SetTags    []TagAssociation `ddl:"keyword" sql:"SET TAG"`
+}
+
+func (opts *SetTagOptions) validate() error {
+	if opts.ObjectType == "" {
+		return errors.New("ObjectType must be set")
+	}
+	if opts.ObjectType != ObjectTypeAccount && (opts.ObjectName == nil || !validObjectidentifier(opts.ObjectName)) {
+		return ErrInvalidObjectIdentifier
+	}
+	if len(opts.SetTags) == 0 {
+		return errors.New("at least one tag must be set")
+	}
+	return nil
+}
+
+func (v *tags) Set(ctx context.Context, opts *SetTagOptions) error {
+	if opts == nil {
+		opts = &SetTagOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	if opts.ObjectType == ObjectTypeColumn {
+		objectType, objectName, column, err := columnToTable(opts.ObjectName)
+		if err != nil {
+			return err
+		}
+		opts.ObjectType, opts.ObjectName, opts.column = objectType, objectName, column
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// UnsetTagOptions contains options for unsetting tags from an object, e.g.
+// ALTER <object_type> <object_name> UNSET TAG <tag_name>. For columns,
+// ObjectName must be a TableColumnIdentifier.
+type UnsetTagOptions struct {
+	alter      bool               `ddl:"static" sql:"ALTER"` //lint:ignore U1000 This is used in the ddl tag
+	ObjectType ObjectType         `ddl:"keyword"`
+	IfExists   *bool              `ddl:"keyword" sql:"IF EXISTS"`
+	ObjectName ObjectIdentifier   `ddl:"identifier"`
+	column     *string            `ddl:"parameter,no_equals,double_quotes" sql:"MODIFY COLUMN"`
+	UnsetTags  []ObjectIdentifier `ddl:"keyword" sql:"UNSET TAG"`
+}
+
+func (opts *UnsetTagOptions) validate() error {
+	if opts.ObjectType == "" {
+		return errors.New("ObjectType must be set")
+	}
+	if opts.ObjectType != ObjectTypeAccount && (opts.ObjectName == nil || !validObjectidentifier(opts.ObjectName)) {
+		return ErrInvalidObjectIdentifier
+	}
+	if len(opts.UnsetTags) == 0 {
+		return errors.New("at least one tag must be unset")
+	}
+	return nil
+}
+
+func (v *tags) Unset(ctx context.Context, opts *UnsetTagOptions) error {
+	if opts == nil {
+		opts = &UnsetTagOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	if opts.ObjectType == ObjectTypeColumn {
+		objectType, objectName, column, err := columnToTable(opts.ObjectName)
+		if err != nil {
+			return err
+		}
+		opts.ObjectType, opts.ObjectName, opts.column = objectType, objectName, column
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
+// columnToTable converts a column identifier into the ALTER TABLE ... MODIFY COLUMN form
+// that Snowflake expects when tagging columns.
+func columnToTable(id ObjectIdentifier) (ObjectType, ObjectIdentifier, *string, error) {
+	column, ok := id.(TableColumnIdentifier)
+	if !ok {
+		return "", nil, nil, errors.New("ObjectName must be a TableColumnIdentifier for columns")
+	}
+	return ObjectTypeTable, NewSchemaObjectIdentifier(column.databaseName, column.schemaName, column.tableName), String(column.columnName), nil
+}
@@ -0,0 +1,228 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_TagsShow(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+
+	tagTest, tagCleanup := createTag(t, client, databaseTest, schemaTest)
+	t.Cleanup(tagCleanup)
+	tag2Test, tag2Cleanup := createTag(t, client, databaseTest, schemaTest)
+	t.Cleanup(tag2Cleanup)
+
+	t.Run("in schema", func(t *testing.T) {
+		tags, err := client.Tags.Show(ctx, &TagShowOptions{
+			In: &In{
+				Schema: schemaTest.ID(),
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, len(tags))
+		assert.Contains(t, tags, tagTest)
+		assert.Contains(t, tags, tag2Test)
+	})
+
+	t.Run("with like in database", func(t *testing.T) {
+		tags, err := client.Tags.Show(ctx, &TagShowOptions{
+			Like: &Like{
+				Pattern: String(tagTest.Name),
+			},
+			In: &In{
+				Database: databaseTest.ID(),
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, len(tags))
+		assert.Contains(t, tags, tagTest)
+	})
+}
+
+func TestInt_TagCreate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+
+	t.Run("with allowed values and comment", func(t *testing.T) {
+		comment := randomComment(t)
+		tag, tagCleanup := createTagWithOptions(t, client, databaseTest, schemaTest, &TagCreateOptions{
+			AllowedValues: []AllowedValue{{Value: "finance"}, {Value: "engineering"}},
+			Comment:       String(comment),
+		})
+		t.Cleanup(tagCleanup)
+		assert.Equal(t, comment, tag.Comment)
+		assert.ElementsMatch(t, []string{"finance", "engineering"}, tag.AllowedValues)
+	})
+
+	t.Run("or replace", func(t *testing.T) {
+		tag, tagCleanup := createTag(t, client, databaseTest, schemaTest)
+		t.Cleanup(tagCleanup)
+		comment := randomComment(t)
+		err := client.Tags.Create(ctx, tag.ID(), &TagCreateOptions{
+			OrReplace: Bool(true),
+			Comment:   String(comment),
+		})
+		require.NoError(t, err)
+		replaced, err := client.Tags.ShowByID(ctx, tag.ID())
+		require.NoError(t, err)
+		assert.Equal(t, comment, replaced.Comment)
+	})
+}
+
+func TestInt_TagAlter(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+
+	t.Run("add and drop allowed values", func(t *testing.T) {
+		tag, tagCleanup := createTagWithOptions(t, client, databaseTest, schemaTest, &TagCreateOptions{
+			AllowedValues: []AllowedValue{{Value: "a"}},
+		})
+		t.Cleanup(tagCleanup)
+
+		err := client.Tags.Alter(ctx, tag.ID(), &TagAlterOptions{
+			Add: &TagAdd{AllowedValues: []AllowedValue{{Value: "b"}, {Value: "c"}}},
+		})
+		require.NoError(t, err)
+		err = client.Tags.Alter(ctx, tag.ID(), &TagAlterOptions{
+			Drop: &TagDrop{AllowedValues: []AllowedValue{{Value: "a"}}},
+		})
+		require.NoError(t, err)
+		altered, err := client.Tags.ShowByID(ctx, tag.ID())
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"b", "c"}, altered.AllowedValues)
+
+		err = client.Tags.Alter(ctx, tag.ID(), &TagAlterOptions{
+			Unset: &TagUnset{AllowedValues: Bool(true)},
+		})
+		require.NoError(t, err)
+		altered, err = client.Tags.ShowByID(ctx, tag.ID())
+		require.NoError(t, err)
+		assert.Empty(t, altered.AllowedValues)
+	})
+
+	t.Run("set and unset comment", func(t *testing.T) {
+		tag, tagCleanup := createTag(t, client, databaseTest, schemaTest)
+		t.Cleanup(tagCleanup)
+		comment := randomComment(t)
+		err := client.Tags.Alter(ctx, tag.ID(), &TagAlterOptions{
+			Set: &TagSet{Comment: String(comment)},
+		})
+		require.NoError(t, err)
+		altered, err := client.Tags.ShowByID(ctx, tag.ID())
+		require.NoError(t, err)
+		assert.Equal(t, comment, altered.Comment)
+
+		err = client.Tags.Alter(ctx, tag.ID(), &TagAlterOptions{
+			Unset: &TagUnset{Comment: Bool(true)},
+		})
+		require.NoError(t, err)
+		altered, err = client.Tags.ShowByID(ctx, tag.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "", altered.Comment)
+	})
+
+	t.Run("set and unset masking policy", func(t *testing.T) {
+		tag, tagCleanup := createTag(t, client, databaseTest, schemaTest)
+		t.Cleanup(tagCleanup)
+		maskingPolicy, maskingPolicyCleanup := createMaskingPolicy(t, client, databaseTest, schemaTest)
+		t.Cleanup(maskingPolicyCleanup)
+
+		err := client.Tags.Alter(ctx, tag.ID(), &TagAlterOptions{
+			Set: &TagSet{MaskingPolicies: []TagMaskingPolicy{{Name: maskingPolicy.ID()}}},
+		})
+		require.NoError(t, err)
+		err = client.Tags.Alter(ctx, tag.ID(), &TagAlterOptions{
+			Unset: &TagUnset{MaskingPolicies: []TagMaskingPolicy{{Name: maskingPolicy.ID()}}},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		tag, _ := createTag(t, client, databaseTest, schemaTest)
+		newID := NewSchemaObjectIdentifier(databaseTest.Name, schemaTest.Name, randomStringN(t, 12))
+		err := client.Tags.Alter(ctx, tag.ID(), &TagAlterOptions{
+			NewName: newID,
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.Tags.Drop(ctx, newID, nil)
+			require.NoError(t, err)
+		})
+		_, err = client.Tags.ShowByID(ctx, tag.ID())
+		assert.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+		renamed, err := client.Tags.ShowByID(ctx, newID)
+		require.NoError(t, err)
+		assert.Equal(t, newID.Name(), renamed.Name)
+	})
+}
+
+func TestInt_TagDropAndUndrop(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+
+	tag, tagCleanup := createTag(t, client, databaseTest, schemaTest)
+	t.Cleanup(tagCleanup)
+
+	err := client.Tags.Drop(ctx, tag.ID(), nil)
+	require.NoError(t, err)
+	_, err = client.Tags.ShowByID(ctx, tag.ID())
+	assert.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+
+	err = client.Tags.Undrop(ctx, tag.ID())
+	require.NoError(t, err)
+	_, err = client.Tags.ShowByID(ctx, tag.ID())
+	require.NoError(t, err)
+}
+
+func TestInt_TagSetAndUnset(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+	tag, tagCleanup := createTag(t, client, databaseTest, schemaTest)
+	t.Cleanup(tagCleanup)
+
+	t.Run("on a schema", func(t *testing.T) {
+		err := client.Tags.Set(ctx, &SetTagOptions{
+			ObjectType: ObjectTypeSchema,
+			ObjectName: schemaTest.ID(),
+			SetTags:    []TagAssociation{{Name: tag.ID(), Value: "v1"}},
+		})
+		require.NoError(t, err)
+		value, err := client.SystemFunctions.GetTag(ctx, tag.ID(), schemaTest.ID(), ObjectTypeSchema)
+		require.NoError(t, err)
+		assert.Equal(t, "v1", value)
+
+		err = client.Tags.Unset(ctx, &UnsetTagOptions{
+			ObjectType: ObjectTypeSchema,
+			ObjectName: schemaTest.ID(),
+			UnsetTags:  []ObjectIdentifier{tag.ID()},
+		})
+		require.NoError(t, err)
+		_, err = client.SystemFunctions.GetTag(ctx, tag.ID(), schemaTest.ID(), ObjectTypeSchema)
+		assert.ErrorIs(t, err, ErrTagNotSet)
+	})
+}
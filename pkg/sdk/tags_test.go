@@ -0,0 +1,267 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagCreate(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("only name", func(t *testing.T) {
+		opts := &TagCreateOptions{
+			name: id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("CREATE TAG %s", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &TagCreateOptions{
+			OrReplace: Bool(true),
+			name:      id,
+			AllowedValues: []AllowedValue{
+				{Value: "finance"},
+				{Value: "engineering"},
+			},
+			Comment: String("comment"),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("CREATE OR REPLACE TAG %s ALLOWED_VALUES 'finance', 'engineering' COMMENT = 'comment'", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: or replace and if not exists", func(t *testing.T) {
+		opts := &TagCreateOptions{
+			name:        id,
+			OrReplace:   Bool(true),
+			IfNotExists: Bool(true),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestTagAlter(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("rename", func(t *testing.T) {
+		newID := NewSchemaObjectIdentifier(id.DatabaseName(), id.SchemaName(), randomUUID(t))
+		opts := &TagAlterOptions{
+			IfExists: Bool(true),
+			name:     id,
+			NewName:  newID,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER TAG IF EXISTS %s RENAME TO %s", id.FullyQualifiedName(), newID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("add allowed values", func(t *testing.T) {
+		opts := &TagAlterOptions{
+			name: id,
+			Add: &TagAdd{
+				AllowedValues: []AllowedValue{{Value: "a"}, {Value: "b"}},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER TAG %s ADD ALLOWED_VALUES 'a', 'b'", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("drop allowed values", func(t *testing.T) {
+		opts := &TagAlterOptions{
+			name: id,
+			Drop: &TagDrop{
+				AllowedValues: []AllowedValue{{Value: "a"}},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER TAG %s DROP ALLOWED_VALUES 'a'", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("set masking policies", func(t *testing.T) {
+		mp1 := randomSchemaObjectIdentifier(t)
+		mp2 := randomSchemaObjectIdentifier(t)
+		opts := &TagAlterOptions{
+			name: id,
+			Set: &TagSet{
+				MaskingPolicies: []TagMaskingPolicy{{Name: mp1}, {Name: mp2}},
+				Force:           Bool(true),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER TAG %s SET MASKING POLICY %s, MASKING POLICY %s FORCE", id.FullyQualifiedName(), mp1.FullyQualifiedName(), mp2.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("unset masking policies", func(t *testing.T) {
+		mp := randomSchemaObjectIdentifier(t)
+		opts := &TagAlterOptions{
+			name: id,
+			Unset: &TagUnset{
+				MaskingPolicies: []TagMaskingPolicy{{Name: mp}},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER TAG %s UNSET MASKING POLICY %s", id.FullyQualifiedName(), mp.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("set comment", func(t *testing.T) {
+		opts := &TagAlterOptions{
+			name: id,
+			Set: &TagSet{
+				Comment: String("new comment"),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER TAG %s SET COMMENT = 'new comment'", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("unset allowed values", func(t *testing.T) {
+		opts := &TagAlterOptions{
+			name: id,
+			Unset: &TagUnset{
+				AllowedValues: Bool(true),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER TAG %s UNSET ALLOWED_VALUES", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: more than one action", func(t *testing.T) {
+		opts := &TagAlterOptions{
+			name:  id,
+			Set:   &TagSet{Comment: String("comment")},
+			Unset: &TagUnset{AllowedValues: Bool(true)},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: force without masking policies", func(t *testing.T) {
+		opts := &TagAlterOptions{
+			name: id,
+			Set:  &TagSet{Comment: String("comment"), Force: Bool(true)},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestTagDropAndUndrop(t *testing.T) {
+	id := randomSchemaObjectIdentifier(t)
+
+	t.Run("drop", func(t *testing.T) {
+		opts := &TagDropOptions{
+			IfExists: Bool(true),
+			name:     id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("DROP TAG IF EXISTS %s", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("undrop", func(t *testing.T) {
+		opts := &undropTagOptions{
+			name: id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("UNDROP TAG %s", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestTagShow(t *testing.T) {
+	t.Run("in database", func(t *testing.T) {
+		database := randomAccountObjectIdentifier(t)
+		opts := &TagShowOptions{
+			Like: &Like{Pattern: String("tag")},
+			In:   &In{Database: database},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("SHOW TAGS LIKE 'tag' IN DATABASE %s", database.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: in with more than one scope", func(t *testing.T) {
+		opts := &TagShowOptions{
+			In: &In{Account: Bool(true), Database: randomAccountObjectIdentifier(t)},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestTagSetAndUnset(t *testing.T) {
+	tagID := randomSchemaObjectIdentifier(t)
+
+	t.Run("set on object", func(t *testing.T) {
+		objectID := randomAccountObjectIdentifier(t)
+		opts := &SetTagOptions{
+			ObjectType: ObjectTypeWarehouse,
+			ObjectName: objectID,
+			SetTags: []TagAssociation{
+				{Name: tagID, Value: "value"},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER WAREHOUSE %s SET TAG %s = 'value'", objectID.FullyQualifiedName(), tagID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("set on account", func(t *testing.T) {
+		opts := &SetTagOptions{
+			ObjectType: ObjectTypeAccount,
+			SetTags: []TagAssociation{
+				{Name: tagID, Value: "value"},
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER ACCOUNT SET TAG %s = 'value'", tagID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("unset on column", func(t *testing.T) {
+		columnID := NewTableColumnIdentifier("db", "schema", "table", "column")
+		objectType, objectName, column, err := columnToTable(columnID)
+		require.NoError(t, err)
+		opts := &UnsetTagOptions{
+			ObjectType: objectType,
+			ObjectName: objectName,
+			column:     column,
+			UnsetTags:  []ObjectIdentifier{tagID},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`ALTER TABLE "db"."schema"."table" MODIFY COLUMN "column" UNSET TAG %s`, tagID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: no tags", func(t *testing.T) {
+		opts := &UnsetTagOptions{
+			ObjectType: ObjectTypeWarehouse,
+			ObjectName: randomAccountObjectIdentifier(t),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
@@ -2,6 +2,10 @@ package sdk
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
 )
 
 type Users interface {
@@ -25,51 +29,586 @@ type users struct {
 	client *Client
 }
 
-type User struct{}
+type User struct {
+	Name                  string
+	CreatedOn             time.Time
+	LoginName             string
+	DisplayName           string
+	FirstName             string
+	LastName              string
+	Email                 string
+	MinsToUnlock          string
+	DaysToExpiry          string
+	Comment               string
+	Disabled              bool
+	MustChangePassword    bool
+	SnowflakeLock         bool
+	DefaultWarehouse      string
+	DefaultNamespace      string
+	DefaultRole           string
+	DefaultSecondaryRoles string
+	ExtAuthnDuo           bool
+	ExtAuthnUID           string
+	MinsToBypassMFA       string
+	Owner                 string
+	LastSuccessLogin      time.Time
+	ExpiresAtTime         time.Time
+	LockedUntilTime       time.Time
+	HasPassword           bool
+	HasRSAPublicKey       bool
+}
 
 func (v *User) ID() AccountObjectIdentifier {
-	return AccountObjectIdentifier{}
+	return NewAccountObjectIdentifier(v.Name)
 }
 
 func (v *User) ObjectType() ObjectType {
 	return ObjectTypeUser
 }
 
+type userDBRow struct {
+	Name                  string         `db:"name"`
+	CreatedOn             time.Time      `db:"created_on"`
+	LoginName             sql.NullString `db:"login_name"`
+	DisplayName           sql.NullString `db:"display_name"`
+	FirstName             sql.NullString `db:"first_name"`
+	LastName              sql.NullString `db:"last_name"`
+	Email                 sql.NullString `db:"email"`
+	MinsToUnlock          sql.NullString `db:"mins_to_unlock"`
+	DaysToExpiry          sql.NullString `db:"days_to_expiry"`
+	Comment               sql.NullString `db:"comment"`
+	Disabled              sql.NullString `db:"disabled"`
+	MustChangePassword    sql.NullString `db:"must_change_password"`
+	SnowflakeLock         sql.NullString `db:"snowflake_lock"`
+	DefaultWarehouse      sql.NullString `db:"default_warehouse"`
+	DefaultNamespace      sql.NullString `db:"default_namespace"`
+	DefaultRole           sql.NullString `db:"default_role"`
+	DefaultSecondaryRoles sql.NullString `db:"default_secondary_roles"`
+	ExtAuthnDuo           sql.NullString `db:"ext_authn_duo"`
+	ExtAuthnUID           sql.NullString `db:"ext_authn_uid"`
+	MinsToBypassMFA       sql.NullString `db:"mins_to_bypass_mfa"`
+	Owner                 sql.NullString `db:"owner"`
+	LastSuccessLogin      sql.NullTime   `db:"last_success_login"`
+	ExpiresAtTime         sql.NullTime   `db:"expires_at_time"`
+	LockedUntilTime       sql.NullTime   `db:"locked_until_time"`
+	HasPassword           sql.NullString `db:"has_password"`
+	HasRSAPublicKey       sql.NullString `db:"has_rsa_public_key"`
+}
+
+func (row *userDBRow) toUser() *User {
+	user := &User{
+		Name:                  row.Name,
+		CreatedOn:             row.CreatedOn,
+		LoginName:             row.LoginName.String,
+		DisplayName:           row.DisplayName.String,
+		FirstName:             row.FirstName.String,
+		LastName:              row.LastName.String,
+		Email:                 row.Email.String,
+		MinsToUnlock:          row.MinsToUnlock.String,
+		DaysToExpiry:          row.DaysToExpiry.String,
+		Comment:               row.Comment.String,
+		Disabled:              row.Disabled.String == "true",
+		MustChangePassword:    row.MustChangePassword.String == "true",
+		SnowflakeLock:         row.SnowflakeLock.String == "true",
+		DefaultWarehouse:      row.DefaultWarehouse.String,
+		DefaultNamespace:      row.DefaultNamespace.String,
+		DefaultRole:           row.DefaultRole.String,
+		DefaultSecondaryRoles: row.DefaultSecondaryRoles.String,
+		ExtAuthnDuo:           row.ExtAuthnDuo.String == "true",
+		ExtAuthnUID:           row.ExtAuthnUID.String,
+		MinsToBypassMFA:       row.MinsToBypassMFA.String,
+		Owner:                 row.Owner.String,
+		HasPassword:           row.HasPassword.String == "true",
+		HasRSAPublicKey:       row.HasRSAPublicKey.String == "true",
+	}
+	if row.LastSuccessLogin.Valid {
+		user.LastSuccessLogin = row.LastSuccessLogin.Time
+	}
+	if row.ExpiresAtTime.Valid {
+		user.ExpiresAtTime = row.ExpiresAtTime.Time
+	}
+	if row.LockedUntilTime.Valid {
+		user.LockedUntilTime = row.LockedUntilTime.Time
+	}
+	return user
+}
+
+// SecondaryRole is a single entry of DEFAULT_SECONDARY_ROLES. Currently Snowflake only supports 'ALL'.
+type SecondaryRole struct {
+	Value string `ddl:"keyword,single_quotes"`
+}
+
+type UserObjectProperties struct {
+	Password                  *string         `ddl:"parameter,single_quotes" sql:"PASSWORD"`
+	LoginName                 *string         `ddl:"parameter,single_quotes" sql:"LOGIN_NAME"`
+	DisplayName               *string         `ddl:"parameter,single_quotes" sql:"DISPLAY_NAME"`
+	FirstName                 *string         `ddl:"parameter,single_quotes" sql:"FIRST_NAME"`
+	MiddleName                *string         `ddl:"parameter,single_quotes" sql:"MIDDLE_NAME"`
+	LastName                  *string         `ddl:"parameter,single_quotes" sql:"LAST_NAME"`
+	Email                     *string         `ddl:"parameter,single_quotes" sql:"EMAIL"`
+	MustChangePassword        *bool           `ddl:"parameter" sql:"MUST_CHANGE_PASSWORD"`
+	Disabled                  *bool           `ddl:"parameter" sql:"DISABLED"`
+	DaysToExpiry              *int            `ddl:"parameter" sql:"DAYS_TO_EXPIRY"`
+	MinsToUnlock              *int            `ddl:"parameter" sql:"MINS_TO_UNLOCK"`
+	DefaultWarehouse          *string         `ddl:"parameter,single_quotes" sql:"DEFAULT_WAREHOUSE"`
+	DefaultNamespace          *string         `ddl:"parameter,single_quotes" sql:"DEFAULT_NAMESPACE"`
+	DefaultRole               *string         `ddl:"parameter,single_quotes" sql:"DEFAULT_ROLE"`
+	DefaultSecondaryRoles     []SecondaryRole `ddl:"parameter,parentheses" sql:"DEFAULT_SECONDARY_ROLES"`
+	MinsToBypassMFA           *int            `ddl:"parameter" sql:"MINS_TO_BYPASS_MFA"`
+	MinsToBypassNetworkPolicy *int            `ddl:"parameter" sql:"MINS_TO_BYPASS_NETWORK_POLICY"`
+	DisableMFA                *bool           `ddl:"parameter" sql:"DISABLE_MFA"`
+	ExtAuthnDuo               *bool           `ddl:"parameter" sql:"EXT_AUTHN_DUO"`
+	ExtAuthnUID               *string         `ddl:"parameter,single_quotes" sql:"EXT_AUTHN_UID"`
+	RSAPublicKey              *string         `ddl:"parameter,single_quotes" sql:"RSA_PUBLIC_KEY"`
+	RSAPublicKey2             *string         `ddl:"parameter,single_quotes" sql:"RSA_PUBLIC_KEY_2"`
+	Comment                   *string         `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+func (v *UserObjectProperties) validate() error {
+	if valueSet(v.DaysToExpiry) && !validateIntGreaterThanOrEqual(*v.DaysToExpiry, 0) {
+		return fmt.Errorf("DAYS_TO_EXPIRY must be greater than or equal to 0")
+	}
+	if valueSet(v.MinsToUnlock) && !validateIntGreaterThanOrEqual(*v.MinsToUnlock, 0) {
+		return fmt.Errorf("MINS_TO_UNLOCK must be greater than or equal to 0")
+	}
+	if valueSet(v.MinsToBypassMFA) && !validateIntGreaterThanOrEqual(*v.MinsToBypassMFA, 0) {
+		return fmt.Errorf("MINS_TO_BYPASS_MFA must be greater than or equal to 0")
+	}
+	if valueSet(v.MinsToBypassNetworkPolicy) && !validateIntGreaterThanOrEqual(*v.MinsToBypassNetworkPolicy, 0) {
+		return fmt.Errorf("MINS_TO_BYPASS_NETWORK_POLICY must be greater than or equal to 0")
+	}
+	return nil
+}
+
+type UserObjectPropertiesUnset struct {
+	Password                  *bool `ddl:"keyword" sql:"PASSWORD"`
+	LoginName                 *bool `ddl:"keyword" sql:"LOGIN_NAME"`
+	DisplayName               *bool `ddl:"keyword" sql:"DISPLAY_NAME"`
+	FirstName                 *bool `ddl:"keyword" sql:"FIRST_NAME"`
+	MiddleName                *bool `ddl:"keyword" sql:"MIDDLE_NAME"`
+	LastName                  *bool `ddl:"keyword" sql:"LAST_NAME"`
+	Email                     *bool `ddl:"keyword" sql:"EMAIL"`
+	MustChangePassword        *bool `ddl:"keyword" sql:"MUST_CHANGE_PASSWORD"`
+	Disabled                  *bool `ddl:"keyword" sql:"DISABLED"`
+	DaysToExpiry              *bool `ddl:"keyword" sql:"DAYS_TO_EXPIRY"`
+	MinsToUnlock              *bool `ddl:"keyword" sql:"MINS_TO_UNLOCK"`
+	DefaultWarehouse          *bool `ddl:"keyword" sql:"DEFAULT_WAREHOUSE"`
+	DefaultNamespace          *bool `ddl:"keyword" sql:"DEFAULT_NAMESPACE"`
+	DefaultRole               *bool `ddl:"keyword" sql:"DEFAULT_ROLE"`
+	DefaultSecondaryRoles     *bool `ddl:"keyword" sql:"DEFAULT_SECONDARY_ROLES"`
+	MinsToBypassMFA           *bool `ddl:"keyword" sql:"MINS_TO_BYPASS_MFA"`
+	MinsToBypassNetworkPolicy *bool `ddl:"keyword" sql:"MINS_TO_BYPASS_NETWORK_POLICY"`
+	DisableMFA                *bool `ddl:"keyword" sql:"DISABLE_MFA"`
+	ExtAuthnDuo               *bool `ddl:"keyword" sql:"EXT_AUTHN_DUO"`
+	ExtAuthnUID               *bool `ddl:"keyword" sql:"EXT_AUTHN_UID"`
+	RSAPublicKey              *bool `ddl:"keyword" sql:"RSA_PUBLIC_KEY"`
+	RSAPublicKey2             *bool `ddl:"keyword" sql:"RSA_PUBLIC_KEY_2"`
+	Comment                   *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+// UserObjectParameters are the object parameters that can be set on a user.
+type UserObjectParameters struct {
+	EnableUnredactedQuerySyntaxError *bool   `ddl:"parameter" sql:"ENABLE_UNREDACTED_QUERY_SYNTAX_ERROR"`
+	NetworkPolicy                    *string `ddl:"parameter,single_quotes" sql:"NETWORK_POLICY"`
+}
+
+type UserObjectParametersUnset struct {
+	EnableUnredactedQuerySyntaxError *bool `ddl:"keyword" sql:"ENABLE_UNREDACTED_QUERY_SYNTAX_ERROR"`
+	NetworkPolicy                    *bool `ddl:"keyword" sql:"NETWORK_POLICY"`
+}
+
 // CreateUserOptions contains options for creating a user.
-type CreateUserOptions struct{}
+type CreateUserOptions struct {
+	create      bool                    `ddl:"static" sql:"CREATE"` //lint:ignore U1000 This is used in the ddl tag
+	OrReplace   *bool                   `ddl:"keyword" sql:"OR REPLACE"`
+	user        bool                    `ddl:"static" sql:"USER"` //lint:ignore U1000 This is used in the ddl tag
+	IfNotExists *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name        AccountObjectIdentifier `ddl:"identifier"`
 
-func (v *users) Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateUserOptions) error {
+	ObjectProperties  *UserObjectProperties `ddl:"keyword"`
+	ObjectParameters  *UserObjectParameters `ddl:"keyword"`
+	SessionParameters *SessionParameters    `ddl:"keyword"`
+	Tag               []TagAssociation      `ddl:"keyword,parentheses" sql:"WITH TAG"`
+}
+
+func (opts *CreateUserOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		return errors.New("IF NOT EXISTS and OR REPLACE are incompatible.")
+	}
+	if valueSet(opts.ObjectProperties) {
+		if err := opts.ObjectProperties.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.SessionParameters) {
+		if err := opts.SessionParameters.validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+func (v *users) Create(ctx context.Context, id AccountObjectIdentifier, opts *CreateUserOptions) error {
+	if opts == nil {
+		opts = &CreateUserOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
 // AlterUserOptions contains options for altering a user.
-type AlterUserOptions struct{}
+type AlterUserOptions struct {
+	alter    bool                    `ddl:"static" sql:"ALTER"` //lint:ignore U1000 This is used in the ddl tag
+	user     bool                    `ddl:"static" sql:"USER"`  //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name     AccountObjectIdentifier `ddl:"identifier"`
 
-func (v *users) Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterUserOptions) error {
+	NewName         AccountObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	ResetPassword   *bool                   `ddl:"keyword" sql:"RESET PASSWORD"`
+	AbortAllQueries *bool                   `ddl:"keyword" sql:"ABORT ALL QUERIES"`
+	SetTag          []TagAssociation        `ddl:"keyword" sql:"SET TAG"`
+	UnsetTag        []ObjectIdentifier      `ddl:"keyword" sql:"UNSET TAG"`
+	Set             *UserSet                `ddl:"keyword" sql:"SET"`
+	Unset           *UserUnset              `ddl:"list,no_parentheses" sql:"UNSET"`
+}
+
+func (opts *AlterUserOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	if ok := exactlyOneValueSet(
+		opts.NewName,
+		opts.ResetPassword,
+		opts.AbortAllQueries,
+		opts.SetTag,
+		opts.UnsetTag,
+		opts.Set,
+		opts.Unset); !ok {
+		return fmt.Errorf("exactly one of NewName, ResetPassword, AbortAllQueries, SetTag, UnsetTag, Set, Unset must be set")
+	}
+	if valueSet(opts.Set) {
+		if err := opts.Set.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.Unset) {
+		if err := opts.Unset.validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+type UserSet struct {
+	PasswordPolicy    SchemaObjectIdentifier `ddl:"identifier" sql:"PASSWORD POLICY"`
+	SessionPolicy     SchemaObjectIdentifier `ddl:"identifier" sql:"SESSION POLICY"`
+	ObjectProperties  *UserObjectProperties  `ddl:"keyword"`
+	ObjectParameters  *UserObjectParameters  `ddl:"keyword"`
+	SessionParameters *SessionParameters     `ddl:"keyword"`
+}
+
+func (opts *UserSet) validate() error {
+	if !anyValueSet(opts.PasswordPolicy, opts.SessionPolicy, opts.ObjectProperties, opts.ObjectParameters, opts.SessionParameters) {
+		return fmt.Errorf("at least one of password policy, session policy, object properties, object parameters, or session parameters must be set")
+	}
+	if valueSet(opts.PasswordPolicy) {
+		if anyValueSet(opts.SessionPolicy, opts.ObjectProperties, opts.ObjectParameters, opts.SessionParameters) {
+			return fmt.Errorf("password policy cannot be set with any other field")
+		}
+		return nil
+	}
+	if valueSet(opts.SessionPolicy) {
+		if anyValueSet(opts.ObjectProperties, opts.ObjectParameters, opts.SessionParameters) {
+			return fmt.Errorf("session policy cannot be set with any other field")
+		}
+		return nil
+	}
+	if valueSet(opts.ObjectProperties) {
+		if err := opts.ObjectProperties.validate(); err != nil {
+			return err
+		}
+	}
+	if valueSet(opts.SessionParameters) {
+		if err := opts.SessionParameters.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type UserUnset struct {
+	PasswordPolicy    *bool                      `ddl:"keyword" sql:"PASSWORD POLICY"`
+	SessionPolicy     *bool                      `ddl:"keyword" sql:"SESSION POLICY"`
+	ObjectProperties  *UserObjectPropertiesUnset `ddl:"list,no_parentheses"`
+	ObjectParameters  *UserObjectParametersUnset `ddl:"list,no_parentheses"`
+	SessionParameters *SessionParametersUnset    `ddl:"list,no_parentheses"`
+}
+
+func (opts *UserUnset) validate() error {
+	if !anyValueSet(opts.PasswordPolicy, opts.SessionPolicy, opts.ObjectProperties, opts.ObjectParameters, opts.SessionParameters) {
+		return fmt.Errorf("at least one of password policy, session policy, object properties, object parameters, or session parameters must be unset")
+	}
+	if valueSet(opts.PasswordPolicy) {
+		if anyValueSet(opts.SessionPolicy, opts.ObjectProperties, opts.ObjectParameters, opts.SessionParameters) {
+			return fmt.Errorf("password policy cannot be unset with any other field")
+		}
+		return nil
+	}
+	if valueSet(opts.SessionPolicy) {
+		if anyValueSet(opts.ObjectProperties, opts.ObjectParameters, opts.SessionParameters) {
+			return fmt.Errorf("session policy cannot be unset with any other field")
+		}
+		return nil
+	}
+	if valueSet(opts.SessionParameters) {
+		if err := opts.SessionParameters.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *users) Alter(ctx context.Context, id AccountObjectIdentifier, opts *AlterUserOptions) error {
+	if opts == nil {
+		opts = &AlterUserOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
 // DropUserOptions contains options for dropping a user.
-type DropUserOptions struct{}
+type DropUserOptions struct {
+	drop     bool                    `ddl:"static" sql:"DROP"` //lint:ignore U1000 This is used in the ddl tag
+	user     bool                    `ddl:"static" sql:"USER"` //lint:ignore U1000 This is used in the ddl tag
+	IfExists *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name     AccountObjectIdentifier `ddl:"identifier"`
+}
 
-func (v *users) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropUserOptions) error {
+func (opts *DropUserOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
 	return nil
 }
 
+func (v *users) Drop(ctx context.Context, id AccountObjectIdentifier, opts *DropUserOptions) error {
+	if opts == nil {
+		opts = &DropUserOptions{}
+	}
+	opts.name = id
+	if err := opts.validate(); err != nil {
+		return err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return err
+	}
+	_, err = v.client.exec(ctx, sql)
+	return err
+}
+
 // UserDetails contains details about a user.
-type UserDetails struct{}
+type UserDetails struct {
+	Name                                *StringProperty
+	Comment                             *StringProperty
+	DisplayName                         *StringProperty
+	LoginName                           *StringProperty
+	FirstName                           *StringProperty
+	MiddleName                          *StringProperty
+	LastName                            *StringProperty
+	Email                               *StringProperty
+	Password                            *StringProperty
+	MustChangePassword                  *BoolProperty
+	Disabled                            *BoolProperty
+	SnowflakeLock                       *BoolProperty
+	SnowflakeSupport                    *BoolProperty
+	DaysToExpiry                        *StringProperty
+	MinsToUnlock                        *StringProperty
+	DefaultWarehouse                    *StringProperty
+	DefaultNamespace                    *StringProperty
+	DefaultRole                         *StringProperty
+	DefaultSecondaryRoles               *StringProperty
+	ExtAuthnDuo                         *BoolProperty
+	ExtAuthnUID                         *StringProperty
+	MinsToBypassMFA                     *StringProperty
+	MinsToBypassNetworkPolicy           *StringProperty
+	RSAPublicKeyFp                      *StringProperty
+	RSAPublicKey2Fp                     *StringProperty
+	PasswordLastSetTime                 *StringProperty
+	CustomLandingPageURL                *StringProperty
+	CustomLandingPageURLFlushNextUILoad *BoolProperty
+}
+
+func userDetailsFromRows(rows []propertyRow) *UserDetails {
+	v := &UserDetails{}
+	for _, row := range rows {
+		switch row.Property {
+		case "NAME":
+			v.Name = row.toStringProperty()
+		case "COMMENT":
+			v.Comment = row.toStringProperty()
+		case "DISPLAY_NAME":
+			v.DisplayName = row.toStringProperty()
+		case "LOGIN_NAME":
+			v.LoginName = row.toStringProperty()
+		case "FIRST_NAME":
+			v.FirstName = row.toStringProperty()
+		case "MIDDLE_NAME":
+			v.MiddleName = row.toStringProperty()
+		case "LAST_NAME":
+			v.LastName = row.toStringProperty()
+		case "EMAIL":
+			v.Email = row.toStringProperty()
+		case "PASSWORD":
+			v.Password = row.toStringProperty()
+		case "MUST_CHANGE_PASSWORD":
+			v.MustChangePassword = row.toBoolProperty()
+		case "DISABLED":
+			v.Disabled = row.toBoolProperty()
+		case "SNOWFLAKE_LOCK":
+			v.SnowflakeLock = row.toBoolProperty()
+		case "SNOWFLAKE_SUPPORT":
+			v.SnowflakeSupport = row.toBoolProperty()
+		case "DAYS_TO_EXPIRY":
+			v.DaysToExpiry = row.toStringProperty()
+		case "MINS_TO_UNLOCK":
+			v.MinsToUnlock = row.toStringProperty()
+		case "DEFAULT_WAREHOUSE":
+			v.DefaultWarehouse = row.toStringProperty()
+		case "DEFAULT_NAMESPACE":
+			v.DefaultNamespace = row.toStringProperty()
+		case "DEFAULT_ROLE":
+			v.DefaultRole = row.toStringProperty()
+		case "DEFAULT_SECONDARY_ROLES":
+			v.DefaultSecondaryRoles = row.toStringProperty()
+		case "EXT_AUTHN_DUO":
+			v.ExtAuthnDuo = row.toBoolProperty()
+		case "EXT_AUTHN_UID":
+			v.ExtAuthnUID = row.toStringProperty()
+		case "MINS_TO_BYPASS_MFA":
+			v.MinsToBypassMFA = row.toStringProperty()
+		case "MINS_TO_BYPASS_NETWORK_POLICY":
+			v.MinsToBypassNetworkPolicy = row.toStringProperty()
+		case "RSA_PUBLIC_KEY_FP":
+			v.RSAPublicKeyFp = row.toStringProperty()
+		case "RSA_PUBLIC_KEY_2_FP":
+			v.RSAPublicKey2Fp = row.toStringProperty()
+		case "PASSWORD_LAST_SET_TIME":
+			v.PasswordLastSetTime = row.toStringProperty()
+		case "CUSTOM_LANDING_PAGE_URL":
+			v.CustomLandingPageURL = row.toStringProperty()
+		case "CUSTOM_LANDING_PAGE_URL_FLUSH_NEXT_UI_LOAD":
+			v.CustomLandingPageURLFlushNextUILoad = row.toBoolProperty()
+		}
+	}
+	return v
+}
+
+type describeUserOptions struct {
+	describe bool                    `ddl:"static" sql:"DESCRIBE"` //lint:ignore U1000 This is used in the ddl tag
+	user     bool                    `ddl:"static" sql:"USER"`     //lint:ignore U1000 This is used in the ddl tag
+	name     AccountObjectIdentifier `ddl:"identifier"`
+}
+
+func (opts *describeUserOptions) validate() error {
+	if !validObjectidentifier(opts.name) {
+		return ErrInvalidObjectIdentifier
+	}
+	return nil
+}
 
 func (v *users) Describe(ctx context.Context, id AccountObjectIdentifier) (*UserDetails, error) {
-	return nil, nil
+	opts := &describeUserOptions{
+		name: id,
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []propertyRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	return userDetailsFromRows(dest), nil
 }
 
 // ShowUserOptions contains options for listing users.
-type ShowUserOptions struct{}
+type ShowUserOptions struct {
+	show       bool    `ddl:"static" sql:"SHOW"` //lint:ignore U1000 This is used in the ddl tag
+	Terse      *bool   `ddl:"keyword" sql:"TERSE"`
+	users      bool    `ddl:"static" sql:"USERS"` //lint:ignore U1000 This is used in the ddl tag
+	Like       *Like   `ddl:"keyword" sql:"LIKE"`
+	StartsWith *string `ddl:"parameter,no_equals,single_quotes" sql:"STARTS WITH"`
+	Limit      *int    `ddl:"parameter,no_equals" sql:"LIMIT"`
+	From       *string `ddl:"parameter,no_equals,single_quotes" sql:"FROM"`
+}
+
+func (opts *ShowUserOptions) validate() error {
+	if valueSet(opts.From) && !valueSet(opts.Limit) {
+		return fmt.Errorf("FROM can only be used together with LIMIT")
+	}
+	return nil
+}
 
 func (v *users) Show(ctx context.Context, opts *ShowUserOptions) ([]*User, error) {
-	return nil, nil
+	if opts == nil {
+		opts = &ShowUserOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return nil, err
+	}
+	dest := []userDBRow{}
+	err = v.client.query(ctx, &dest, sql)
+	if err != nil {
+		return nil, err
+	}
+	resultList := make([]*User, len(dest))
+	for i, row := range dest {
+		resultList[i] = row.toUser()
+	}
+	return resultList, nil
 }
 
 func (v *users) ShowByID(ctx context.Context, id AccountObjectIdentifier) (*User, error) {
-	return nil, nil
+	users, err := v.Show(ctx, &ShowUserOptions{
+		Like: &Like{
+			Pattern: String(id.Name()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		if user.ID().name == id.Name() {
+			return user, nil
+		}
+	}
+	return nil, ErrObjectNotExistOrAuthorized
 }
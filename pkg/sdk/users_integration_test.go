@@ -0,0 +1,218 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_UsersShow(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	userTest, userCleanup := createUser(t, client)
+	t.Cleanup(userCleanup)
+
+	userTest2, user2Cleanup := createUser(t, client)
+	t.Cleanup(user2Cleanup)
+
+	t.Run("without show options", func(t *testing.T) {
+		users, err := client.Users.Show(ctx, nil)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, 2, len(users))
+	})
+
+	t.Run("with like", func(t *testing.T) {
+		users, err := client.Users.Show(ctx, &ShowUserOptions{
+			Like: &Like{
+				Pattern: String(userTest.Name),
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, len(users))
+		assert.Equal(t, userTest.Name, users[0].Name)
+	})
+
+	t.Run("with starts with and limit", func(t *testing.T) {
+		users, err := client.Users.Show(ctx, &ShowUserOptions{
+			StartsWith: String(userTest2.Name),
+			Limit:      Int(1),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, len(users))
+		assert.Equal(t, userTest2.Name, users[0].Name)
+	})
+
+	t.Run("when searching a non-existent user", func(t *testing.T) {
+		users, err := client.Users.Show(ctx, &ShowUserOptions{
+			Like: &Like{
+				Pattern: String("non-existent"),
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(users))
+	})
+}
+
+func TestInt_UserCreate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	databaseTest, databaseCleanup := createDatabase(t, client)
+	t.Cleanup(databaseCleanup)
+	schemaTest, schemaCleanup := createSchema(t, client, databaseTest)
+	t.Cleanup(schemaCleanup)
+	tag, tagCleanup := createTag(t, client, databaseTest, schemaTest)
+	t.Cleanup(tagCleanup)
+
+	t.Run("test complete", func(t *testing.T) {
+		id := randomAccountObjectIdentifier(t)
+		err := client.Users.Create(ctx, id, &CreateUserOptions{
+			OrReplace: Bool(true),
+			ObjectProperties: &UserObjectProperties{
+				Password:              String(randomString(t)),
+				LoginName:             String(randomString(t)),
+				FirstName:             String("John"),
+				LastName:              String("Doe"),
+				Email:                 String("john.doe@example.com"),
+				MustChangePassword:    Bool(true),
+				DaysToExpiry:          Int(5),
+				MinsToUnlock:          Int(10),
+				MinsToBypassMFA:       Int(15),
+				DefaultSecondaryRoles: []SecondaryRole{{Value: "ALL"}},
+				Comment:               String("test comment"),
+			},
+			ObjectParameters: &UserObjectParameters{
+				EnableUnredactedQuerySyntaxError: Bool(true),
+			},
+			SessionParameters: &SessionParameters{
+				QueryTag: String("some tag"),
+			},
+			Tag: []TagAssociation{
+				{
+					Name:  tag.ID(),
+					Value: "v1",
+				},
+			},
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.Users.Drop(ctx, id, nil)
+			require.NoError(t, err)
+		})
+
+		userDetails, err := client.Users.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), userDetails.Name.Value)
+		assert.Equal(t, "John", userDetails.FirstName.Value)
+		assert.Equal(t, "Doe", userDetails.LastName.Value)
+		assert.Equal(t, "john.doe@example.com", userDetails.Email.Value)
+		assert.Equal(t, true, userDetails.MustChangePassword.Value)
+		assert.Equal(t, "test comment", userDetails.Comment.Value)
+		assert.Equal(t, `["ALL"]`, userDetails.DefaultSecondaryRoles.Value)
+
+		user, err := client.Users.ShowByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "test comment", user.Comment)
+		assert.True(t, user.HasPassword)
+	})
+
+	t.Run("test if not exists", func(t *testing.T) {
+		user, userCleanup := createUser(t, client)
+		t.Cleanup(userCleanup)
+		err := client.Users.Create(ctx, user.ID(), &CreateUserOptions{
+			IfNotExists: Bool(true),
+		})
+		require.NoError(t, err)
+	})
+}
+
+func TestInt_UserAlter(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	t.Run("rename", func(t *testing.T) {
+		user, userCleanup := createUser(t, client)
+		newID := randomAccountObjectIdentifier(t)
+		err := client.Users.Alter(ctx, user.ID(), &AlterUserOptions{
+			NewName: newID,
+		})
+		if err != nil {
+			t.Cleanup(userCleanup)
+		} else {
+			t.Cleanup(func() {
+				err := client.Users.Drop(ctx, newID, nil)
+				require.NoError(t, err)
+			})
+		}
+		require.NoError(t, err)
+		_, err = client.Users.ShowByID(ctx, newID)
+		require.NoError(t, err)
+	})
+
+	t.Run("set and unset properties", func(t *testing.T) {
+		user, userCleanup := createUser(t, client)
+		t.Cleanup(userCleanup)
+		err := client.Users.Alter(ctx, user.ID(), &AlterUserOptions{
+			Set: &UserSet{
+				ObjectProperties: &UserObjectProperties{
+					Comment:      String("new comment"),
+					DisplayName:  String("display"),
+					MinsToUnlock: Int(5),
+				},
+				SessionParameters: &SessionParameters{
+					QueryTag: String("tag"),
+				},
+			},
+		})
+		require.NoError(t, err)
+		userDetails, err := client.Users.Describe(ctx, user.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "new comment", userDetails.Comment.Value)
+		assert.Equal(t, "display", userDetails.DisplayName.Value)
+
+		err = client.Users.Alter(ctx, user.ID(), &AlterUserOptions{
+			Unset: &UserUnset{
+				ObjectProperties: &UserObjectPropertiesUnset{
+					Comment: Bool(true),
+				},
+				SessionParameters: &SessionParametersUnset{
+					QueryTag: Bool(true),
+				},
+			},
+		})
+		require.NoError(t, err)
+		userDetails, err = client.Users.Describe(ctx, user.ID())
+		require.NoError(t, err)
+		assert.Equal(t, "", userDetails.Comment.Value)
+	})
+
+	t.Run("reset password", func(t *testing.T) {
+		user, userCleanup := createUser(t, client)
+		t.Cleanup(userCleanup)
+		err := client.Users.Alter(ctx, user.ID(), &AlterUserOptions{
+			ResetPassword: Bool(true),
+		})
+		require.NoError(t, err)
+	})
+}
+
+func TestInt_UserDrop(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	t.Run("when user exists", func(t *testing.T) {
+		user, _ := createUser(t, client)
+		err := client.Users.Drop(ctx, user.ID(), nil)
+		require.NoError(t, err)
+		_, err = client.Users.ShowByID(ctx, user.ID())
+		assert.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+	})
+
+	t.Run("when user does not exist", func(t *testing.T) {
+		err := client.Users.Drop(ctx, NewAccountObjectIdentifier("does_not_exist"), nil)
+		assert.Error(t, err)
+	})
+}
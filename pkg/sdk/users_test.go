@@ -0,0 +1,353 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserCreate(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("only name", func(t *testing.T) {
+		opts := &CreateUserOptions{
+			name: id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("CREATE USER %s", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with complete options", func(t *testing.T) {
+		tagID := randomSchemaObjectIdentifier(t)
+		opts := &CreateUserOptions{
+			OrReplace:   Bool(true),
+			name:        id,
+			IfNotExists: Bool(true),
+			ObjectProperties: &UserObjectProperties{
+				Password:              String("secret"),
+				LoginName:             String("login"),
+				MustChangePassword:    Bool(true),
+				DaysToExpiry:          Int(10),
+				DefaultRole:           String("PUBLIC"),
+				DefaultSecondaryRoles: []SecondaryRole{{Value: "ALL"}},
+				RSAPublicKey2:         String("key2"),
+				Comment:               String("some comment"),
+			},
+			ObjectParameters: &UserObjectParameters{
+				EnableUnredactedQuerySyntaxError: Bool(true),
+			},
+			SessionParameters: &SessionParameters{
+				QueryTag: String("tag"),
+			},
+			Tag: []TagAssociation{
+				{
+					Name:  tagID,
+					Value: "v1",
+				},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf(`CREATE OR REPLACE USER IF NOT EXISTS %s PASSWORD = 'secret' LOGIN_NAME = 'login' MUST_CHANGE_PASSWORD = true DAYS_TO_EXPIRY = 10 DEFAULT_ROLE = 'PUBLIC' DEFAULT_SECONDARY_ROLES = ('ALL') RSA_PUBLIC_KEY_2 = 'key2' COMMENT = 'some comment' ENABLE_UNREDACTED_QUERY_SYNTAX_ERROR = true QUERY_TAG = 'tag' WITH TAG (%s = 'v1')`, id.FullyQualifiedName(), tagID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: or replace and if not exists", func(t *testing.T) {
+		opts := &CreateUserOptions{
+			OrReplace:   Bool(true),
+			name:        id,
+			IfNotExists: Bool(true),
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: negative days to expiry", func(t *testing.T) {
+		opts := &CreateUserOptions{
+			name: id,
+			ObjectProperties: &UserObjectProperties{
+				DaysToExpiry: Int(-1),
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+}
+
+func TestUserAlter(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("validation: no action", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("validation: multiple actions", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name:            id,
+			ResetPassword:   Bool(true),
+			AbortAllQueries: Bool(true),
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		newID := randomAccountObjectIdentifier(t)
+		opts := &AlterUserOptions{
+			IfExists: Bool(true),
+			name:     id,
+			NewName:  newID,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER USER IF EXISTS %s RENAME TO %s", id.FullyQualifiedName(), newID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("reset password", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name:          id,
+			ResetPassword: Bool(true),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER USER %s RESET PASSWORD", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("abort all queries", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name:            id,
+			AbortAllQueries: Bool(true),
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER USER %s ABORT ALL QUERIES", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("set tags", func(t *testing.T) {
+		tagID1 := randomSchemaObjectIdentifier(t)
+		tagID2 := randomSchemaObjectIdentifier(t)
+		opts := &AlterUserOptions{
+			name: id,
+			SetTag: []TagAssociation{
+				{Name: tagID1, Value: "v1"},
+				{Name: tagID2, Value: "v2"},
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER USER %s SET TAG %s = 'v1', %s = 'v2'", id.FullyQualifiedName(), tagID1.FullyQualifiedName(), tagID2.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("unset tags", func(t *testing.T) {
+		tagID1 := randomSchemaObjectIdentifier(t)
+		tagID2 := randomSchemaObjectIdentifier(t)
+		opts := &AlterUserOptions{
+			name:     id,
+			UnsetTag: []ObjectIdentifier{tagID1, tagID2},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER USER %s UNSET TAG %s, %s", id.FullyQualifiedName(), tagID1.FullyQualifiedName(), tagID2.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("set properties and parameters", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Set: &UserSet{
+				ObjectProperties: &UserObjectProperties{
+					Disabled:        Bool(true),
+					MinsToUnlock:    Int(5),
+					MinsToBypassMFA: Int(10),
+					Email:           String("user@example.com"),
+				},
+				ObjectParameters: &UserObjectParameters{
+					NetworkPolicy: String("policy"),
+				},
+				SessionParameters: &SessionParameters{
+					Autocommit: Bool(false),
+				},
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER USER %s SET EMAIL = 'user@example.com' DISABLED = true MINS_TO_UNLOCK = 5 MINS_TO_BYPASS_MFA = 10 NETWORK_POLICY = 'policy' AUTOCOMMIT = false", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("set password policy", func(t *testing.T) {
+		policyID := randomSchemaObjectIdentifier(t)
+		opts := &AlterUserOptions{
+			name: id,
+			Set: &UserSet{
+				PasswordPolicy: policyID,
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER USER %s SET PASSWORD POLICY %s", id.FullyQualifiedName(), policyID.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: set password policy with other fields", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Set: &UserSet{
+				PasswordPolicy: randomSchemaObjectIdentifier(t),
+				ObjectProperties: &UserObjectProperties{
+					Disabled: Bool(true),
+				},
+			},
+		}
+		assert.Error(t, opts.validate())
+	})
+
+	t.Run("unset properties and parameters", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Unset: &UserUnset{
+				ObjectProperties: &UserObjectPropertiesUnset{
+					Comment:       Bool(true),
+					RSAPublicKey2: Bool(true),
+				},
+				ObjectParameters: &UserObjectParametersUnset{
+					EnableUnredactedQuerySyntaxError: Bool(true),
+				},
+				SessionParameters: &SessionParametersUnset{
+					Autocommit: Bool(true),
+				},
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER USER %s UNSET RSA_PUBLIC_KEY_2, COMMENT, ENABLE_UNREDACTED_QUERY_SYNTAX_ERROR, AUTOCOMMIT", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("unset session policy", func(t *testing.T) {
+		opts := &AlterUserOptions{
+			name: id,
+			Unset: &UserUnset{
+				SessionPolicy: Bool(true),
+			},
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("ALTER USER %s UNSET SESSION POLICY", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestUserDrop(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("only name", func(t *testing.T) {
+		opts := &DropUserOptions{
+			name: id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("DROP USER %s", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with if exists", func(t *testing.T) {
+		opts := &DropUserOptions{
+			IfExists: Bool(true),
+			name:     id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("DROP USER IF EXISTS %s", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+}
+
+func TestUserDescribe(t *testing.T) {
+	id := randomAccountObjectIdentifier(t)
+
+	t.Run("only name", func(t *testing.T) {
+		opts := &describeUserOptions{
+			name: id,
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := fmt.Sprintf("DESCRIBE USER %s", id.FullyQualifiedName())
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("parse rows", func(t *testing.T) {
+		rows := []propertyRow{
+			{Property: "NAME", Value: "USER1"},
+			{Property: "DISABLED", Value: "true", DefaultValue: "false"},
+			{Property: "DAYS_TO_EXPIRY", Value: "null"},
+			{Property: "MINS_TO_BYPASS_MFA", Value: "4.5"},
+			{Property: "DEFAULT_SECONDARY_ROLES", Value: `["ALL"]`},
+			{Property: "RSA_PUBLIC_KEY_2_FP", Value: "SHA256:abc"},
+		}
+		details := userDetailsFromRows(rows)
+		assert.Equal(t, "USER1", details.Name.Value)
+		assert.True(t, details.Disabled.Value)
+		assert.False(t, details.Disabled.DefaultValue)
+		assert.Equal(t, "", details.DaysToExpiry.Value)
+		assert.Equal(t, "4.5", details.MinsToBypassMFA.Value)
+		assert.Equal(t, `["ALL"]`, details.DefaultSecondaryRoles.Value)
+		assert.Equal(t, "SHA256:abc", details.RSAPublicKey2Fp.Value)
+	})
+}
+
+func TestUserShow(t *testing.T) {
+	t.Run("empty options", func(t *testing.T) {
+		opts := &ShowUserOptions{}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := "SHOW USERS"
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with like", func(t *testing.T) {
+		opts := &ShowUserOptions{
+			Like: &Like{
+				Pattern: String("user%"),
+			},
+		}
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := "SHOW USERS LIKE 'user%'"
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("with complete options", func(t *testing.T) {
+		opts := &ShowUserOptions{
+			Terse:      Bool(true),
+			Like:       &Like{Pattern: String("user%")},
+			StartsWith: String("us"),
+			Limit:      Int(10),
+			From:       String("user1"),
+		}
+		require.NoError(t, opts.validate())
+		actual, err := structToSQL(opts)
+		require.NoError(t, err)
+		expected := "SHOW TERSE USERS LIKE 'user%' STARTS WITH 'us' LIMIT 10 FROM 'user1'"
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("validation: from without limit", func(t *testing.T) {
+		opts := &ShowUserOptions{
+			From: String("user1"),
+		}
+		assert.Error(t, opts.validate())
+	})
+}
@@ -199,6 +199,11 @@ func (agb *AllGrantBuilder) Show() string {
 	return fmt.Sprintf(`SHOW ALL GRANTS IN %v %v`, agb.allGrantTarget, agb.qualifiedName)
 }
 
+// ShowObjects returns the SQL that will list the existing objects the grant applies to.
+func (agb *AllGrantBuilder) ShowObjects() string {
+	return fmt.Sprintf(`SHOW %vS IN %v %v`, agb.allGrantType, agb.allGrantTarget, agb.qualifiedName)
+}
+
 // Role returns a pointer to a AllGrantExecutable for a role.
 func (agb *AllGrantBuilder) Role(n string) GrantExecutable {
 	return &AllGrantExecutable{
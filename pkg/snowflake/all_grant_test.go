@@ -20,3 +20,16 @@ func TestExistingSchemaGrant(t *testing.T) {
 	r.Equal(`GRANT USAGE ON ALL SCHEMAS IN DATABASE "test_db" TO ROLE "bob"`, eb.Grant("USAGE", false))
 	r.Equal([]string{`REVOKE USAGE ON ALL SCHEMAS IN DATABASE "test_db" FROM ROLE "bob"`}, eb.Revoke("USAGE"))
 }
+
+func TestAllGrantShowObjects(t *testing.T) {
+	r := require.New(t)
+
+	schemas := snowflake.AllSchemaGrant("test_db").(*snowflake.AllGrantBuilder)
+	r.Equal(`SHOW SCHEMAS IN DATABASE "test_db"`, schemas.ShowObjects())
+
+	tables := snowflake.AllTableGrant("test_db", "test_schema").(*snowflake.AllGrantBuilder)
+	r.Equal(`SHOW TABLES IN SCHEMA "test_db"."test_schema"`, tables.ShowObjects())
+
+	views := snowflake.AllMaterializedViewGrant("test_db", "").(*snowflake.AllGrantBuilder)
+	r.Equal(`SHOW MATERIALIZED VIEWS IN DATABASE "test_db"`, views.ShowObjects())
+}
@@ -0,0 +1,63 @@
+package snowflake
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// NewExternalAccessIntegrationBuilder returns a pointer to a Builder that abstracts the DDL operations for an external access integration.
+//
+// Supported DDL operations are:
+//   - CREATE EXTERNAL ACCESS INTEGRATION
+//   - ALTER EXTERNAL ACCESS INTEGRATION
+//   - DROP EXTERNAL ACCESS INTEGRATION
+//   - SHOW EXTERNAL ACCESS INTEGRATIONS
+//   - DESCRIBE EXTERNAL ACCESS INTEGRATION
+//
+// [Snowflake Reference](https://docs.snowflake.com/en/sql-reference/sql/create-external-access-integration)
+func NewExternalAccessIntegrationBuilder(name string) *Builder {
+	return &Builder{
+		entityType: ExternalAccessIntegrationType,
+		name:       name,
+	}
+}
+
+// IdentifierList formats object names the way Snowflake expects them in an identifier list, e.g. (db.schema.rule).
+// Unlike string lists, the names are not quoted, so they can be either plain or quoted identifiers.
+func IdentifierList(identifiers []string) string {
+	return fmt.Sprintf("(%v)", strings.Join(identifiers, ", "))
+}
+
+// secretReferenceList formats the secrets used by a function or procedure, e.g. ('cred' = db.schema.secret), sorted
+// by variable name so that the statement is stable.
+func secretReferenceList(secrets map[string]string) string {
+	variables := make([]string, 0, len(secrets))
+	for variable := range secrets {
+		variables = append(variables, variable)
+	}
+	sort.Strings(variables)
+	references := make([]string, len(variables))
+	for i, variable := range variables {
+		references[i] = fmt.Sprintf(`'%v' = %v`, EscapeString(variable), secrets[variable])
+	}
+	return fmt.Sprintf("(%v)", strings.Join(references, ", "))
+}
+
+type ExternalAccessIntegration struct {
+	Name            sql.NullString `db:"name"`
+	Category        sql.NullString `db:"category"`
+	IntegrationType sql.NullString `db:"type"`
+	CreatedOn       sql.NullString `db:"created_on"`
+	Comment         sql.NullString `db:"comment"`
+	Enabled         sql.NullBool   `db:"enabled"`
+}
+
+func ScanExternalAccessIntegration(row *sqlx.Row) (*ExternalAccessIntegration, error) {
+	r := &ExternalAccessIntegration{}
+	err := row.StructScan(r)
+	return r, err
+}
@@ -0,0 +1,31 @@
+package snowflake_test
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExternalAccessIntegration(t *testing.T) {
+	r := require.New(t)
+	builder := snowflake.NewExternalAccessIntegrationBuilder("external_access")
+	r.NotNil(builder)
+
+	q := builder.Show()
+	r.Equal("SHOW EXTERNAL ACCESS INTEGRATIONS LIKE 'external_access'", q)
+
+	q = builder.Describe()
+	r.Equal(`DESCRIBE EXTERNAL ACCESS INTEGRATION "external_access"`, q)
+
+	c := builder.Create()
+	c.SetRaw("ALLOWED_NETWORK_RULES=" + snowflake.IdentifierList([]string{"db.schema.rule", `"db"."schema"."other"`}))
+	c.SetRaw("ALLOWED_AUTHENTICATION_SECRETS=" + snowflake.IdentifierList([]string{"db.schema.secret"}))
+	c.SetBool(`ENABLED`, true)
+	c.SetString(`COMMENT`, `great comment`)
+	q = c.Statement()
+	r.Equal(`CREATE EXTERNAL ACCESS INTEGRATION "external_access" ALLOWED_NETWORK_RULES=(db.schema.rule, "db"."schema"."other") ALLOWED_AUTHENTICATION_SECRETS=(db.schema.secret) COMMENT='great comment' ENABLED=true`, q)
+
+	q = builder.Drop()
+	r.Equal(`DROP EXTERNAL ACCESS INTEGRATION "external_access"`, q)
+}
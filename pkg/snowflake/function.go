@@ -29,6 +29,9 @@ type FunctionBuilder struct {
 	statement         string
 	runtimeVersion    string // for Python runtime version
 	secure            bool
+
+	externalAccessIntegrations []string          // for Java / Python / Scala external network access
+	secrets                    map[string]string // secret variable name => secret name, for Java / Python / Scala
 }
 
 // QualifiedName prepends the db and schema and appends argument types.
@@ -112,6 +115,18 @@ func (pb *FunctionBuilder) WithHandler(s string) *FunctionBuilder {
 	return pb
 }
 
+// WithExternalAccessIntegrations sets the external access integrations the function can use to reach external network locations.
+func (pb *FunctionBuilder) WithExternalAccessIntegrations(s []string) *FunctionBuilder {
+	pb.externalAccessIntegrations = s
+	return pb
+}
+
+// WithSecrets sets the secrets, keyed by the variable name used in the handler code, that the function can use.
+func (pb *FunctionBuilder) WithSecrets(s map[string]string) *FunctionBuilder {
+	pb.secrets = s
+	return pb
+}
+
 // WithTargetPath sets the target path for compiled jar file for Java function or Python file for Python function.
 func (pb *FunctionBuilder) WithTargetPath(s string) *FunctionBuilder {
 	pb.targetPath = s
@@ -233,6 +248,12 @@ func (pb *FunctionBuilder) Create() (string, error) {
 	if pb.targetPath != "" {
 		q.WriteString(fmt.Sprintf(" TARGET_PATH = '%v'", pb.targetPath))
 	}
+	if len(pb.externalAccessIntegrations) > 0 {
+		q.WriteString(fmt.Sprintf(" EXTERNAL_ACCESS_INTEGRATIONS = %v", IdentifierList(pb.externalAccessIntegrations)))
+	}
+	if len(pb.secrets) > 0 {
+		q.WriteString(fmt.Sprintf(" SECRETS = %v", secretReferenceList(pb.secrets)))
+	}
 
 	q.WriteString(fmt.Sprintf(" AS $$%v$$", pb.statement))
 	return q.String(), nil
@@ -226,6 +226,25 @@ func TestFunctionCreateWithPythonFunctionWithTargetPath(t *testing.T) {
 	r.Equal(expected, createStmnt)
 }
 
+func TestFunctionCreateWithPythonFunctionWithExternalAccess(t *testing.T) {
+	r := require.New(t)
+	s := getPythonFunction(true)
+	s.WithLanguage("PYTHON")
+	s.WithRuntimeVersion("3.8")
+	s.WithHandler("CoolFunc.test")
+	s.WithExternalAccessIntegrations([]string{"test_integration"})
+	s.WithSecrets(map[string]string{"token": "test_db.test_schema.token", "cred": "test_db.test_schema.cred"})
+
+	createStmnt, _ := s.Create()
+	expected := `CREATE OR REPLACE FUNCTION "test_db"."test_schema"."test_func"` +
+		`(arg INT) RETURNS INT` +
+		` LANGUAGE PYTHON RUNTIME_VERSION = '3.8'` +
+		` HANDLER = 'CoolFunc.test' EXTERNAL_ACCESS_INTEGRATIONS = (test_integration)` +
+		` SECRETS = ('cred' = test_db.test_schema.cred, 'token' = test_db.test_schema.token)` +
+		` AS $$` + pythonfunc + `$$`
+	r.Equal(expected, createStmnt)
+}
+
 func TestFunctionDrop(t *testing.T) {
 	r := require.New(t)
 
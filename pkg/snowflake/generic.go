@@ -10,18 +10,19 @@ import (
 type EntityType string
 
 const (
-	APIIntegrationType          EntityType = "API INTEGRATION"
-	DatabaseType                EntityType = "DATABASE"
-	ManagedAccountType          EntityType = "MANAGED ACCOUNT"
-	ResourceMonitorType         EntityType = "RESOURCE MONITOR"
-	RoleType                    EntityType = "ROLE"
-	ShareType                   EntityType = "SHARE"
-	ReplicationType             EntityType = "REPLICATION"
-	StorageIntegrationType      EntityType = "STORAGE INTEGRATION"
-	NotificationIntegrationType EntityType = "NOTIFICATION INTEGRATION"
-	SecurityIntegrationType     EntityType = "SECURITY INTEGRATION"
-	UserType                    EntityType = "USER"
-	WarehouseType               EntityType = "WAREHOUSE"
+	APIIntegrationType            EntityType = "API INTEGRATION"
+	DatabaseType                  EntityType = "DATABASE"
+	ExternalAccessIntegrationType EntityType = "EXTERNAL ACCESS INTEGRATION"
+	ManagedAccountType            EntityType = "MANAGED ACCOUNT"
+	ResourceMonitorType           EntityType = "RESOURCE MONITOR"
+	RoleType                      EntityType = "ROLE"
+	ShareType                     EntityType = "SHARE"
+	ReplicationType               EntityType = "REPLICATION"
+	StorageIntegrationType        EntityType = "STORAGE INTEGRATION"
+	NotificationIntegrationType   EntityType = "NOTIFICATION INTEGRATION"
+	SecurityIntegrationType       EntityType = "SECURITY INTEGRATION"
+	UserType                      EntityType = "USER"
+	WarehouseType                 EntityType = "WAREHOUSE"
 )
 
 type Builder struct {
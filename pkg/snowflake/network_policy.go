@@ -3,6 +3,7 @@ package snowflake
 import (
 	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/jmoiron/sqlx"
@@ -14,6 +15,9 @@ type NetworkPolicyBuilder struct {
 	comment       string
 	allowedIPList string
 	blockedIPList string
+
+	allowedNetworkRuleList string
+	blockedNetworkRuleList string
 }
 
 // WithComment adds a comment to the NetworkPolicyBuilder.
@@ -34,6 +38,27 @@ func (npb *NetworkPolicyBuilder) WithBlockedIPList(blockedIps []string) *Network
 	return npb
 }
 
+// WithAllowedNetworkRuleList adds a list of fully qualified network rule names allowed by the NetworkPolicyBuilder.
+func (npb *NetworkPolicyBuilder) WithAllowedNetworkRuleList(allowedNetworkRules []string) *NetworkPolicyBuilder {
+	npb.allowedNetworkRuleList = networkRuleListToSnowflakeString(allowedNetworkRules)
+	return npb
+}
+
+// WithBlockedNetworkRuleList adds a list of fully qualified network rule names blocked by the NetworkPolicyBuilder.
+func (npb *NetworkPolicyBuilder) WithBlockedNetworkRuleList(blockedNetworkRules []string) *NetworkPolicyBuilder {
+	npb.blockedNetworkRuleList = networkRuleListToSnowflakeString(blockedNetworkRules)
+	return npb
+}
+
+// networkRuleListToSnowflakeString formats network rule names the way Snowflake expects them, e.g. ('db.schema.rule').
+func networkRuleListToSnowflakeString(networkRules []string) string {
+	quoted := make([]string, len(networkRules))
+	for i, networkRule := range networkRules {
+		quoted[i] = fmt.Sprintf(`'%v'`, EscapeString(networkRule))
+	}
+	return fmt.Sprintf("(%v)", strings.Join(quoted, ", "))
+}
+
 // NetworkPolicy returns a pointer to a Builder that abstracts the DDL operations for a network policy.
 //
 // Supported DDL operations are:
@@ -50,10 +75,19 @@ func NetworkPolicy(name string) *NetworkPolicyBuilder {
 
 // Create returns the SQL query that will create a network policy.
 func (npb *NetworkPolicyBuilder) Create() string {
-	createSQL := fmt.Sprintf(`CREATE NETWORK POLICY "%v" ALLOWED_IP_LIST=%v`, npb.name, npb.allowedIPList)
+	createSQL := fmt.Sprintf(`CREATE NETWORK POLICY "%v"`, npb.name)
+	if npb.allowedIPList != "" {
+		createSQL += fmt.Sprintf(" ALLOWED_IP_LIST=%v", npb.allowedIPList)
+	}
 	if npb.blockedIPList != "" {
 		createSQL += fmt.Sprintf(" BLOCKED_IP_LIST=%v", npb.blockedIPList)
 	}
+	if npb.allowedNetworkRuleList != "" {
+		createSQL += fmt.Sprintf(" ALLOWED_NETWORK_RULE_LIST=%v", npb.allowedNetworkRuleList)
+	}
+	if npb.blockedNetworkRuleList != "" {
+		createSQL += fmt.Sprintf(" BLOCKED_NETWORK_RULE_LIST=%v", npb.blockedNetworkRuleList)
+	}
 	if npb.comment != "" {
 		createSQL += fmt.Sprintf(` COMMENT="%v"`, npb.comment)
 	}
@@ -81,6 +115,11 @@ func (npb *NetworkPolicyBuilder) ChangeIPList(listType string, ips []string) str
 	return fmt.Sprintf(`ALTER NETWORK POLICY "%v" SET %v_IP_LIST = %v`, npb.name, listType, helpers.IPListToSnowflakeString(ips))
 }
 
+// ChangeNetworkRuleList returns the SQL query that will update the network rule list (of the specified listType) on the network policy.
+func (npb *NetworkPolicyBuilder) ChangeNetworkRuleList(listType string, networkRules []string) string {
+	return fmt.Sprintf(`ALTER NETWORK POLICY "%v" SET %v_NETWORK_RULE_LIST = %v`, npb.name, listType, networkRuleListToSnowflakeString(networkRules))
+}
+
 // Drop returns the SQL query that will drop a network policy.
 func (npb *NetworkPolicyBuilder) Drop() string {
 	return fmt.Sprintf(`DROP NETWORK POLICY "%v"`, npb.name)
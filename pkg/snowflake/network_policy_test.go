@@ -36,6 +36,18 @@ func TestNetworkPolicyCreateNoOptionals(t *testing.T) {
 	r.Equal(`CREATE NETWORK POLICY "test_network_policy" ALLOWED_IP_LIST=('192.168.0.100/24', '192.168.0.200/18')`, q)
 }
 
+func TestNetworkPolicyCreateWithNetworkRules(t *testing.T) {
+	r := require.New(t)
+	s := snowflake.NetworkPolicy("test_network_policy")
+	r.NotNil(s)
+
+	s.WithAllowedNetworkRuleList([]string{"db.schema.allowed", `"db"."schema"."other"`})
+	s.WithBlockedNetworkRuleList([]string{"db.schema.blocked"})
+
+	q := s.Create()
+	r.Equal(`CREATE NETWORK POLICY "test_network_policy" ALLOWED_NETWORK_RULE_LIST=('db.schema.allowed', '"db"."schema"."other"') BLOCKED_NETWORK_RULE_LIST=('db.schema.blocked')`, q)
+}
+
 func TestNetworkPolicyDescribe(t *testing.T) {
 	r := require.New(t)
 	s := snowflake.NetworkPolicy("test_network_policy")
@@ -86,6 +98,18 @@ func TestNetworkPolicyChangeIpList(t *testing.T) {
 	r.Equal(`ALTER NETWORK POLICY "test_network_policy" SET BLOCKED_IP_LIST = ()`, q)
 }
 
+func TestNetworkPolicyChangeNetworkRuleList(t *testing.T) {
+	r := require.New(t)
+	s := snowflake.NetworkPolicy("test_network_policy")
+	r.NotNil(s)
+
+	q := s.ChangeNetworkRuleList("ALLOWED", []string{"db.schema.rule"})
+	r.Equal(`ALTER NETWORK POLICY "test_network_policy" SET ALLOWED_NETWORK_RULE_LIST = ('db.schema.rule')`, q)
+
+	q = s.ChangeNetworkRuleList("BLOCKED", nil)
+	r.Equal(`ALTER NETWORK POLICY "test_network_policy" SET BLOCKED_NETWORK_RULE_LIST = ()`, q)
+}
+
 func TestNetworkPolicySetOnAccount(t *testing.T) {
 	r := require.New(t)
 	s := snowflake.NetworkPolicy("test_network_policy")
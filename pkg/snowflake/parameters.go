@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/jmoiron/sqlx"
@@ -548,6 +549,40 @@ func ParameterDefaults() map[string]ParameterDefault {
 				ObjectTypeFailoverGroup,
 			},
 		},
+		"EVENT_TABLE": {
+			TypeSet: []ParameterType{ParameterTypeObject, ParameterTypeAccount},
+			// there is no default event table, the parameter is unset instead of being reset to a default value
+			DefaultValue: nil,
+			Validate: func(value string) (err error) {
+				parts := strings.Split(value, ".")
+				if len(parts) != 3 {
+					return fmt.Errorf("%v is not a valid value for EVENT_TABLE, must be a fully qualified table name (database.schema.table)", value)
+				}
+				for _, part := range parts {
+					if strings.Trim(part, `"`) == "" {
+						return fmt.Errorf("%v is not a valid value for EVENT_TABLE, must be a fully qualified table name (database.schema.table)", value)
+					}
+				}
+				return nil
+			},
+			AllowedObjectTypes: []ObjectType{
+				ObjectTypeDatabase,
+			},
+		},
+		"LOG_LEVEL": {
+			TypeSet:      []ParameterType{ParameterTypeObject, ParameterTypeAccount},
+			DefaultValue: "OFF",
+			Validate: func(value string) (err error) {
+				if !slices.Contains([]string{"TRACE", "DEBUG", "INFO", "WARN", "ERROR", "FATAL", "OFF"}, value) {
+					return fmt.Errorf("%v is not a valid value for LOG_LEVEL, must be one of TRACE, DEBUG, INFO, WARN, ERROR, FATAL, OFF", value)
+				}
+				return nil
+			},
+			AllowedObjectTypes: []ObjectType{
+				ObjectTypeDatabase,
+				ObjectTypeSchema,
+			},
+		},
 		"MAX_CONCURRENCY_LEVEL": {
 			TypeSet:      []ParameterType{ParameterTypeObject},
 			DefaultValue: 0,
@@ -640,6 +675,20 @@ func ParameterDefaults() map[string]ParameterDefault {
 				ObjectTypeTask,
 			},
 		},
+		"TRACE_LEVEL": {
+			TypeSet:      []ParameterType{ParameterTypeObject, ParameterTypeAccount},
+			DefaultValue: "OFF",
+			Validate: func(value string) (err error) {
+				if !slices.Contains([]string{"ALWAYS", "ON_EVENT", "OFF"}, value) {
+					return fmt.Errorf("%v is not a valid value for TRACE_LEVEL, must be one of ALWAYS, ON_EVENT, OFF", value)
+				}
+				return nil
+			},
+			AllowedObjectTypes: []ObjectType{
+				ObjectTypeDatabase,
+				ObjectTypeSchema,
+			},
+		},
 		"USER_TASK_MANAGED_INITIAL_WAREHOUSE_SIZE": {
 			TypeSet:      []ParameterType{ParameterTypeObject, ParameterTypeAccount},
 			DefaultValue: "MEDIUM",
@@ -778,6 +827,11 @@ func (v *AccountParameterBuilder) SetParameter() error {
 	return v.executor.Execute(stmt)
 }
 
+func (v *AccountParameterBuilder) UnsetParameter() error {
+	stmt := fmt.Sprintf("ALTER ACCOUNT UNSET %s", v.key)
+	return v.executor.Execute(stmt)
+}
+
 // SessionParameterBuilder abstracts the creation of SQL queries for Snowflake session parameters.
 type SessionParameterBuilder struct {
 	key       string
@@ -866,6 +920,22 @@ func (v *ObjectParameterBuilder) SetParameter() error {
 	return v.executor.Execute(stmt)
 }
 
+func (v *ObjectParameterBuilder) UnsetParameter() error {
+	if v.onAccount {
+		stmt := fmt.Sprintf("ALTER ACCOUNT UNSET %s", v.key)
+		return v.executor.Execute(stmt)
+	}
+	if v.objectType == "" {
+		return fmt.Errorf("object type is required when unsetting object parameters")
+	}
+	if v.objectIdentifier == "" {
+		return fmt.Errorf("object identifier is required when unsetting object parameters")
+	}
+
+	stmt := fmt.Sprintf("ALTER %s %s UNSET %s", v.objectType, v.objectIdentifier, v.key)
+	return v.executor.Execute(stmt)
+}
+
 type Parameter struct {
 	Key         sql.NullString `db:"key"`
 	Value       sql.NullString `db:"value"`
@@ -87,6 +87,41 @@ func (e *ViewSelectStatementExtractor) ExtractMaterializedView() (string, error)
 	return string(e.input[e.pos:]), nil
 }
 
+func (e *ViewSelectStatementExtractor) ExtractDynamicTable() (string, error) {
+	fmt.Printf("[DEBUG] extracting dynamic table query %s\n", string(e.input))
+	e.consumeSpace()
+	e.consumeToken("create")
+	e.consumeSpace()
+	e.consumeToken("or replace")
+	e.consumeSpace()
+	e.consumeToken("dynamic table")
+	e.consumeSpace()
+	e.consumeToken("if not exists")
+	e.consumeSpace()
+	e.consumeID()
+	// the parameters may come in any order
+	for {
+		e.consumeSpace()
+		start := e.pos
+		e.consumeParameter("target_lag")
+		e.consumeParameter("warehouse")
+		e.consumeParameter("refresh_mode")
+		e.consumeParameter("initialize")
+		e.consumeComment()
+		if e.consumeToken("cluster by") {
+			e.consumeSpace()
+			e.consumeClusterBy()
+		}
+		if e.pos == start {
+			break
+		}
+	}
+	e.consumeToken("as")
+	e.consumeSpace()
+
+	return string(e.input[e.pos:]), nil
+}
+
 // consumeToken will move e.pos forward iff the token is the next part of the input. Comparison is
 // case-insensitive. Will return true if consumed.
 func (e *ViewSelectStatementExtractor) consumeToken(t string) bool {
@@ -173,6 +208,32 @@ func (e *ViewSelectStatementExtractor) consumeComment() {
 	}
 }
 
+// consumeParameter consumes a parameter of the form name = value, where the value is either quoted or a single word.
+func (e *ViewSelectStatementExtractor) consumeParameter(name string) {
+	if c := e.consumeToken(name); !c {
+		return
+	}
+	e.consumeSpace()
+	if c := e.consumeToken("="); !c {
+		return
+	}
+	e.consumeSpace()
+	if !e.consumeToken("'") {
+		e.consumeNonSpace()
+		return
+	}
+	found := 0
+	for {
+		if e.pos+found > len(e.input)-1 || e.input[e.pos+found] == '\'' {
+			break
+		}
+		found++
+	}
+	e.pos += found
+	e.consumeToken("'")
+	e.consumeSpace()
+}
+
 func (e *ViewSelectStatementExtractor) consumeClusterBy() {
 	if e.input[e.pos] != '(' {
 		return
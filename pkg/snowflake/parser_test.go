@@ -137,6 +137,57 @@ from bar;`
 	}
 }
 
+func TestViewSelectStatementExtractor_ExtractDynamicTable(t *testing.T) {
+	basic := "create dynamic table foo target_lag = '1 minute' warehouse = bar as select * from bar;"
+	caps := "CREATE DYNAMIC TABLE FOO TARGET_LAG = '1 MINUTE' WAREHOUSE = BAR AS SELECT * FROM BAR;"
+	downstream := "create dynamic table foo target_lag = downstream warehouse = bar as select * from bar;"
+	multiline := `
+create or replace dynamic table foo
+  target_lag = '1 minute'
+  warehouse = bar
+as
+select *
+from bar;`
+	order := "create dynamic table foo warehouse=bar refresh_mode = incremental target_lag='1 minute' as select * from bar;"
+	clusterBy := "create dynamic table foo target_lag = '1 minute' warehouse = bar cluster by (c1, c2) as select * from bar;"
+	comment := `create dynamic table foo target_lag = '1 minute' warehouse = bar comment='asdf\'s are fun' as select * from bar;`
+
+	full := `CREATE OR REPLACE DYNAMIC TABLE "db"."schema"."foo" TARGET_LAG = DOWNSTREAM WAREHOUSE = "bar" REFRESH_MODE = FULL CLUSTER BY (ID) COMMENT = 'Terraform test resource' AS SELECT ID, NAME FROM "db"."schema"."bar"`
+
+	type args struct {
+		input string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{"basic", args{basic}, "select * from bar;", false},
+		{"caps", args{caps}, "SELECT * FROM BAR;", false},
+		{"downstream", args{downstream}, "select * from bar;", false},
+		{"multiline", args{multiline}, "select *\nfrom bar;", false},
+		{"order", args{order}, "select * from bar;", false},
+		{"clusterBy", args{clusterBy}, "select * from bar;", false},
+		{"comment", args{comment}, "select * from bar;", false},
+		{"full", args{full}, `SELECT ID, NAME FROM "db"."schema"."bar"`, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewViewSelectStatementExtractor(tt.args.input)
+			got, err := e.ExtractDynamicTable()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ViewSelectStatementExtractor.ExtractDynamicTable() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ViewSelectStatementExtractor.ExtractDynamicTable() = '%v', want '%v'", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestViewSelectStatementExtractor_consumeToken(t *testing.T) {
 	type fields struct {
 		input []rune
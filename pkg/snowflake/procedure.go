@@ -28,6 +28,9 @@ type ProcedureBuilder struct {
 	comment           string
 	statement         string
 	runtimeVersion    string // for Python runtime version
+
+	externalAccessIntegrations []string          // for Java / Python / Scala external network access
+	secrets                    map[string]string // secret variable name => secret name, for Java / Python / Scala
 }
 
 // QualifiedName prepends the db and schema and appends argument types.
@@ -117,6 +120,18 @@ func (pb *ProcedureBuilder) WithHandler(s string) *ProcedureBuilder {
 	return pb
 }
 
+// WithExternalAccessIntegrations sets the external access integrations the procedure can use to reach external network locations.
+func (pb *ProcedureBuilder) WithExternalAccessIntegrations(s []string) *ProcedureBuilder {
+	pb.externalAccessIntegrations = s
+	return pb
+}
+
+// WithSecrets sets the secrets, keyed by the variable name used in the handler code, that the procedure can use.
+func (pb *ProcedureBuilder) WithSecrets(s map[string]string) *ProcedureBuilder {
+	pb.secrets = s
+	return pb
+}
+
 // WithComment adds a comment to the ProcedureBuilder.
 func (pb *ProcedureBuilder) WithComment(c string) *ProcedureBuilder {
 	pb.comment = c
@@ -208,6 +223,12 @@ func (pb *ProcedureBuilder) Create() (string, error) {
 	if pb.handler != "" {
 		q.WriteString(fmt.Sprintf(" HANDLER = '%v'", pb.handler))
 	}
+	if len(pb.externalAccessIntegrations) > 0 {
+		q.WriteString(fmt.Sprintf(" EXTERNAL_ACCESS_INTEGRATIONS = %v", IdentifierList(pb.externalAccessIntegrations)))
+	}
+	if len(pb.secrets) > 0 {
+		q.WriteString(fmt.Sprintf(" SECRETS = %v", secretReferenceList(pb.secrets)))
+	}
 	if pb.comment != "" {
 		q.WriteString(fmt.Sprintf(" COMMENT = '%v'", EscapeString(pb.comment)))
 	}